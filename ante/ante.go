@@ -9,15 +9,21 @@ import (
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	atomoneerrors "github.com/atomone-hub/atomone/types/errors"
+	dynamicfeekeeper "github.com/atomone-hub/atomone/x/dynamicfee/keeper"
+	govkeeper "github.com/atomone-hub/atomone/x/gov/keeper"
+	photonkeeper "github.com/atomone-hub/atomone/x/photon/keeper"
 )
 
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
 // channel keeper.
 type HandlerOptions struct {
 	ante.HandlerOptions
-	Codec         codec.BinaryCodec
-	StakingKeeper *stakingkeeper.Keeper
-	TxFeeChecker  ante.TxFeeChecker
+	Codec            codec.BinaryCodec
+	StakingKeeper    *stakingkeeper.Keeper
+	GovKeeper        *govkeeper.Keeper
+	PhotonKeeper     *photonkeeper.Keeper
+	DynamicfeeKeeper *dynamicfeekeeper.Keeper
+	TxFeeChecker     ante.TxFeeChecker
 }
 
 func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
@@ -33,11 +39,24 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 	if opts.StakingKeeper == nil {
 		return nil, errorsmod.Wrap(atomoneerrors.ErrNotFound, "staking param store is required for AnteHandler")
 	}
+	if opts.GovKeeper == nil {
+		return nil, errorsmod.Wrap(atomoneerrors.ErrLogic, "gov keeper is required for AnteHandler")
+	}
+	if opts.PhotonKeeper == nil {
+		return nil, errorsmod.Wrap(atomoneerrors.ErrLogic, "photon keeper is required for AnteHandler")
+	}
+	if opts.DynamicfeeKeeper == nil {
+		return nil, errorsmod.Wrap(atomoneerrors.ErrLogic, "dynamicfee keeper is required for AnteHandler")
+	}
 
 	sigGasConsumer := opts.SigGasConsumer
 	if sigGasConsumer == nil {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
+	txFeeChecker := opts.TxFeeChecker
+	if txFeeChecker == nil {
+		txFeeChecker = NewDynamicFeeChecker(opts.DynamicfeeKeeper, opts.StakingKeeper).CheckTxFee
+	}
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewExtensionOptionsDecorator(opts.ExtensionOptionChecker),
@@ -46,7 +65,9 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
 		NewGovVoteDecorator(opts.Codec, opts.StakingKeeper),
-		ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, opts.TxFeeChecker),
+		NewGovVoteLockDecorator(opts.Codec, opts.GovKeeper),
+		NewPhotonFeeDecorator(opts.PhotonKeeper),
+		ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, txFeeChecker),
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(opts.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(opts.AccountKeeper, sigGasConsumer),
@@ -0,0 +1,142 @@
+package ante
+
+import (
+	"math"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	atomoneerrors "github.com/atomone-hub/atomone/types/errors"
+	dynamicfeekeeper "github.com/atomone-hub/atomone/x/dynamicfee/keeper"
+	dynamicfeetypes "github.com/atomone-hub/atomone/x/dynamicfee/types"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// DynamicFeeChecker checks the transaction fees against the base gas price of
+// the dynamicfee module, in addition to the validator minimum gas prices.
+type DynamicFeeChecker struct {
+	dynamicfeeKeeper *dynamicfeekeeper.Keeper
+	stakingKeeper    *stakingkeeper.Keeper
+}
+
+func NewDynamicFeeChecker(dynamicfeeKeeper *dynamicfeekeeper.Keeper, stakingKeeper *stakingkeeper.Keeper) DynamicFeeChecker {
+	return DynamicFeeChecker{
+		dynamicfeeKeeper: dynamicfeeKeeper,
+		stakingKeeper:    stakingKeeper,
+	}
+}
+
+// CheckTxFee implements the ante.TxFeeChecker function signature. The base
+// gas price is enforced in CheckTx and DeliverTx: the fee must hold at least
+// ceil(base_gas_price * gas) of one of the fee denoms, PHOTON or the bond denom
+// allowed by the photon tx_fee_exceptions. The validator minimum gas
+// prices are only enforced in CheckTx, like in the default TxFeeChecker.
+func (c DynamicFeeChecker) CheckTxFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(atomoneerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	if ctx.IsCheckTx() {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+
+			// Determine the required fees by multiplying each required minimum gas
+			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+			glDec := sdkmath.LegacyNewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			if !feeCoins.IsAnyGTE(requiredFees) {
+				return nil, 0, errorsmod.Wrapf(atomoneerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+		}
+	}
+
+	// the genesis transactions are not subject to the base gas price
+	if ctx.BlockHeight() > 0 && c.dynamicfeeKeeper.GetParams(ctx).Enabled {
+		baseGasPrice := c.dynamicfeeKeeper.GetBaseGasPrice(ctx)
+		required := baseGasPrice.MulInt64(int64(gas)).Ceil().RoundInt()
+		feeDenomCoins := filterDenoms(feeCoins, photontypes.Denom, c.stakingKeeper.BondDenom(ctx))
+		if required.IsPositive() && !anyAmountGTE(feeDenomCoins, required) {
+			return nil, 0, errorsmod.Wrapf(dynamicfeetypes.ErrInsufficientFee, "got: %s required: %s of a fee denom (base gas price %s)", feeCoins, required, baseGasPrice)
+		}
+
+		// the priority gas prices paid in the block feed the fee estimation
+		if !ctx.IsCheckTx() && gas > 0 {
+			priorityGasPrice := sdkmath.LegacyNewDecFromInt(maxAmount(feeDenomCoins)).QuoInt64(int64(gas)).Sub(baseGasPrice)
+			if priorityGasPrice.IsNegative() {
+				priorityGasPrice = sdkmath.LegacyZeroDec()
+			}
+			c.dynamicfeeKeeper.RecordPriorityGasPrice(ctx, priorityGasPrice)
+		}
+	}
+
+	priority := getTxPriority(feeCoins, int64(gas))
+	return feeCoins, priority, nil
+}
+
+// filterDenoms returns the coins whose denom is one of denoms.
+func filterDenoms(coins sdk.Coins, denoms ...string) sdk.Coins {
+	var filtered sdk.Coins
+	for _, c := range coins {
+		if slices.Contains(denoms, c.Denom) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// anyAmountGTE returns true if one of the coins has an amount greater or
+// equal to amount.
+func anyAmountGTE(coins sdk.Coins, amount sdkmath.Int) bool {
+	for _, c := range coins {
+		if c.Amount.GTE(amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxAmount returns the greatest amount of the coins.
+func maxAmount(coins sdk.Coins) sdkmath.Int {
+	amount := sdkmath.ZeroInt()
+	for _, c := range coins {
+		if c.Amount.GT(amount) {
+			amount = c.Amount
+		}
+	}
+	return amount
+}
+
+// getTxPriority returns a naive tx priority based on the amount of the
+// smallest denomination of the gas price provided in a transaction.
+func getTxPriority(fee sdk.Coins, gas int64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	var priority int64
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(gas)
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
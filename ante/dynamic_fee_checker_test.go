@@ -0,0 +1,160 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/ante"
+	"github.com/atomone-hub/atomone/app/helpers"
+)
+
+func TestDynamicFeeChecker(t *testing.T) {
+	atomoneApp := helpers.Setup(t)
+	ctx := atomoneApp.NewUncachedContext(false, tmproto.Header{Height: 1})
+	checker := ante.NewDynamicFeeChecker(atomoneApp.DynamicfeeKeeper, atomoneApp.StakingKeeper)
+	atomoneApp.DynamicfeeKeeper.SetBaseGasPrice(ctx, math.LegacyMustNewDecFromStr("0.5"))
+
+	tests := []struct {
+		name             string
+		gas              uint64
+		fee              sdk.Coins
+		checkTx          bool
+		minGasPrices     sdk.DecCoins
+		disabled         bool
+		genesis          bool
+		expectedPriority int64
+		expectedErr      string
+	}{
+		{
+			name:             "ok: fee equal to base gas price",
+			gas:              1000,
+			fee:              sdk.NewCoins(sdk.NewInt64Coin("uphoton", 500)),
+			expectedPriority: 0,
+		},
+		{
+			name:             "ok: fee above base gas price",
+			gas:              1000,
+			fee:              sdk.NewCoins(sdk.NewInt64Coin("uphoton", 2000)),
+			expectedPriority: 2,
+		},
+		{
+			name:        "fail: fee below base gas price",
+			gas:         1000,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uphoton", 499)),
+			expectedErr: "got: 499uphoton required: 500 of a fee denom (base gas price 0.500000000000000000): insufficient fee for the base gas price",
+		},
+		{
+			name:             "ok: fee in bond denom",
+			gas:              1000,
+			fee:              sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)),
+			expectedPriority: 0,
+		},
+		{
+			name:        "fail: fee in other denom",
+			gas:         1000,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("ibc/foo", 5000)),
+			expectedErr: "got: 5000ibc/foo required: 500 of a fee denom (base gas price 0.500000000000000000): insufficient fee for the base gas price",
+		},
+		{
+			name:        "fail: fee in other denom above base gas price",
+			gas:         1000,
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("ibc/foo", 5000), sdk.NewInt64Coin("uphoton", 499)),
+			expectedErr: "got: 5000ibc/foo,499uphoton required: 500 of a fee denom (base gas price 0.500000000000000000): insufficient fee for the base gas price",
+		},
+		{
+			name:        "fail: no fee",
+			gas:         1000,
+			expectedErr: "got:  required: 500 of a fee denom (base gas price 0.500000000000000000): insufficient fee for the base gas price",
+		},
+		{
+			name:     "ok: disabled",
+			gas:      1000,
+			disabled: true,
+		},
+		{
+			name:    "ok: genesis transaction",
+			gas:     1000,
+			genesis: true,
+		},
+		{
+			name:         "fail: fee below validator min gas prices in check tx",
+			gas:          1000,
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("uphoton", 500)),
+			checkTx:      true,
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoin("uphoton", math.NewInt(1))),
+			expectedErr:  "insufficient fees; got: 500uphoton required: 1000uphoton: insufficient fee",
+		},
+		{
+			name:             "ok: validator min gas prices in deliver tx",
+			gas:              1000,
+			fee:              sdk.NewCoins(sdk.NewInt64Coin("uphoton", 500)),
+			minGasPrices:     sdk.NewDecCoins(sdk.NewDecCoin("uphoton", math.NewInt(1))),
+			expectedPriority: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			ctx = ctx.WithIsCheckTx(tt.checkTx).WithMinGasPrices(tt.minGasPrices)
+			if tt.genesis {
+				ctx = ctx.WithBlockHeight(0)
+			}
+			if tt.disabled {
+				params := atomoneApp.DynamicfeeKeeper.GetParams(ctx)
+				params.Enabled = false
+				require.NoError(t, atomoneApp.DynamicfeeKeeper.SetParams(ctx, params))
+			}
+			txBuilder := atomoneApp.GetTxConfig().NewTxBuilder()
+			txBuilder.SetGasLimit(tt.gas)
+			txBuilder.SetFeeAmount(tt.fee)
+
+			fee, priority, err := checker.CheckTxFee(ctx, txBuilder.GetTx())
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.fee, fee)
+			require.Equal(t, tt.expectedPriority, priority)
+		})
+	}
+}
+
+func TestDynamicFeeCheckerRecordsPriorityGasPrice(t *testing.T) {
+	atomoneApp := helpers.Setup(t)
+	ctx := atomoneApp.NewUncachedContext(false, tmproto.Header{Height: 1})
+	checker := ante.NewDynamicFeeChecker(atomoneApp.DynamicfeeKeeper, atomoneApp.StakingKeeper)
+	atomoneApp.DynamicfeeKeeper.SetBaseGasPrice(ctx, math.LegacyMustNewDecFromStr("0.5"))
+
+	for _, tt := range []struct {
+		fee     int64
+		checkTx bool
+	}{
+		{fee: 2000},
+		{fee: 500},
+		{fee: 1200},
+		// not recorded in check tx
+		{fee: 10000, checkTx: true},
+	} {
+		txBuilder := atomoneApp.GetTxConfig().NewTxBuilder()
+		txBuilder.SetGasLimit(1000)
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uphoton", tt.fee)))
+		_, _, err := checker.CheckTxFee(ctx.WithIsCheckTx(tt.checkTx), txBuilder.GetTx())
+		require.NoError(t, err)
+	}
+
+	atomoneApp.DynamicfeeKeeper.UpdateBaseGasPrice(ctx, 0)
+
+	history := atomoneApp.DynamicfeeKeeper.GetFeeHistory(ctx)
+	require.Len(t, history, 1)
+	// median of 1.5, 0 and 0.7
+	require.Equal(t, "0.700000000000000000", history[0].PriorityGasPrice.String())
+}
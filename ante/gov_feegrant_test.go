@@ -0,0 +1,89 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/atomone-hub/atomone/ante"
+	"github.com/atomone-hub/atomone/app/helpers"
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// Test that the fees of the governance actions can be paid by a fee granter
+// through an allowance restricted to them.
+func TestGovActionsFeeGrant(t *testing.T) {
+	atomoneApp := helpers.Setup(t)
+	ctx := atomoneApp.NewUncachedContext(false, tmproto.Header{})
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewPhotonFeeDecorator(atomoneApp.PhotonKeeper),
+		authante.NewDeductFeeDecorator(atomoneApp.AccountKeeper, atomoneApp.BankKeeper, atomoneApp.FeeGrantKeeper, nil),
+	)
+
+	granter := sdk.AccAddress("granter_____________")
+	grantee := sdk.AccAddress("grantee_____________")
+	atomoneApp.AccountKeeper.SetAccount(ctx, atomoneApp.AccountKeeper.NewAccountWithAddress(ctx, grantee))
+	fee := sdk.NewCoins(sdk.NewInt64Coin(photontypes.Denom, 100))
+	err := banktestutil.FundAccount(atomoneApp.BankKeeper, ctx, granter, fee.MulInt(sdk.NewInt(3)))
+	require.NoError(t, err)
+
+	allowance, err := govv1.NewGovActionsAllowance(fee.MulInt(sdk.NewInt(2)), nil)
+	require.NoError(t, err)
+	err = atomoneApp.FeeGrantKeeper.GrantAllowance(ctx, granter, grantee, allowance)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		msg         sdk.Msg
+		expectedErr string
+	}{
+		{
+			name: "ok: vote",
+			msg:  govv1.NewMsgVote(grantee, 1, govv1.OptionYes, ""),
+		},
+		{
+			name:        "fail: not a governance action",
+			msg:         banktypes.NewMsgSend(grantee, granter, fee),
+			expectedErr: "message does not exist in allowed messages",
+		},
+		{
+			name: "ok: deposit",
+			msg:  govv1.NewMsgDeposit(grantee, 1, fee),
+		},
+		{
+			// the allowance is removed once its spend limit is used up
+			name:        "fail: spend limit used up",
+			msg:         govv1.NewMsgVote(grantee, 1, govv1.OptionNo, ""),
+			expectedErr: "fee-grant not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txBuilder := atomoneApp.GetTxConfig().NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tt.msg))
+			txBuilder.SetFeeAmount(fee)
+			txBuilder.SetFeeGranter(granter)
+			txBuilder.SetGasLimit(200000)
+
+			granterBalance := atomoneApp.BankKeeper.GetAllBalances(ctx, granter)
+			_, err := anteHandler(ctx, txBuilder.GetTx(), false)
+
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				require.Equal(t, granterBalance, atomoneApp.BankKeeper.GetAllBalances(ctx, granter))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, granterBalance.Sub(fee...), atomoneApp.BankKeeper.GetAllBalances(ctx, granter))
+			require.True(t, atomoneApp.BankKeeper.GetAllBalances(ctx, grantee).Empty())
+		})
+	}
+}
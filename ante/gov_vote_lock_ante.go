@@ -0,0 +1,68 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	atomoneerrors "github.com/atomone-hub/atomone/types/errors"
+	govkeeper "github.com/atomone-hub/atomone/x/gov/keeper"
+)
+
+// GovVoteLockDecorator rejects the undelegations and redelegations of the
+// delegation shares locked by gov votes, including the ones executed through
+// authz.
+type GovVoteLockDecorator struct {
+	govKeeper *govkeeper.Keeper
+	cdc       codec.BinaryCodec
+}
+
+func NewGovVoteLockDecorator(cdc codec.BinaryCodec, govKeeper *govkeeper.Keeper) GovVoteLockDecorator {
+	return GovVoteLockDecorator{
+		govKeeper: govKeeper,
+		cdc:       cdc,
+	}
+}
+
+func (d GovVoteLockDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	msgs, err := d.unpackMsgs(tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	if err := d.govKeeper.CheckVoteLocks(ctx, msgs); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// unpackMsgs returns the messages, with the messages of the authz MsgExec
+// in place of them.
+func (d GovVoteLockDecorator) unpackMsgs(msgs []sdk.Msg) ([]sdk.Msg, error) {
+	unpacked := make([]sdk.Msg, 0, len(msgs))
+	for _, m := range msgs {
+		execMsg, ok := m.(*authz.MsgExec)
+		if !ok {
+			unpacked = append(unpacked, m)
+			continue
+		}
+
+		innerMsgs := make([]sdk.Msg, len(execMsg.Msgs))
+		for i, v := range execMsg.Msgs {
+			if err := d.cdc.UnpackAny(v, &innerMsgs[i]); err != nil {
+				return nil, errorsmod.Wrap(atomoneerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+		}
+		innerMsgs, err := d.unpackMsgs(innerMsgs)
+		if err != nil {
+			return nil, err
+		}
+		unpacked = append(unpacked, innerMsgs...)
+	}
+	return unpacked, nil
+}
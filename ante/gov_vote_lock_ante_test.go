@@ -0,0 +1,84 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/atomone/ante"
+	"github.com/atomone-hub/atomone/app/helpers"
+	govtypes "github.com/atomone-hub/atomone/x/gov/types"
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// Test that the GovVoteLockDecorator rejects the undelegations of the shares
+// locked by a vote, including the ones executed through authz.
+func TestGovVoteLockDecorator(t *testing.T) {
+	atomoneApp := helpers.Setup(t)
+	ctx := atomoneApp.NewUncachedContext(true, tmproto.Header{Time: time.Now().UTC()})
+	decorator := ante.NewGovVoteLockDecorator(atomoneApp.AppCodec(), atomoneApp.GovKeeper)
+
+	// Lock the delegation created during setup
+	delegation := atomoneApp.StakingKeeper.GetAllDelegations(ctx)[0]
+	delegator := delegation.GetDelegatorAddr()
+	endTime := ctx.BlockTime().Add(time.Hour)
+	atomoneApp.GovKeeper.SetVoteLock(ctx, govv1.VoteLock{
+		Voter:   delegator.String(),
+		EndTime: &endTime,
+		Shares:  []*govv1.LockedShares{{ValidatorAddress: delegation.ValidatorAddress, Shares: delegation.Shares.String()}},
+	})
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	undelegate := stakingtypes.NewMsgUndelegate(delegator, delegation.GetValidatorAddr(), coin)
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{undelegate})
+	nestedExec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{&exec})
+
+	tests := []struct {
+		name       string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{
+			name:       "delegate",
+			msgs:       []sdk.Msg{stakingtypes.NewMsgDelegate(delegator, delegation.GetValidatorAddr(), coin)},
+			expectPass: true,
+		},
+		{
+			name:       "undelegate",
+			msgs:       []sdk.Msg{undelegate},
+			expectPass: false,
+		},
+		{
+			name:       "authz undelegate",
+			msgs:       []sdk.Msg{&exec},
+			expectPass: false,
+		},
+		{
+			name:       "nested authz undelegate",
+			msgs:       []sdk.Msg{&nestedExec},
+			expectPass: false,
+		},
+	}
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := atomoneApp.GetTxConfig().NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, next)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, govtypes.ErrVoteLocked)
+			}
+		})
+	}
+}
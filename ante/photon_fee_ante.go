@@ -0,0 +1,87 @@
+package ante
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	atomoneerrors "github.com/atomone-hub/atomone/types/errors"
+	photonkeeper "github.com/atomone-hub/atomone/x/photon/keeper"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// PhotonFeeDecorator restricts the transaction fees to PHOTON, except for the
+// transactions whose messages are all listed in the photon tx_fee_exceptions
+// param.
+type PhotonFeeDecorator struct {
+	photonKeeper *photonkeeper.Keeper
+}
+
+func NewPhotonFeeDecorator(photonKeeper *photonkeeper.Keeper) PhotonFeeDecorator {
+	return PhotonFeeDecorator{
+		photonKeeper: photonKeeper,
+	}
+}
+
+func (d PhotonFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(atomoneerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the genesis transactions are not restricted
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.ValidateFee(ctx, tx.GetMsgs(), feeTx.GetFee()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateFee checks that the fee is paid in PHOTON, unless all the messages
+// are exempted.
+func (d PhotonFeeDecorator) ValidateFee(ctx sdk.Context, msgs []sdk.Msg, fee sdk.Coins) error {
+	if fee.IsZero() {
+		// the minimum gas prices are enforced by the DeductFeeDecorator
+		return nil
+	}
+
+	if d.allowsAnyTxFee(ctx, msgs) {
+		return nil
+	}
+
+	if len(fee) > 1 {
+		return errorsmod.Wrapf(photontypes.ErrTooManyFeeCoins, "fee: %s", fee)
+	}
+	if fee[0].Denom != photontypes.Denom {
+		return errorsmod.Wrapf(photontypes.ErrInvalidFeeToken, "fee denom %s not allowed, expected %s", fee[0].Denom, photontypes.Denom)
+	}
+
+	return nil
+}
+
+// allowsAnyTxFee returns true if all the messages are in the tx_fee_exceptions
+// param, or if it contains the wildcard.
+func (d PhotonFeeDecorator) allowsAnyTxFee(ctx sdk.Context, msgs []sdk.Msg) bool {
+	exceptions := d.photonKeeper.GetParams(ctx).TxFeeExceptions
+	if slices.Contains(exceptions, photontypes.TxFeeExceptionWildcard) {
+		return true
+	}
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if !slices.Contains(exceptions, sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+	return true
+}
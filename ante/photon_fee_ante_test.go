@@ -0,0 +1,89 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/atomone-hub/atomone/ante"
+	"github.com/atomone-hub/atomone/app/helpers"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestPhotonFeeDecorator(t *testing.T) {
+	atomoneApp := helpers.Setup(t)
+	ctx := atomoneApp.NewUncachedContext(true, tmproto.Header{})
+	decorator := ante.NewPhotonFeeDecorator(atomoneApp.PhotonKeeper)
+	addr := sdk.AccAddress("addr________________")
+	mintMsg := photontypes.NewMsgMintPhoton(addr, sdk.NewInt64Coin("uatone", 1))
+	sendMsg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)))
+
+	tests := []struct {
+		name        string
+		exceptions  []string
+		msgs        []sdk.Msg
+		fee         sdk.Coins
+		expectedErr string
+	}{
+		{
+			name: "ok: no fee",
+			msgs: []sdk.Msg{sendMsg},
+		},
+		{
+			name: "ok: fee in photon",
+			msgs: []sdk.Msg{sendMsg},
+			fee:  sdk.NewCoins(sdk.NewInt64Coin(photontypes.Denom, 1)),
+		},
+		{
+			name:        "fail: fee in atone",
+			msgs:        []sdk.Msg{sendMsg},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)),
+			expectedErr: "fee denom uatone not allowed, expected uphoton: invalid fee token",
+		},
+		{
+			name:        "fail: fee in photon and atone",
+			msgs:        []sdk.Msg{sendMsg},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(photontypes.Denom, 1), sdk.NewInt64Coin("uatone", 1)),
+			expectedErr: "fee: 1uatone,1uphoton: too many fee coins, only accepts fees in one denom",
+		},
+		{
+			name: "ok: exempted msg fee in atone",
+			msgs: []sdk.Msg{mintMsg},
+			fee:  sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)),
+		},
+		{
+			name:        "fail: exempted and not exempted msgs fee in atone",
+			msgs:        []sdk.Msg{mintMsg, sendMsg},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)),
+			expectedErr: "fee denom uatone not allowed, expected uphoton: invalid fee token",
+		},
+		{
+			name:       "ok: wildcard exception fee in atone",
+			exceptions: []string{photontypes.TxFeeExceptionWildcard},
+			msgs:       []sdk.Msg{mintMsg, sendMsg},
+			fee:        sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			if tt.exceptions != nil {
+				err := atomoneApp.PhotonKeeper.SetParams(ctx, photontypes.NewParams(false, tt.exceptions))
+				require.NoError(t, err)
+			}
+
+			err := decorator.ValidateFee(ctx, tt.msgs, tt.fee)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package atomone
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -44,12 +45,18 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 
 	atomoneante "github.com/atomone-hub/atomone/ante"
 	"github.com/atomone-hub/atomone/app/keepers"
 	"github.com/atomone-hub/atomone/app/params"
 	"github.com/atomone-hub/atomone/app/upgrades"
+	atomonemempool "github.com/atomone-hub/atomone/mempool"
+	govsearch "github.com/atomone-hub/atomone/x/gov/search"
+	govstream "github.com/atomone-hub/atomone/x/gov/stream"
 	govtypes "github.com/atomone-hub/atomone/x/gov/types"
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	treasurytypes "github.com/atomone-hub/atomone/x/treasury/types"
 )
 
 var (
@@ -83,6 +90,11 @@ type AtomOneApp struct { //nolint: revive
 	// simulation manager
 	sm           *module.SimulationManager
 	configurator module.Configurator
+
+	// governance events streaming service
+	govEventStream *govstream.Server
+	// optional node-local proposal search index, nil if disabled
+	govSearchIndex *govsearch.Index
 }
 
 func init() {
@@ -127,6 +139,9 @@ func NewAtomOneApp(
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 	bApp.SetTxEncoder(txConfig.TxEncoder())
 
+	govEventStream := govstream.NewServer(govstream.ConfigFromAppOptions(appOpts))
+	bApp.SetStreamingService(govEventStream)
+
 	app := &AtomOneApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
@@ -134,6 +149,7 @@ func NewAtomOneApp(
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
+		govEventStream:    govEventStream,
 	}
 
 	moduleAccountAddresses := app.ModuleAccountAddrs()
@@ -193,6 +209,14 @@ func NewAtomOneApp(
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
 
+	// The proposal search index is built from the committed blocks and only
+	// serves the queries of the node, it is therefore optional.
+	if govsearch.ConfigFromAppOptions(appOpts).Enable {
+		app.govSearchIndex = govsearch.NewIndex(app.GovKeeper)
+		bApp.SetStreamingService(app.govSearchIndex)
+		govv1.RegisterSearchServer(app.GRPCQueryRouter(), app.govSearchIndex)
+	}
+
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	//
 	// NOTE: this is not required apps that don't use the simulator for fuzz testing
@@ -215,9 +239,12 @@ func NewAtomOneApp(
 				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			Codec:         appCodec,
-			StakingKeeper: app.StakingKeeper,
-			// If TxFeeChecker is nil the default ante TxFeeChecker is used
+			Codec:            appCodec,
+			StakingKeeper:    app.StakingKeeper,
+			GovKeeper:        app.GovKeeper,
+			PhotonKeeper:     app.PhotonKeeper,
+			DynamicfeeKeeper: app.DynamicfeeKeeper,
+			// If TxFeeChecker is nil the dynamicfee TxFeeChecker is used
 			TxFeeChecker: nil,
 		},
 	)
@@ -226,6 +253,23 @@ func NewAtomOneApp(
 	}
 
 	app.SetAnteHandler(anteHandler)
+
+	// The proposal handler reserves block space for the gov votes when a
+	// proposal is close to its voting deadline.
+	voteLaneConfig, err := atomonemempool.VoteLaneConfigFromAppOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	proposalHandler := atomonemempool.NewVoteLaneProposalHandler(
+		app.Mempool(),
+		app,
+		txConfig.TxEncoder(),
+		txConfig.TxDecoder(),
+		app.GovKeeper,
+		voteLaneConfig,
+	)
+	app.SetPrepareProposal(proposalHandler.PrepareProposalHandler())
+	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
@@ -287,6 +331,9 @@ func (app *AtomOneApp) ModuleAccountAddrs() map[string]bool {
 func (app *AtomOneApp) BlockedModuleAccountAddrs(modAccAddrs map[string]bool) map[string]bool {
 	// remove module accounts that are ALLOWED to received funds
 	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the treasury module account receives the community pool funds granted
+	// to the streams
+	delete(modAccAddrs, authtypes.NewModuleAddress(treasurytypes.ModuleName).String())
 
 	return modAccAddrs
 }
@@ -332,12 +379,28 @@ func (app *AtomOneApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.AP
 	// Register nodeservice grpc-gateway routes.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the gov proposal search grpc-gateway routes if the index is
+	// enabled.
+	if app.govSearchIndex != nil {
+		if err := govv1.RegisterSearchHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, govv1.NewSearchClient(clientCtx)); err != nil {
+			panic(err)
+		}
+	}
+
 	// register swagger API from root so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
 	}
 }
 
+// RegisterGRPCServer registers gRPC services directly with the gRPC server,
+// including the streaming services which can't be served through the
+// GRPCQueryRouter.
+func (app *AtomOneApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	govv1.RegisterStreamServer(server, app.govEventStream)
+}
+
 // RegisterTxService allows query minimum-gas-prices in app.toml
 func (app *AtomOneApp) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
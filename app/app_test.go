@@ -13,6 +13,7 @@ import (
 	atomone "github.com/atomone-hub/atomone/app"
 	atomonehelpers "github.com/atomone-hub/atomone/app/helpers"
 	govtypes "github.com/atomone-hub/atomone/x/gov/types"
+	treasurytypes "github.com/atomone-hub/atomone/x/treasury/types"
 )
 
 type EmptyAppOptions struct{}
@@ -38,6 +39,7 @@ func TestAtomOneApp_BlockedModuleAccountAddrs(t *testing.T) {
 	blockedAddrs := app.BlockedModuleAccountAddrs(moduleAccountAddresses)
 
 	require.NotContains(t, blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	require.NotContains(t, blockedAddrs, authtypes.NewModuleAddress(treasurytypes.ModuleName).String())
 }
 
 func TestAtomOneApp_Export(t *testing.T) {
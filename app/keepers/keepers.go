@@ -45,10 +45,16 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	dynamicfeekeeper "github.com/atomone-hub/atomone/x/dynamicfee/keeper"
+	dynamicfeetypes "github.com/atomone-hub/atomone/x/dynamicfee/types"
 	govkeeper "github.com/atomone-hub/atomone/x/gov/keeper"
 	govtypes "github.com/atomone-hub/atomone/x/gov/types"
 	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	govv1beta1 "github.com/atomone-hub/atomone/x/gov/types/v1beta1"
+	photonkeeper "github.com/atomone-hub/atomone/x/photon/keeper"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
+	treasurykeeper "github.com/atomone-hub/atomone/x/treasury/keeper"
+	treasurytypes "github.com/atomone-hub/atomone/x/treasury/types"
 )
 
 type AppKeepers struct {
@@ -73,6 +79,9 @@ type AppKeepers struct {
 	FeeGrantKeeper        feegrantkeeper.Keeper
 	AuthzKeeper           authzkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
+	PhotonKeeper          *photonkeeper.Keeper
+	DynamicfeeKeeper      *dynamicfeekeeper.Keeper
+	TreasuryKeeper        *treasurykeeper.Keeper
 }
 
 func NewAppKeeper(
@@ -207,15 +216,6 @@ func NewAppKeeper(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	// register the staking hooks
-	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	appKeepers.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(
-			appKeepers.DistrKeeper.Hooks(),
-			appKeepers.SlashingKeeper.Hooks(),
-		),
-	)
-
 	// UpgradeKeeper must be created before IBCKeeper
 	appKeepers.UpgradeKeeper = upgradekeeper.NewKeeper(
 		skipUpgradeHeights,
@@ -230,6 +230,7 @@ func NewAppKeeper(
 	govConfig := govtypes.DefaultConfig()
 	// set the MaxMetadataLen for proposals to the same value as it was pre-sdk v0.47.x
 	govConfig.MaxMetadataLen = 10200
+	govConfig.QueryConfig = govtypes.QueryConfigFromAppOptions(appOpts)
 	appKeepers.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[govtypes.StoreKey],
@@ -241,6 +242,17 @@ func NewAppKeeper(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// register the staking hooks, after the gov keeper which shrinks the stake
+	// locked by votes when it is slashed
+	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	appKeepers.StakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(
+			appKeepers.DistrKeeper.Hooks(),
+			appKeepers.SlashingKeeper.Hooks(),
+			appKeepers.GovKeeper.StakingHooks(),
+		),
+	)
+
 	// Register the proposal types
 	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
 	// by granting the governance module the right to execute the message.
@@ -257,6 +269,42 @@ func NewAppKeeper(
 	// Set legacy router for backwards compatibility with gov v1beta1
 	appKeepers.GovKeeper.SetLegacyRouter(govRouter)
 
+	// Register the validators of the governance-adjustable params, the params
+	// update messages without a registered validator are not restricted.
+	appKeepers.GovKeeper.SetParamsRegistry(
+		govtypes.NewParamsRegistry().
+			Register(sdk.MsgTypeURL(&photontypes.MsgUpdateParams{}), photonkeeper.NewParamsValidator(bApp.MsgServiceRouter())),
+	)
+
+	appKeepers.PhotonKeeper = photonkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[photontypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.StakingKeeper,
+	)
+
+	appKeepers.DynamicfeeKeeper = dynamicfeekeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[dynamicfeetypes.StoreKey],
+		appKeepers.tkeys[dynamicfeetypes.TStoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.TreasuryKeeper = treasurykeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[treasurytypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		appKeepers.MintKeeper,
+	)
+	// the treasury streams are created from the community pool, and are
+	// subject to the gov community pool spend limit
+	appKeepers.GovKeeper.SetTreasuryKeeper(appKeepers.TreasuryKeeper)
+
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[evidencetypes.StoreKey],
@@ -37,9 +37,15 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	atomoneappparams "github.com/atomone-hub/atomone/app/params"
+	"github.com/atomone-hub/atomone/x/dynamicfee"
+	dynamicfeetypes "github.com/atomone-hub/atomone/x/dynamicfee/types"
 	"github.com/atomone-hub/atomone/x/gov"
 	govclient "github.com/atomone-hub/atomone/x/gov/client"
 	govtypes "github.com/atomone-hub/atomone/x/gov/types"
+	"github.com/atomone-hub/atomone/x/photon"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
+	"github.com/atomone-hub/atomone/x/treasury"
+	treasurytypes "github.com/atomone-hub/atomone/x/treasury/types"
 )
 
 var maccPerms = map[string][]string{
@@ -49,6 +55,8 @@ var maccPerms = map[string][]string{
 	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	govtypes.ModuleName:            {authtypes.Burner},
+	photontypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
+	treasurytypes.ModuleName:       nil,
 	// liquiditytypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
 }
 
@@ -80,6 +88,9 @@ var ModuleBasics = module.NewBasicManager(
 	evidence.AppModuleBasic{},
 	vesting.AppModuleBasic{},
 	consensus.AppModuleBasic{},
+	photon.AppModuleBasic{},
+	dynamicfee.AppModuleBasic{},
+	treasury.AppModuleBasic{},
 )
 
 func appModules(
@@ -112,6 +123,9 @@ func appModules(
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		sdkparams.NewAppModule(app.ParamsKeeper),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
+		photon.NewAppModule(appCodec, app.PhotonKeeper),
+		dynamicfee.NewAppModule(appCodec, app.DynamicfeeKeeper),
+		treasury.NewAppModule(appCodec, app.TreasuryKeeper, app.AccountKeeper),
 	}
 }
 
@@ -137,6 +151,9 @@ func simulationModules(
 		sdkparams.NewAppModule(app.ParamsKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		photon.NewAppModule(appCodec, app.PhotonKeeper),
+		dynamicfee.NewAppModule(appCodec, app.DynamicfeeKeeper),
+		treasury.NewAppModule(appCodec, app.TreasuryKeeper, app.AccountKeeper),
 	}
 }
 
@@ -158,6 +175,8 @@ func orderBeginBlockers() []string {
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
 		minttypes.ModuleName,
+		// treasury collects its mint share before distribution allocates the fees
+		treasurytypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -172,6 +191,8 @@ func orderBeginBlockers() []string {
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		photontypes.ModuleName,
+		dynamicfeetypes.ModuleName,
 	}
 }
 
@@ -202,6 +223,9 @@ func orderEndBlockers() []string {
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		photontypes.ModuleName,
+		dynamicfeetypes.ModuleName,
+		treasurytypes.ModuleName,
 	}
 }
 
@@ -232,5 +256,8 @@ func orderInitBlockers() []string {
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
+		photontypes.ModuleName,
+		dynamicfeetypes.ModuleName,
+		treasurytypes.ModuleName,
 	}
 }
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	tmjson "github.com/cometbft/cometbft/libs/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/atomone-hub/atomone/x/gov/migrations/fromsdk"
+	govtypes "github.com/atomone-hub/atomone/x/gov/types"
+)
+
+// MigrateSDKGovCmd returns migrate-sdk-gov cobra Command.
+func MigrateSDKGovCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-sdk-gov [genesis-file]",
+		Short: "Migrate the stock cosmos-sdk x/gov state of a genesis file to the AtomOne x/gov state",
+		Long: `Migrate the stock cosmos-sdk v0.47 x/gov state of an exported genesis file to
+the AtomOne x/gov state, and print the migrated genesis file to STDOUT.
+
+Example:
+	atomoned genesis migrate-sdk-gov /path/to/genesis.json > /path/to/migrated-genesis.json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			govGenState, ok := appState[govtypes.ModuleName]
+			if !ok {
+				return fmt.Errorf("no %s state in genesis file", govtypes.ModuleName)
+			}
+
+			appState[govtypes.ModuleName], err = fromsdk.MigrateJSON(govGenState)
+			if err != nil {
+				return fmt.Errorf("failed to migrate %s state: %w", govtypes.ModuleName, err)
+			}
+
+			genDoc.AppState, err = json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal app state: %w", err)
+			}
+
+			bz, err := tmjson.Marshal(genDoc)
+			if err != nil {
+				return fmt.Errorf("failed to marshal genesis doc: %w", err)
+			}
+
+			sortedBz, err := sdk.SortJSON(bz)
+			if err != nil {
+				return fmt.Errorf("failed to sort JSON genesis doc: %w", err)
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(sortedBz))
+			return err
+		},
+	}
+
+	return cmd
+}
@@ -34,6 +34,7 @@ import (
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -42,6 +43,10 @@ import (
 
 	atomone "github.com/atomone-hub/atomone/app"
 	"github.com/atomone-hub/atomone/app/params"
+	atomonemempool "github.com/atomone-hub/atomone/mempool"
+	govsearch "github.com/atomone-hub/atomone/x/gov/search"
+	govstream "github.com/atomone-hub/atomone/x/gov/stream"
+	govtypes "github.com/atomone-hub/atomone/x/gov/types"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -107,6 +112,11 @@ func initAppConfig() (string, interface{}) {
 	// Embed additional configurations
 	type CustomAppConfig struct {
 		serverconfig.Config
+
+		VoteLane  atomonemempool.VoteLaneConfig `mapstructure:"vote-lane"`
+		GovSearch govsearch.Config              `mapstructure:"gov-search"`
+		GovStream govstream.Config              `mapstructure:"gov-stream"`
+		GovQuery  govtypes.QueryConfig          `mapstructure:"gov-query"`
 	}
 
 	// Can optionally overwrite the SDK's default server config.
@@ -115,10 +125,18 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.StateSync.SnapshotKeepRecent = 10
 
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
+		Config:    *srvCfg,
+		VoteLane:  atomonemempool.DefaultVoteLaneConfig(),
+		GovSearch: govsearch.DefaultConfig(),
+		GovStream: govstream.DefaultConfig(),
+		GovQuery:  govtypes.DefaultQueryConfig(),
 	}
 
-	defaultAppTemplate := serverconfig.DefaultConfigTemplate
+	defaultAppTemplate := serverconfig.DefaultConfigTemplate +
+		atomonemempool.VoteLaneConfigTemplate +
+		govsearch.ConfigTemplate +
+		govstream.ConfigTemplate +
+		govtypes.QueryConfigTemplate
 
 	return defaultAppTemplate, customAppConfig
 }
@@ -143,7 +161,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
-		genesisCommand(encodingConfig),
+		genesisCommand(encodingConfig, MigrateSDKGovCmd()),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(atomone.DefaultNodeHome),
@@ -284,6 +302,13 @@ func (a appCreator) newApp(
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
 		baseapp.SetSnapshot(snapshotStore, snapshotOptions),
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(server.FlagIAVLCacheSize))),
+		// the app-side mempool orders the transactions by priority, which the
+		// vote lane of the proposal handler selects from.
+		baseapp.SetMempool(
+			mempool.NewPriorityMempool(
+				mempool.PriorityNonceWithMaxTx(cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs))),
+			),
+		),
 	}
 
 	return atomone.NewAtomOneApp(
@@ -0,0 +1,209 @@
+// Package indexer implements the governance events indexer served by
+// govindexerd. It tails the ABCI events of the blocks committed by a node,
+// keeps the proposal phase changes and votes they report, and pushes them to
+// the clients of its REST and WebSocket endpoints.
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+)
+
+// subscriberBufferSize is the number of notifications buffered for each
+// subscriber. Subscribers falling further behind are disconnected.
+const subscriberBufferSize = 256
+
+// Client is the subset of the CometBFT RPC client used by the Indexer.
+type Client interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+}
+
+// Indexer tails the blocks committed by a node and records the governance
+// notifications they report. It keeps the last notifications in memory and
+// broadcasts the new ones to its subscribers.
+type Indexer struct {
+	client       Client
+	logger       log.Logger
+	pollInterval time.Duration
+	historySize  int
+
+	mtx           sync.Mutex
+	nextHeight    int64
+	history       []Notification
+	lastSequence  uint64
+	subscribers   map[uint64]chan Notification
+	nextSubscribe uint64
+}
+
+// New returns an Indexer reading blocks from client, starting at startHeight,
+// or at the latest block if startHeight is zero, and keeping the last
+// historySize notifications in memory.
+func New(client Client, logger log.Logger, startHeight int64, pollInterval time.Duration, historySize int) *Indexer {
+	return &Indexer{
+		client:       client,
+		logger:       logger,
+		pollInterval: pollInterval,
+		historySize:  historySize,
+		nextHeight:   startHeight,
+		subscribers:  make(map[uint64]chan Notification),
+	}
+}
+
+// Run indexes the committed blocks until ctx is done. Errors returned by the
+// node are logged and the block is retried on the next poll.
+func (idx *Indexer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(idx.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := idx.indexNewBlocks(ctx); err != nil && ctx.Err() == nil {
+			idx.logger.Error("failed to index blocks", "height", idx.NextHeight(), "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			idx.close()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NextHeight returns the height of the next block to index.
+func (idx *Indexer) NextHeight() int64 {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	return idx.nextHeight
+}
+
+// Notifications returns the notifications kept in memory with a sequence
+// greater than afterSequence, optionally restricted to the given proposal. A
+// zero proposalID matches every proposal.
+func (idx *Indexer) Notifications(afterSequence, proposalID uint64) []Notification {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	notifications := []Notification{}
+	for _, n := range idx.history {
+		if n.Sequence > afterSequence && matchesProposal(n, proposalID) {
+			notifications = append(notifications, n)
+		}
+	}
+	return notifications
+}
+
+// Subscribe registers a new subscriber to the notifications indexed from now
+// on. The returned channel is closed when the subscriber is unsubscribed, falls
+// too far behind or when the indexer stops.
+func (idx *Indexer) Subscribe() (uint64, <-chan Notification) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	id := idx.nextSubscribe
+	idx.nextSubscribe++
+	ch := make(chan Notification, subscriberBufferSize)
+	idx.subscribers[id] = ch
+	return id, ch
+}
+
+// Unsubscribe removes the subscriber with the given ID.
+func (idx *Indexer) Unsubscribe(id uint64) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if ch, ok := idx.subscribers[id]; ok {
+		close(ch)
+		delete(idx.subscribers, id)
+	}
+}
+
+// indexNewBlocks indexes the blocks committed since the last indexed one.
+func (idx *Indexer) indexNewBlocks(ctx context.Context) error {
+	status, err := idx.client.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query node status: %w", err)
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+
+	idx.mtx.Lock()
+	if idx.nextHeight == 0 {
+		idx.nextHeight = latest
+	}
+	height := idx.nextHeight
+	idx.mtx.Unlock()
+
+	for ; height <= latest; height++ {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		res, err := idx.client.BlockResults(ctx, &height)
+		if err != nil {
+			return fmt.Errorf("failed to query block results: %w", err)
+		}
+
+		// the events are reported in the order they were emitted in
+		events := res.BeginBlockEvents
+		for _, tx := range res.TxsResults {
+			if tx.IsOK() {
+				events = append(events, tx.Events...)
+			}
+		}
+		events = append(events, res.EndBlockEvents...)
+
+		idx.publish(height, notificationsFromEvents(height, events))
+	}
+	return nil
+}
+
+// publish records the notifications of the block at the given height and
+// broadcasts them to the subscribers.
+func (idx *Indexer) publish(height int64, notifications []Notification) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.nextHeight = height + 1
+	for _, n := range notifications {
+		idx.lastSequence++
+		n.Sequence = idx.lastSequence
+
+		idx.history = append(idx.history, n)
+		if len(idx.history) > idx.historySize {
+			idx.history = idx.history[len(idx.history)-idx.historySize:]
+		}
+
+		for id, ch := range idx.subscribers {
+			select {
+			case ch <- n:
+			default:
+				// never block the indexing on a slow subscriber
+				close(ch)
+				delete(idx.subscribers, id)
+			}
+		}
+	}
+}
+
+// close disconnects all the subscribers.
+func (idx *Indexer) close() {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	for id, ch := range idx.subscribers {
+		close(ch)
+		delete(idx.subscribers, id)
+	}
+}
+
+// matchesProposal returns true if the notification is about the given
+// proposal. A zero proposalID matches every proposal.
+func matchesProposal(n Notification, proposalID uint64) bool {
+	return proposalID == 0 || n.ProposalID == proposalID
+}
@@ -0,0 +1,182 @@
+package indexer_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/atomone-hub/atomone/cmd/govindexerd/indexer"
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+type mockClient struct {
+	mtx    sync.Mutex
+	blocks []*coretypes.ResultBlockResults
+}
+
+func (m *mockClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return &coretypes.ResultStatus{
+		SyncInfo: coretypes.SyncInfo{LatestBlockHeight: int64(len(m.blocks))},
+	}, nil
+}
+
+func (m *mockClient) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.blocks[*height-1], nil
+}
+
+func (m *mockClient) addBlock(txEvents, endBlockEvents []abci.Event) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.blocks = append(m.blocks, &coretypes.ResultBlockResults{
+		Height:         int64(len(m.blocks) + 1),
+		TxsResults:     []*abci.ResponseDeliverTx{{Events: txEvents}},
+		EndBlockEvents: endBlockEvents,
+	})
+}
+
+func event(typ string, attrs ...string) abci.Event {
+	event := abci.Event{Type: typ}
+	for i := 0; i < len(attrs); i += 2 {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+	}
+	return event
+}
+
+func runIndexer(t *testing.T, client *mockClient) *indexer.Indexer {
+	t.Helper()
+
+	idx := indexer.New(client, log.NewNopLogger(), 1, 10*time.Millisecond, 100)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go idx.Run(ctx) //nolint:errcheck
+	return idx
+}
+
+func TestIndexerNotifications(t *testing.T) {
+	client := &mockClient{}
+	client.addBlock(
+		[]abci.Event{
+			event(types.EventTypeSubmitProposal, types.AttributeKeyProposalID, "1"),
+			event(types.EventTypeProposalDeposit, types.AttributeKeyVotingPeriodStart, "1"),
+			event("transfer", "amount", "1uatone"),
+		},
+		nil,
+	)
+	client.addBlock(
+		[]abci.Event{
+			event(types.EventTypeProposalVote,
+				types.AttributeKeyVoter, "atone1voter",
+				types.AttributeKeyOption, "option:VOTE_OPTION_YES weight:\"1.000000000000000000\"",
+				types.AttributeKeyProposalID, "1",
+				types.AttributeKeyVotingPower, "10.000000000000000000",
+			),
+		},
+		[]abci.Event{
+			event(types.EventTypeActiveProposal,
+				types.AttributeKeyProposalID, "1",
+				types.AttributeKeyProposalResult, types.AttributeValueProposalPassed,
+			),
+			event(types.EventTypeInactiveProposal,
+				types.AttributeKeyProposalID, "2",
+				types.AttributeKeyProposalResult, types.AttributeValueProposalDropped,
+			),
+			event(types.EventTypeRecurringProposal,
+				types.AttributeKeyRecurringProposalID, "1",
+				types.AttributeKeyProposalID, "3",
+				types.AttributeKeyVotingPeriodStart, "3",
+			),
+		},
+	)
+
+	idx := runIndexer(t, client)
+	require.Eventually(t, func() bool { return idx.NextHeight() == 3 }, time.Second, 10*time.Millisecond)
+
+	require.Equal(t, []indexer.Notification{
+		{Sequence: 1, Height: 1, Type: indexer.NotificationTypePhase, ProposalID: 1, Phase: indexer.PhaseDepositPeriod},
+		{Sequence: 2, Height: 1, Type: indexer.NotificationTypePhase, ProposalID: 1, Phase: indexer.PhaseVotingPeriod},
+		{
+			Sequence: 3, Height: 2, Type: indexer.NotificationTypeVote, ProposalID: 1,
+			Voter: "atone1voter", Option: "option:VOTE_OPTION_YES weight:\"1.000000000000000000\"", VotingPower: "10.000000000000000000",
+		},
+		{Sequence: 4, Height: 2, Type: indexer.NotificationTypePhase, ProposalID: 1, Phase: indexer.PhasePassed},
+		{Sequence: 5, Height: 2, Type: indexer.NotificationTypePhase, ProposalID: 2, Phase: indexer.PhaseDropped},
+		{Sequence: 6, Height: 2, Type: indexer.NotificationTypePhase, ProposalID: 3, Phase: indexer.PhaseVotingPeriod},
+	}, idx.Notifications(0, 0))
+
+	// filters
+	require.Len(t, idx.Notifications(3, 0), 3)
+	require.Len(t, idx.Notifications(0, 2), 1)
+	require.Empty(t, idx.Notifications(6, 0))
+}
+
+func TestHandler(t *testing.T) {
+	client := &mockClient{}
+	client.addBlock([]abci.Event{
+		event(types.EventTypeSubmitProposal, types.AttributeKeyProposalID, "1"),
+		event(types.EventTypeSubmitProposal, types.AttributeKeyProposalID, "2"),
+	}, nil)
+
+	idx := runIndexer(t, client)
+	require.Eventually(t, func() bool { return idx.NextHeight() == 2 }, time.Second, 10*time.Millisecond)
+
+	server := httptest.NewServer(indexer.NewHandler(idx, log.NewNopLogger()))
+	defer server.Close()
+
+	// REST
+	res, err := http.Get(server.URL + "/notifications?proposal_id=2")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	var notifications indexer.NotificationsResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&notifications))
+	require.Equal(t, int64(2), notifications.NextHeight)
+	require.Len(t, notifications.Notifications, 1)
+	require.Equal(t, uint64(2), notifications.Notifications[0].ProposalID)
+
+	res, err = http.Get(server.URL + "/notifications?proposal_id=x")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	// WebSocket: the history after the given sequence is sent first, followed
+	// by the new notifications of the proposal
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws?proposal_id=1"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var n indexer.Notification
+	require.NoError(t, conn.ReadJSON(&n))
+	require.Equal(t, uint64(1), n.Sequence)
+	require.Equal(t, indexer.PhaseDepositPeriod, n.Phase)
+
+	client.addBlock([]abci.Event{
+		event(types.EventTypeProposalDeposit, types.AttributeKeyVotingPeriodStart, "2"),
+		event(types.EventTypeProposalDeposit, types.AttributeKeyReviewPeriodStart, "1"),
+	}, nil)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	require.NoError(t, conn.ReadJSON(&n))
+	require.Equal(t, uint64(4), n.Sequence)
+	require.Equal(t, uint64(1), n.ProposalID)
+	require.Equal(t, indexer.PhaseReviewPeriod, n.Phase)
+}
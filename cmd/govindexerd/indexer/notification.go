@@ -0,0 +1,142 @@
+package indexer
+
+import (
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+// Notification types.
+const (
+	// NotificationTypePhase is the type of the notifications of a proposal
+	// entering a new phase.
+	NotificationTypePhase = "proposal_phase"
+	// NotificationTypeVote is the type of the notifications of a vote cast on
+	// a proposal.
+	NotificationTypeVote = "proposal_vote"
+)
+
+// Proposal phases reported by the NotificationTypePhase notifications.
+const (
+	PhaseDepositPeriod = "deposit_period"
+	PhaseReviewPeriod  = "review_period"
+	PhaseVotingPeriod  = "voting_period"
+	PhasePassed        = "passed"
+	PhaseRejected      = "rejected"
+	PhaseFailed        = "failed"
+	PhaseQueued        = "queued"
+	PhaseDropped       = "dropped"
+)
+
+// resultPhases maps the proposal_result attribute values of the gov events to
+// the phase they report.
+var resultPhases = map[string]string{
+	types.AttributeValueProposalPassed:   PhasePassed,
+	types.AttributeValueProposalRejected: PhaseRejected,
+	types.AttributeValueProposalFailed:   PhaseFailed,
+	types.AttributeValueProposalQueued:   PhaseQueued,
+	types.AttributeValueProposalDropped:  PhaseDropped,
+}
+
+// Notification is a governance notification pushed to the indexer clients.
+type Notification struct {
+	// Sequence is the position of the notification in the indexer history,
+	// starting at 1.
+	Sequence uint64 `json:"sequence"`
+	// Height is the height of the block the notification was emitted in.
+	Height int64 `json:"height"`
+	// Type is either NotificationTypePhase or NotificationTypeVote.
+	Type       string `json:"type"`
+	ProposalID uint64 `json:"proposal_id"`
+	// Phase is the phase the proposal entered, set for the
+	// NotificationTypePhase notifications.
+	Phase string `json:"phase,omitempty"`
+	// Voter, Option and VotingPower describe the vote, set for the
+	// NotificationTypeVote notifications.
+	Voter       string `json:"voter,omitempty"`
+	Option      string `json:"option,omitempty"`
+	VotingPower string `json:"voting_power,omitempty"`
+}
+
+// notificationsFromEvents returns the notifications reported by the ABCI
+// events emitted at the given height, in the order of the events.
+func notificationsFromEvents(height int64, events []abci.Event) []Notification {
+	var notifications []Notification
+	for _, event := range events {
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+
+		switch event.Type {
+		case types.EventTypeSubmitProposal:
+			// a proposal submitted with the min deposit emits a second
+			// submit_proposal event holding the voting period start
+			if n, ok := phaseNotification(height, attrs[types.AttributeKeyProposalID], PhaseDepositPeriod); ok {
+				notifications = append(notifications, n)
+			}
+			if n, ok := phaseNotification(height, attrs[types.AttributeKeyVotingPeriodStart], PhaseVotingPeriod); ok {
+				notifications = append(notifications, n)
+			}
+
+		case types.EventTypeProposalDeposit, types.EventTypeReviewProposal:
+			if n, ok := phaseNotification(height, attrs[types.AttributeKeyReviewPeriodStart], PhaseReviewPeriod); ok {
+				notifications = append(notifications, n)
+			}
+			if n, ok := phaseNotification(height, attrs[types.AttributeKeyVotingPeriodStart], PhaseVotingPeriod); ok {
+				notifications = append(notifications, n)
+			}
+
+		case types.EventTypeRecurringProposal:
+			// the proposals of the recurring proposals are submitted directly
+			// into the voting period
+			if n, ok := phaseNotification(height, attrs[types.AttributeKeyVotingPeriodStart], PhaseVotingPeriod); ok {
+				notifications = append(notifications, n)
+			}
+
+		case types.EventTypeActiveProposal, types.EventTypeInactiveProposal, types.EventTypeQueuedProposal:
+			phase, ok := resultPhases[attrs[types.AttributeKeyProposalResult]]
+			if !ok {
+				continue
+			}
+			if n, ok := phaseNotification(height, attrs[types.AttributeKeyProposalID], phase); ok {
+				notifications = append(notifications, n)
+			}
+
+		case types.EventTypeProposalVote:
+			proposalID, err := strconv.ParseUint(attrs[types.AttributeKeyProposalID], 10, 64)
+			if err != nil {
+				continue
+			}
+			notifications = append(notifications, Notification{
+				Height:      height,
+				Type:        NotificationTypeVote,
+				ProposalID:  proposalID,
+				Voter:       attrs[types.AttributeKeyVoter],
+				Option:      attrs[types.AttributeKeyOption],
+				VotingPower: attrs[types.AttributeKeyVotingPower],
+			})
+		}
+	}
+	return notifications
+}
+
+// phaseNotification returns the notification of the proposal with the given
+// ID entering phase, and false if the ID is missing or invalid.
+func phaseNotification(height int64, id, phase string) (Notification, bool) {
+	if id == "" {
+		return Notification{}, false
+	}
+	proposalID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return Notification{}, false
+	}
+	return Notification{
+		Height:     height,
+		Type:       NotificationTypePhase,
+		ProposalID: proposalID,
+		Phase:      phase,
+	}, true
+}
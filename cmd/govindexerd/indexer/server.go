@@ -0,0 +1,148 @@
+package indexer
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// writeTimeout is the time allowed to write a notification to a WebSocket
+// client.
+const writeTimeout = 10 * time.Second
+
+// NotificationsResponse is the response of the REST notifications endpoint.
+type NotificationsResponse struct {
+	// Notifications are the notifications matching the request, in the order
+	// they were indexed.
+	Notifications []Notification `json:"notifications"`
+	// NextHeight is the height of the next block to be indexed.
+	NextHeight int64 `json:"next_height"`
+}
+
+// NewHandler returns the HTTP handler serving the indexer notifications:
+//
+//   - GET /notifications returns the notifications kept in memory, as a
+//     NotificationsResponse.
+//   - GET /ws upgrades the connection to a WebSocket pushing each new
+//     notification as a JSON message.
+//
+// Both endpoints accept an optional proposal_id query parameter restricting
+// the notifications to a single proposal, and an optional after parameter
+// skipping the notifications up to the given sequence. Over WebSocket, the
+// notifications kept in memory after that sequence are sent first.
+func NewHandler(idx *Indexer, logger log.Logger) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/notifications", func(w http.ResponseWriter, r *http.Request) {
+		proposalID, after, ok := parseFilters(w, r)
+		if !ok {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(NotificationsResponse{
+			Notifications: idx.Notifications(after, proposalID),
+			NextHeight:    idx.NextHeight(),
+		})
+	})
+
+	upgrader := websocket.Upgrader{
+		// the notifications are public, any origin can subscribe to them
+		CheckOrigin: func(*http.Request) bool { return true },
+	}
+	mux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
+		proposalID, after, ok := parseFilters(w, r)
+		if !ok {
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader already replied with an error
+			return
+		}
+		defer conn.Close()
+
+		// subscribe before reading the history so that no notification
+		// indexed in between is missed
+		id, ch := idx.Subscribe()
+		defer idx.Unsubscribe(id)
+
+		// the client isn't expected to send anything, but the connection must
+		// be read to process the control messages and detect its closing
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		last := after
+		for _, n := range idx.Notifications(after, proposalID) {
+			if err := writeNotification(conn, n); err != nil {
+				return
+			}
+			last = n.Sequence
+		}
+
+		for {
+			select {
+			case <-closed:
+				return
+
+			case n, ok := <-ch:
+				if !ok {
+					// too slow or indexer stopped
+					_ = conn.WriteControl(websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.CloseGoingAway, "subscription closed"),
+						time.Now().Add(writeTimeout))
+					return
+				}
+				if n.Sequence <= last || !matchesProposal(n, proposalID) {
+					continue
+				}
+				if err := writeNotification(conn, n); err != nil {
+					logger.Debug("failed to write notification", "err", err)
+					return
+				}
+			}
+		}
+	})
+	return mux
+}
+
+// parseFilters parses the proposal_id and after query parameters of r, and
+// replies with a bad request error if they are invalid.
+func parseFilters(w http.ResponseWriter, r *http.Request) (proposalID, after uint64, ok bool) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return 0, 0, false
+	}
+
+	query := r.URL.Query()
+	for param, value := range map[string]*uint64{"proposal_id": &proposalID, "after": &after} {
+		if s := query.Get(param); s != "" {
+			v, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				http.Error(w, "invalid "+param+": "+err.Error(), http.StatusBadRequest)
+				return 0, 0, false
+			}
+			*value = v
+		}
+	}
+	return proposalID, after, true
+}
+
+func writeNotification(conn *websocket.Conn, n Notification) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+	return conn.WriteJSON(n)
+}
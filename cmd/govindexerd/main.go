@@ -0,0 +1,101 @@
+// govindexerd is an optional out-of-process governance events indexer. It
+// tails the ABCI events of the blocks committed by an AtomOne node through its
+// CometBFT RPC, and serves the proposal phase changes and votes they report
+// over REST and WebSocket, for bots and alerting.
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/libs/log"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+
+	"github.com/atomone-hub/atomone/cmd/govindexerd/indexer"
+)
+
+const (
+	flagNode         = "node"
+	flagListen       = "listen"
+	flagStartHeight  = "start-height"
+	flagPollInterval = "poll-interval"
+	flagHistorySize  = "history-size"
+)
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "govindexerd",
+		Short: "Index the governance events of an AtomOne node and serve them over REST and WebSocket",
+		Long: `Tail the ABCI events of the blocks committed by an AtomOne node and serve the
+proposal phase changes and votes they report:
+
+  GET /notifications[?proposal_id=<id>][&after=<sequence>]
+  GET /ws[?proposal_id=<id>][&after=<sequence>]   (WebSocket)
+
+The last notifications are kept in memory only, clients resuming after a
+restart of the indexer should set --start-height to replay older blocks.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			node, _ := cmd.Flags().GetString(flagNode)
+			listen, _ := cmd.Flags().GetString(flagListen)
+			startHeight, _ := cmd.Flags().GetInt64(flagStartHeight)
+			pollInterval, _ := cmd.Flags().GetDuration(flagPollInterval)
+			historySize, _ := cmd.Flags().GetInt(flagHistorySize)
+			if startHeight < 0 || pollInterval <= 0 || historySize <= 0 {
+				return errors.New("start height must not be negative, poll interval and history size must be positive")
+			}
+
+			client, err := rpchttp.New(node, "/websocket")
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(cmd.OutOrStdout()))
+			idx := indexer.New(client, logger, startHeight, pollInterval, historySize)
+			server := &http.Server{
+				Addr:              listen,
+				Handler:           indexer.NewHandler(idx, logger),
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			go func() {
+				<-ctx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				_ = server.Shutdown(shutdownCtx)
+			}()
+			go func() {
+				_ = idx.Run(ctx)
+			}()
+
+			logger.Info("serving governance notifications", "listen", listen, "node", node)
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagNode, "tcp://localhost:26657", "CometBFT RPC address of the node to index")
+	cmd.Flags().String(flagListen, "localhost:8090", "Address to serve the REST and WebSocket endpoints on")
+	cmd.Flags().Int64(flagStartHeight, 0, "Height of the first block to index, the latest block if 0")
+	cmd.Flags().Duration(flagPollInterval, time.Second, "Interval between two polls of the node for new blocks")
+	cmd.Flags().Int(flagHistorySize, 10000, "Number of notifications kept in memory")
+	return cmd
+}
@@ -0,0 +1,91 @@
+package mempool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	FlagVoteLaneDeadlineBlocks = "vote-lane.deadline-blocks"
+	FlagVoteLaneBlockTime      = "vote-lane.block-time"
+	FlagVoteLaneRatio          = "vote-lane.ratio"
+)
+
+// VoteLaneConfig defines the node configuration of the block space lane
+// reserved for the gov votes. As the lane only applies to the blocks proposed
+// by the node, it is not part of the consensus.
+type VoteLaneConfig struct {
+	// DeadlineBlocks is the number of blocks before the voting deadline of a
+	// proposal during which the lane is open. Zero disables the lane.
+	DeadlineBlocks uint64 `mapstructure:"deadline-blocks"`
+	// BlockTime is the expected block time, used to convert DeadlineBlocks to
+	// a duration.
+	BlockTime time.Duration `mapstructure:"block-time"`
+	// Ratio is the maximum share of the block bytes and gas reserved for the
+	// votes when the lane is open, between 0 and 1. Only the size and the gas
+	// limits of the vote transactions waiting in the mempool are reserved.
+	Ratio float64 `mapstructure:"ratio"`
+}
+
+// DefaultVoteLaneConfig returns the default vote lane configuration, which
+// reserves a fifth of the block space during the last 600 blocks of a voting
+// period.
+func DefaultVoteLaneConfig() VoteLaneConfig {
+	return VoteLaneConfig{
+		DeadlineBlocks: 600,
+		BlockTime:      6 * time.Second,
+		Ratio:          0.2,
+	}
+}
+
+// Validate returns an error if the ratio is not between 0 and 1.
+func (c VoteLaneConfig) Validate() error {
+	if !(c.Ratio >= 0 && c.Ratio <= 1) {
+		return fmt.Errorf("%s must be between 0 and 1: %v", FlagVoteLaneRatio, c.Ratio)
+	}
+	return nil
+}
+
+// VoteLaneConfigFromAppOptions reads the vote lane configuration from the app
+// options, falling back to the default values for the missing ones, and
+// validates it.
+func VoteLaneConfigFromAppOptions(appOpts servertypes.AppOptions) (VoteLaneConfig, error) {
+	cfg := DefaultVoteLaneConfig()
+	if v := appOpts.Get(FlagVoteLaneDeadlineBlocks); v != nil {
+		cfg.DeadlineBlocks = cast.ToUint64(v)
+	}
+	if v := appOpts.Get(FlagVoteLaneBlockTime); v != nil {
+		cfg.BlockTime = cast.ToDuration(v)
+	}
+	if v := appOpts.Get(FlagVoteLaneRatio); v != nil {
+		cfg.Ratio = cast.ToFloat64(v)
+	}
+	return cfg, cfg.Validate()
+}
+
+// VoteLaneConfigTemplate is the app.toml section of the vote lane
+// configuration.
+const VoteLaneConfigTemplate = `
+###############################################################################
+###                         Vote Lane Configuration                         ###
+###############################################################################
+
+[vote-lane]
+
+# Number of blocks before the voting deadline of a proposal during which a
+# share of the proposed blocks is reserved for the gov votes. Setting it to 0
+# disables the lane.
+deadline-blocks = {{ .VoteLane.DeadlineBlocks }}
+
+# Expected block time, used to convert deadline-blocks to a duration.
+block-time = "{{ .VoteLane.BlockTime }}"
+
+# Maximum share of the block bytes and gas reserved for the gov votes when the
+# lane is open, between 0 and 1. Only the size and the gas limits of the votes
+# waiting in the mempool are reserved.
+ratio = {{ .VoteLane.Ratio }}
+`
@@ -0,0 +1,26 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"github.com/atomone-hub/atomone/mempool"
+)
+
+func TestVoteLaneConfigFromAppOptions(t *testing.T) {
+	cfg, err := mempool.VoteLaneConfigFromAppOptions(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	require.Equal(t, mempool.DefaultVoteLaneConfig(), cfg)
+
+	cfg, err = mempool.VoteLaneConfigFromAppOptions(simtestutil.AppOptionsMap{mempool.FlagVoteLaneRatio: 1})
+	require.NoError(t, err)
+	require.Equal(t, float64(1), cfg.Ratio)
+
+	for _, ratio := range []float64{-0.1, 1.1} {
+		_, err = mempool.VoteLaneConfigFromAppOptions(simtestutil.AppOptionsMap{mempool.FlagVoteLaneRatio: ratio})
+		require.ErrorContains(t, err, "vote-lane.ratio must be between 0 and 1")
+	}
+}
@@ -0,0 +1,220 @@
+package mempool
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	govv1beta1 "github.com/atomone-hub/atomone/x/gov/types/v1beta1"
+)
+
+// GovKeeper defines the expected gov keeper, used to find the proposals close
+// to their voting deadline.
+type GovKeeper interface {
+	IterateActiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal govv1.Proposal) (stop bool))
+}
+
+// VoteLaneProposalHandler extends the SDK default proposal handler with a lane
+// of block space reserved for the gov votes, open while a proposal is close to
+// its voting deadline, so that the votes are not crowded out by fee spikes.
+// The lane is sized to the bytes and the gas limits of the vote transactions
+// waiting in the mempool, so no space is reserved when there is none.
+type VoteLaneProposalHandler struct {
+	*baseapp.DefaultProposalHandler
+
+	mempool   sdkmempool.Mempool
+	txEncoder sdk.TxEncoder
+	txDecoder sdk.TxDecoder
+	selector  *voteLaneTxSelector
+	govKeeper GovKeeper
+	config    VoteLaneConfig
+}
+
+// NewVoteLaneProposalHandler returns a VoteLaneProposalHandler selecting the
+// transactions from the given mempool, or from the transactions requested by
+// CometBFT if the mempool is a no-op mempool.
+func NewVoteLaneProposalHandler(
+	mp sdkmempool.Mempool, txVerifier baseapp.ProposalTxVerifier,
+	txEncoder sdk.TxEncoder, txDecoder sdk.TxDecoder,
+	govKeeper GovKeeper, config VoteLaneConfig,
+) *VoteLaneProposalHandler {
+	selector := &voteLaneTxSelector{txDecoder: txDecoder}
+	handler := baseapp.NewDefaultProposalHandler(mp, txVerifier)
+	handler.SetTxSelector(selector)
+
+	return &VoteLaneProposalHandler{
+		DefaultProposalHandler: handler,
+		mempool:                mp,
+		txEncoder:              txEncoder,
+		txDecoder:              txDecoder,
+		selector:               selector,
+		govKeeper:              govKeeper,
+		config:                 config,
+	}
+}
+
+// PrepareProposalHandler returns the default PrepareProposal handler, with the
+// vote lane reserved if a proposal is close to its voting deadline and vote
+// transactions are waiting in the mempool.
+func (h *VoteLaneProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	prepareProposal := h.DefaultProposalHandler.PrepareProposalHandler()
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		h.selector.reservedBytes, h.selector.reservedGas = 0, 0
+		if h.votingDeadlineNear(ctx) {
+			var maxBlockGas uint64
+			if b := ctx.ConsensusParams().Block; b != nil && b.MaxGas > 0 {
+				maxBlockGas = uint64(b.MaxGas)
+			}
+			maxReservedBytes := uint64(float64(req.MaxTxBytes) * h.config.Ratio)
+			maxReservedGas := uint64(float64(maxBlockGas) * h.config.Ratio)
+			h.selector.reservedBytes, h.selector.reservedGas = h.voteTxSpace(ctx, req.Txs, maxReservedBytes, maxReservedGas)
+		}
+		return prepareProposal(ctx, req)
+	}
+}
+
+// voteTxSpace returns the size and the sum of the gas limits of the vote
+// transactions waiting in the mempool, or in the transactions requested by
+// CometBFT if the mempool is a no-op mempool, capped to maxBytes and maxGas.
+func (h *VoteLaneProposalHandler) voteTxSpace(ctx sdk.Context, txs [][]byte, maxBytes, maxGas uint64) (uint64, uint64) {
+	var voteBytes, voteGas uint64
+	full := func() bool {
+		return voteBytes >= maxBytes && voteGas >= maxGas
+	}
+	addVoteTx := func(tx sdk.Tx, txBz []byte) {
+		voteBytes += uint64(len(txBz))
+		if gasTx, ok := tx.(baseapp.GasTx); ok {
+			voteGas += gasTx.GetGas()
+		}
+	}
+
+	if _, ok := h.mempool.(sdkmempool.NoOpMempool); ok {
+		for _, txBz := range txs {
+			if full() {
+				break
+			}
+			if tx, err := h.txDecoder(txBz); err == nil && IsVoteTx(tx) {
+				addVoteTx(tx, txBz)
+			}
+		}
+		return min(voteBytes, maxBytes), min(voteGas, maxGas)
+	}
+
+	iterator := h.mempool.Select(ctx, txs)
+	for iterator != nil && !full() {
+		if tx := iterator.Tx(); IsVoteTx(tx) {
+			if txBz, err := h.txEncoder(tx); err == nil {
+				addVoteTx(tx, txBz)
+			}
+		}
+		iterator = iterator.Next()
+	}
+	return min(voteBytes, maxBytes), min(voteGas, maxGas)
+}
+
+// votingDeadlineNear returns true if the voting period of a proposal ends in
+// the next DeadlineBlocks blocks.
+func (h *VoteLaneProposalHandler) votingDeadlineNear(ctx sdk.Context) bool {
+	if h.config.DeadlineBlocks == 0 || h.config.Ratio <= 0 {
+		return false
+	}
+
+	window := time.Duration(h.config.DeadlineBlocks) * h.config.BlockTime
+	found := false
+	h.govKeeper.IterateActiveProposalsQueue(ctx, ctx.BlockTime().Add(window), func(govv1.Proposal) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
+// IsVoteTx returns true if all the messages of the transaction are gov votes.
+func IsVoteTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *govv1.MsgVote, *govv1.MsgVoteWeighted, *govv1beta1.MsgVote, *govv1beta1.MsgVoteWeighted:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// voteLaneTxSelector is a baseapp.TxSelector which keeps reservedBytes and
+// reservedGas of the block for the vote transactions. The other transactions
+// are selected as long as they leave the reserved bytes and gas available, the
+// vote transactions as long as they fit in the block.
+type voteLaneTxSelector struct {
+	txDecoder     sdk.TxDecoder
+	reservedBytes uint64
+	reservedGas   uint64
+
+	totalTxBytes uint64
+	totalTxGas   uint64
+	otherTxBytes uint64
+	otherTxGas   uint64
+	selectedTxs  [][]byte
+}
+
+var _ baseapp.TxSelector = &voteLaneTxSelector{}
+
+func (ts *voteLaneTxSelector) SelectedTxs() [][]byte {
+	txs := make([][]byte, len(ts.selectedTxs))
+	copy(txs, ts.selectedTxs)
+	return txs
+}
+
+func (ts *voteLaneTxSelector) Clear() {
+	ts.totalTxBytes = 0
+	ts.totalTxGas = 0
+	ts.otherTxBytes = 0
+	ts.otherTxGas = 0
+	ts.selectedTxs = nil
+}
+
+func (ts *voteLaneTxSelector) SelectTxForProposal(maxTxBytes, maxBlockGas uint64, memTx sdk.Tx, txBz []byte) bool {
+	// the transactions requested from CometBFT are not decoded when the
+	// mempool is a no-op mempool.
+	if memTx == nil && (ts.reservedBytes > 0 || ts.reservedGas > 0) {
+		memTx, _ = ts.txDecoder(txBz)
+	}
+
+	txSize := uint64(len(txBz))
+	var txGasLimit uint64
+	if gasTx, ok := memTx.(baseapp.GasTx); ok {
+		txGasLimit = gasTx.GetGas()
+	}
+
+	isVote := memTx != nil && IsVoteTx(memTx)
+	fits := ts.totalTxBytes+txSize <= maxTxBytes &&
+		(maxBlockGas == 0 || ts.totalTxGas+txGasLimit <= maxBlockGas)
+	if !isVote && ts.reservedBytes > 0 {
+		fits = fits && ts.reservedBytes <= maxTxBytes && ts.otherTxBytes+txSize <= maxTxBytes-ts.reservedBytes
+	}
+	if !isVote && ts.reservedGas > 0 && maxBlockGas > 0 {
+		fits = fits && ts.reservedGas <= maxBlockGas && ts.otherTxGas+txGasLimit <= maxBlockGas-ts.reservedGas
+	}
+
+	if fits {
+		ts.totalTxBytes += txSize
+		ts.totalTxGas += txGasLimit
+		if !isVote {
+			ts.otherTxBytes += txSize
+			ts.otherTxGas += txGasLimit
+		}
+		ts.selectedTxs = append(ts.selectedTxs, txBz)
+	}
+
+	// check if we've reached capacity; if so, we cannot select any more transactions
+	return ts.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && ts.totalTxGas >= maxBlockGas)
+}
@@ -0,0 +1,175 @@
+package mempool_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	atomone "github.com/atomone-hub/atomone/app"
+	"github.com/atomone-hub/atomone/mempool"
+	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// mockGovKeeper holds the voting end times of the active proposals.
+type mockGovKeeper struct {
+	votingEndTimes []time.Time
+}
+
+func (k mockGovKeeper) IterateActiveProposalsQueue(_ sdk.Context, endTime time.Time, cb func(govv1.Proposal) bool) {
+	for _, t := range k.votingEndTimes {
+		if !t.After(endTime) && cb(govv1.Proposal{VotingEndTime: &t}) {
+			return
+		}
+	}
+}
+
+func TestVoteLanePrepareProposal(t *testing.T) {
+	encCfg := atomone.RegisterEncodingConfig()
+	addr := sdk.AccAddress("addr________________")
+	encodeTx := func(msg sdk.Msg, memo string) []byte {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetMemo(memo)
+		bz, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)))
+	sendTx1 := encodeTx(send, strings.Repeat("a", 100))
+	sendTx2 := encodeTx(send, strings.Repeat("b", 100))
+	voteTx := encodeTx(govv1.NewMsgVote(addr, 1, govv1.OptionYes, ""), "")
+	// the block can't hold the three transactions
+	maxTxBytes := int64(len(sendTx1) + len(sendTx2) + len(voteTx) - 1)
+	// the lane holds the vote transaction
+	ratio := float64(len(voteTx)+1) / float64(maxTxBytes)
+
+	now := time.Now().UTC()
+	tests := []struct {
+		name           string
+		config         mempool.VoteLaneConfig
+		votingEndTimes []time.Time
+		txs            [][]byte
+		expectedTxs    [][]byte
+	}{
+		{
+			name:        "no active proposal",
+			config:      mempool.VoteLaneConfig{DeadlineBlocks: 10, BlockTime: time.Second, Ratio: ratio},
+			expectedTxs: [][]byte{sendTx1, sendTx2},
+		},
+		{
+			name:           "voting deadline far",
+			config:         mempool.VoteLaneConfig{DeadlineBlocks: 10, BlockTime: time.Second, Ratio: ratio},
+			votingEndTimes: []time.Time{now.Add(time.Minute)},
+			expectedTxs:    [][]byte{sendTx1, sendTx2},
+		},
+		{
+			name:           "voting deadline near",
+			config:         mempool.VoteLaneConfig{DeadlineBlocks: 10, BlockTime: time.Second, Ratio: ratio},
+			votingEndTimes: []time.Time{now.Add(time.Minute), now.Add(5 * time.Second)},
+			expectedTxs:    [][]byte{sendTx1, voteTx},
+		},
+		{
+			name:           "voting deadline near, lane sized to the votes",
+			config:         mempool.VoteLaneConfig{DeadlineBlocks: 10, BlockTime: time.Second, Ratio: 1},
+			votingEndTimes: []time.Time{now.Add(5 * time.Second)},
+			expectedTxs:    [][]byte{sendTx1, voteTx},
+		},
+		{
+			name:           "voting deadline near, no vote",
+			config:         mempool.VoteLaneConfig{DeadlineBlocks: 10, BlockTime: time.Second, Ratio: 1},
+			votingEndTimes: []time.Time{now.Add(5 * time.Second)},
+			txs:            [][]byte{sendTx1, sendTx2},
+			expectedTxs:    [][]byte{sendTx1, sendTx2},
+		},
+		{
+			name:           "lane disabled",
+			config:         mempool.VoteLaneConfig{DeadlineBlocks: 0, BlockTime: time.Second, Ratio: ratio},
+			votingEndTimes: []time.Time{now.Add(5 * time.Second)},
+			expectedTxs:    [][]byte{sendTx1, sendTx2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := sdk.NewContext(nil, tmproto.Header{Time: now}, false, log.NewNopLogger()).
+				WithConsensusParams(&tmproto.ConsensusParams{})
+			handler := mempool.NewVoteLaneProposalHandler(
+				sdkmempool.NoOpMempool{}, nil, encCfg.TxConfig.TxEncoder(), encCfg.TxConfig.TxDecoder(),
+				mockGovKeeper{votingEndTimes: tt.votingEndTimes}, tt.config,
+			)
+
+			txs := tt.txs
+			if txs == nil {
+				txs = [][]byte{sendTx1, sendTx2, voteTx}
+			}
+			res := handler.PrepareProposalHandler()(ctx, abci.RequestPrepareProposal{
+				MaxTxBytes: maxTxBytes,
+				Txs:        txs,
+			})
+
+			require.Equal(t, tt.expectedTxs, res.Txs)
+		})
+	}
+}
+
+func TestVoteLanePrepareProposalGas(t *testing.T) {
+	encCfg := atomone.RegisterEncodingConfig()
+	addr := sdk.AccAddress("addr________________")
+	encodeTx := func(msg sdk.Msg, gasLimit uint64) []byte {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetGasLimit(gasLimit)
+		bz, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)))
+	sendTx1 := encodeTx(send, 100_000)
+	sendTx2 := encodeTx(send, 100_000)
+	voteTx := encodeTx(govv1.NewMsgVote(addr, 1, govv1.OptionYes, ""), 100_000)
+
+	// the block bytes can hold the three transactions, the lane holds the
+	// gas of the vote transaction
+	now := time.Now().UTC()
+	ctx := sdk.NewContext(nil, tmproto.Header{Time: now}, false, log.NewNopLogger()).
+		WithConsensusParams(&tmproto.ConsensusParams{Block: &tmproto.BlockParams{MaxGas: 250_000}})
+	handler := mempool.NewVoteLaneProposalHandler(
+		sdkmempool.NoOpMempool{}, nil, encCfg.TxConfig.TxEncoder(), encCfg.TxConfig.TxDecoder(),
+		mockGovKeeper{votingEndTimes: []time.Time{now.Add(5 * time.Second)}},
+		mempool.VoteLaneConfig{DeadlineBlocks: 10, BlockTime: time.Second, Ratio: 0.5},
+	)
+
+	res := handler.PrepareProposalHandler()(ctx, abci.RequestPrepareProposal{
+		MaxTxBytes: 1_000_000,
+		Txs:        [][]byte{sendTx1, sendTx2, voteTx},
+	})
+
+	require.Equal(t, [][]byte{sendTx1, voteTx}, res.Txs)
+}
+
+func TestIsVoteTx(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	vote := govv1.NewMsgVote(addr, 1, govv1.OptionYes, "")
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)))
+	encCfg := atomone.RegisterEncodingConfig()
+	newTx := func(msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		return txBuilder.GetTx()
+	}
+
+	require.True(t, mempool.IsVoteTx(newTx(vote)))
+	require.True(t, mempool.IsVoteTx(newTx(vote, vote)))
+	require.False(t, mempool.IsVoteTx(newTx(vote, send)))
+	require.False(t, mempool.IsVoteTx(newTx(send)))
+	require.False(t, mempool.IsVoteTx(newTx()))
+}
@@ -18,6 +18,7 @@ import (
 
 	govtypes "github.com/atomone-hub/atomone/x/gov/types"
 	govv1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	photontypes "github.com/atomone-hub/atomone/x/photon/types"
 )
 
 func getGenDoc(path string) (*tmtypes.GenesisDoc, error) {
@@ -135,6 +136,19 @@ func modifyGenesis(path, moniker, amountStr string, addrAll []sdk.AccAddress, de
 			quorum.String(), threshold.String(), govv1.DefaultVetoThreshold.String(),
 			sdk.ZeroDec().String(),
 			false, false, true,
+			govv1.DefaultProposalRetentionPeriod,
+			govv1.DefaultProposerBountyRatio.String(), govv1.DefaultProposerBounty,
+			govv1.DefaultCommunityPoolSpendLimit, govv1.DefaultCommunityPoolSpendPeriod,
+			govv1.DefaultMaxDepositPeriodProposalsPerProposer,
+			govv1.DefaultMinVotingPeriod, govv1.DefaultMaxVotingPeriod,
+			govv1.DefaultProposalMetadataSchema,
+			govv1.DefaultReviewPeriod, govv1.DefaultMessageReviewPeriods,
+			govv1.DefaultExcludeUnvestedVotingPower,
+			govv1.DefaultQuadraticVotingEnabled, govv1.DefaultQuadraticVotingPowerCap.String(),
+			govv1.DefaultMaxVoteLockPeriods,
+			govv1.DefaultExcludeJailedValidatorsStake,
+			govv1.DefaultModuleAccountStakeRule,
+			govv1.DefaultDepositDenomWeights,
 		),
 	)
 	govGenStateBz, err := cdc.MarshalJSON(govGenState)
@@ -143,6 +157,14 @@ func modifyGenesis(path, moniker, amountStr string, addrAll []sdk.AccAddress, de
 	}
 	appState[govtypes.ModuleName] = govGenStateBz
 
+	// the tests pay their fees in uatone
+	photonGenState := photontypes.NewGenesisState(photontypes.NewParams(false, []string{photontypes.TxFeeExceptionWildcard}))
+	photonGenStateBz, err := cdc.MarshalJSON(photonGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal photon genesis state: %w", err)
+	}
+	appState[photontypes.ModuleName] = photonGenStateBz
+
 	appStateJSON, err := json.Marshal(appState)
 	if err != nil {
 		return fmt.Errorf("failed to marshal application genesis state: %w", err)
@@ -0,0 +1,34 @@
+package dynamicfee
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/keeper"
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+// EndBlocker updates the base gas price from the gas used by the block.
+func EndBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if !k.GetParams(ctx).Enabled {
+		return
+	}
+
+	var gasUsed uint64
+	if ctx.BlockGasMeter() != nil {
+		gasUsed = ctx.BlockGasMeter().GasConsumedToLimit()
+	}
+	baseGasPrice := k.UpdateBaseGasPrice(ctx, gasUsed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseGasPrice,
+			sdk.NewAttribute(types.AttributeKeyBaseGasPrice, baseGasPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, sdk.NewIntFromUint64(gasUsed).String()),
+		),
+	)
+}
@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+// FlagPercentile is the percentile flag of the estimate-fee query.
+const FlagPercentile = "percentile"
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	// Group dynamicfee queries under a subcommand
+	dynamicfeeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the dynamicfee module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	dynamicfeeQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryBaseGasPrice(),
+		GetCmdQueryFeeHistory(),
+		GetCmdQueryEstimateFee(),
+	)
+
+	return dynamicfeeQueryCmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the dynamicfee module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the parameters of the dynamicfee module.
+
+Example:
+$ %s query dynamicfee params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBaseGasPrice implements the query base gas price command.
+func GetCmdQueryBaseGasPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-gas-price",
+		Short: "Query the current base gas price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current base gas price, the minimum gas price a transaction
+must pay to be included in a block.
+
+Example:
+$ %s query dynamicfee base-gas-price
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseGasPrice(cmd.Context(), &types.QueryBaseGasPriceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFeeHistory implements the query fee history command.
+func GetCmdQueryFeeHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history",
+		Short: "Query the base gas price and gas used of the recent blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the base gas price and gas used of the recent blocks, the number of
+blocks kept is set by the fee_history_length param.
+
+Example:
+$ %s query dynamicfee fee-history
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeHistory(cmd.Context(), &types.QueryFeeHistoryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEstimateFee implements the query estimate fee command.
+func GetCmdQueryEstimateFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-fee [gas]",
+		Short: "Query the recommended fee for a gas amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the recommended fee for a gas amount, computed from the current base
+gas price and a percentile of the priority gas prices paid in the recent
+blocks.
+
+Example:
+$ %s query dynamicfee estimate-fee 200000 --%s 90
+`,
+				version.AppName, FlagPercentile,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			gas, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("gas %s not a valid uint, please input a valid gas amount", args[0])
+			}
+
+			percentile, err := cmd.Flags().GetUint32(FlagPercentile)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateFee(cmd.Context(), &types.QueryEstimateFeeRequest{
+				Gas:        gas,
+				Percentile: percentile,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagPercentile, 50, "The percentile of the recent priority gas prices to add to the base gas price, from 1 to 100")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
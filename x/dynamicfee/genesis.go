@@ -0,0 +1,23 @@
+package dynamicfee
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/keeper"
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+// InitGenesis - store genesis parameters and base gas price
+func InitGenesis(ctx sdk.Context, k *keeper.Keeper, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Sprintf("%s module params has not been set", types.ModuleName))
+	}
+	k.SetBaseGasPrice(ctx, data.BaseGasPrice)
+}
+
+// ExportGenesis - output genesis parameters and base gas price
+func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetBaseGasPrice(ctx))
+}
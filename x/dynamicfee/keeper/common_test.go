@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/keeper"
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+var govAcct = authtypes.NewModuleAddress("gov")
+
+// setupDynamicfeeKeeper creates a dynamicfeeKeeper with the default genesis
+// state.
+func setupDynamicfeeKeeper(t *testing.T) (*keeper.Keeper, sdk.Context) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	tKey := sdk.NewTransientStoreKey(types.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, tKey)
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Height: 1, Time: tmtime.Now()})
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	k := keeper.NewKeeper(encCfg.Codec, key, tKey, govAcct.String())
+	genesis := types.DefaultGenesisState()
+	if err := k.SetParams(ctx, genesis.Params); err != nil {
+		t.Fatal(err)
+	}
+	k.SetBaseGasPrice(ctx, genesis.BaseGasPrice)
+
+	return k, ctx
+}
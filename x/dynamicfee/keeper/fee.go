@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+// UpdateBaseGasPrice computes the base gas price of the next block from the
+// gas used by the current block, following EIP-1559: the price changes by
+// max_change_rate * (utilization - target) / target, and never goes below
+// min_base_gas_price. The current block is recorded in the fee history, with
+// the median of the priority gas prices paid by its transactions.
+func (k Keeper) UpdateBaseGasPrice(ctx sdk.Context, gasUsed uint64) math.LegacyDec {
+	params := k.GetParams(ctx)
+	baseGasPrice := k.GetBaseGasPrice(ctx)
+
+	k.SetFeeHistoryEntry(ctx, types.FeeHistoryEntry{
+		Height:           ctx.BlockHeight(),
+		BaseGasPrice:     baseGasPrice,
+		GasUsed:          gasUsed,
+		PriorityGasPrice: Percentile(k.getPriorityGasPrices(ctx), 50),
+	})
+	k.pruneFeeHistory(ctx, params.FeeHistoryLength)
+
+	utilization := math.LegacyOneDec()
+	if gasUsed < params.MaxBlockGas {
+		utilization = math.LegacyNewDec(int64(gasUsed)).QuoInt64(int64(params.MaxBlockGas))
+	}
+	delta := params.MaxChangeRate.Mul(utilization.Sub(params.TargetBlockUtilization)).Quo(params.TargetBlockUtilization)
+	newBaseGasPrice := baseGasPrice.Mul(math.LegacyOneDec().Add(delta))
+	if newBaseGasPrice.LT(params.MinBaseGasPrice) {
+		newBaseGasPrice = params.MinBaseGasPrice
+	}
+
+	k.SetBaseGasPrice(ctx, newBaseGasPrice)
+	return newBaseGasPrice
+}
+
+// SetFeeHistoryEntry sets a fee history entry.
+func (k Keeper) SetFeeHistoryEntry(ctx sdk.Context, entry types.FeeHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&entry)
+	store.Set(types.FeeHistoryKey(entry.Height), bz)
+}
+
+// GetFeeHistory returns the fee history entries, ordered by ascending height.
+func (k Keeper) GetFeeHistory(ctx sdk.Context) (entries []types.FeeHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FeeHistoryKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.FeeHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// pruneFeeHistory deletes the fee history entries beyond the length most
+// recent ones.
+func (k Keeper) pruneFeeHistory(ctx sdk.Context, length uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.FeeHistoryKeyPrefix)
+	var keys [][]byte
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		if count > length {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RecordPriorityGasPrice records the gas price paid above the base gas price
+// by a transaction of the current block.
+func (k Keeper) RecordPriorityGasPrice(ctx sdk.Context, price math.LegacyDec) {
+	store := ctx.TransientStore(k.tStoreKey)
+	var count uint64
+	if bz := store.Get(types.PriorityGasPriceCountKey); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.PriorityGasPriceKey(count), k.cdc.MustMarshal(&sdk.DecProto{Dec: price}))
+	store.Set(types.PriorityGasPriceCountKey, sdk.Uint64ToBigEndian(count+1))
+}
+
+// getPriorityGasPrices returns the priority gas prices recorded in the
+// current block.
+func (k Keeper) getPriorityGasPrices(ctx sdk.Context) (prices []math.LegacyDec) {
+	store := ctx.TransientStore(k.tStoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PriorityGasPriceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price sdk.DecProto
+		k.cdc.MustUnmarshal(iterator.Value(), &price)
+		prices = append(prices, price.Dec)
+	}
+	return prices
+}
+
+// Percentile returns the nearest-rank percentile of the prices, zero if there
+// are none. The prices slice is sorted in place.
+func Percentile(prices []math.LegacyDec, percentile uint32) math.LegacyDec {
+	if len(prices) == 0 {
+		return math.LegacyZeroDec()
+	}
+	if percentile > 100 {
+		percentile = 100
+	}
+
+	sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+	rank := (int(percentile)*len(prices) + 99) / 100
+	if rank == 0 {
+		rank = 1
+	}
+	return prices[rank-1]
+}
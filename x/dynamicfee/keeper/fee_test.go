@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/keeper"
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+func TestUpdateBaseGasPrice(t *testing.T) {
+	tests := []struct {
+		name                 string
+		baseGasPrice         string
+		gasUsed              uint64
+		expectedBaseGasPrice string
+	}{
+		{
+			name:                 "target utilization: unchanged",
+			baseGasPrice:         "1",
+			gasUsed:              types.DefaultMaxBlockGas / 2,
+			expectedBaseGasPrice: "1.000000000000000000",
+		},
+		{
+			name:                 "full block: increased by max change rate",
+			baseGasPrice:         "1",
+			gasUsed:              types.DefaultMaxBlockGas,
+			expectedBaseGasPrice: "1.125000000000000000",
+		},
+		{
+			name:                 "gas used above max block gas: capped utilization",
+			baseGasPrice:         "1",
+			gasUsed:              types.DefaultMaxBlockGas * 2,
+			expectedBaseGasPrice: "1.125000000000000000",
+		},
+		{
+			name:                 "empty block: decreased by max change rate",
+			baseGasPrice:         "1",
+			gasUsed:              0,
+			expectedBaseGasPrice: "0.875000000000000000",
+		},
+		{
+			name:                 "3/4 utilization: increased by half max change rate",
+			baseGasPrice:         "1",
+			gasUsed:              types.DefaultMaxBlockGas * 3 / 4,
+			expectedBaseGasPrice: "1.062500000000000000",
+		},
+		{
+			name:                 "empty block: bounded by min base gas price",
+			baseGasPrice:         "0.011",
+			gasUsed:              0,
+			expectedBaseGasPrice: types.DefaultMinBaseGasPrice.String(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, ctx := setupDynamicfeeKeeper(t)
+			k.SetBaseGasPrice(ctx, math.LegacyMustNewDecFromStr(tt.baseGasPrice))
+
+			baseGasPrice := k.UpdateBaseGasPrice(ctx, tt.gasUsed)
+
+			require.Equal(t, tt.expectedBaseGasPrice, baseGasPrice.String())
+			require.Equal(t, baseGasPrice, k.GetBaseGasPrice(ctx))
+			require.Equal(t, []types.FeeHistoryEntry{{
+				Height:           ctx.BlockHeight(),
+				BaseGasPrice:     math.LegacyMustNewDecFromStr(tt.baseGasPrice),
+				GasUsed:          tt.gasUsed,
+				PriorityGasPrice: math.LegacyZeroDec(),
+			}}, k.GetFeeHistory(ctx))
+		})
+	}
+}
+
+func TestFeeHistoryPruning(t *testing.T) {
+	k, ctx := setupDynamicfeeKeeper(t)
+	params := k.GetParams(ctx)
+	params.FeeHistoryLength = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for height := int64(1); height <= 5; height++ {
+		k.UpdateBaseGasPrice(ctx.WithBlockHeight(height), uint64(height))
+	}
+
+	history := k.GetFeeHistory(ctx)
+	require.Len(t, history, 3)
+	for i, entry := range history {
+		require.Equal(t, int64(i+3), entry.Height)
+		require.Equal(t, uint64(i+3), entry.GasUsed)
+	}
+}
+
+func TestUpdateBaseGasPricePriorityGasPrice(t *testing.T) {
+	k, ctx := setupDynamicfeeKeeper(t)
+	for _, price := range []string{"0.3", "0.1", "0.2", "0.5"} {
+		k.RecordPriorityGasPrice(ctx, math.LegacyMustNewDecFromStr(price))
+	}
+
+	k.UpdateBaseGasPrice(ctx, 0)
+
+	history := k.GetFeeHistory(ctx)
+	require.Len(t, history, 1)
+	require.Equal(t, "0.200000000000000000", history[0].PriorityGasPrice.String())
+}
+
+func TestPercentile(t *testing.T) {
+	decs := func(prices ...int64) []math.LegacyDec {
+		res := make([]math.LegacyDec, len(prices))
+		for i, p := range prices {
+			res[i] = math.LegacyNewDec(p)
+		}
+		return res
+	}
+	tests := []struct {
+		name       string
+		prices     []math.LegacyDec
+		percentile uint32
+		expected   int64
+	}{
+		{name: "no prices", prices: nil, percentile: 50, expected: 0},
+		{name: "single price", prices: decs(7), percentile: 50, expected: 7},
+		{name: "median", prices: decs(5, 1, 4, 2, 3), percentile: 50, expected: 3},
+		{name: "median of even count", prices: decs(4, 1, 3, 2), percentile: 50, expected: 2},
+		{name: "0th percentile", prices: decs(4, 1, 3, 2), percentile: 0, expected: 1},
+		{name: "90th percentile", prices: decs(10, 9, 8, 7, 6, 5, 4, 3, 2, 1), percentile: 90, expected: 9},
+		{name: "100th percentile", prices: decs(4, 1, 3, 2), percentile: 100, expected: 4},
+		{name: "percentile above 100", prices: decs(4, 1, 3, 2), percentile: 200, expected: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, math.LegacyNewDec(tt.expected), keeper.Percentile(tt.prices, tt.percentile))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the dynamicfee parameters
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// BaseGasPrice queries the current base gas price
+func (k Keeper) BaseGasPrice(c context.Context, req *types.QueryBaseGasPriceRequest) (*types.QueryBaseGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBaseGasPriceResponse{BaseGasPrice: k.GetBaseGasPrice(ctx)}, nil
+}
+
+// FeeHistory queries the fee history of the recent blocks
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryFeeHistoryResponse{Entries: k.GetFeeHistory(ctx)}, nil
+}
+
+// EstimateFee queries the recommended fee for a gas amount
+func (k Keeper) EstimateFee(c context.Context, req *types.QueryEstimateFeeRequest) (*types.QueryEstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Percentile > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "percentile must not be greater than 100: %d", req.Percentile)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	percentile := req.Percentile
+	if percentile == 0 {
+		percentile = 50
+	}
+
+	var priorityGasPrices []math.LegacyDec
+	for _, entry := range k.GetFeeHistory(ctx) {
+		priorityGasPrices = append(priorityGasPrices, entry.PriorityGasPrice)
+	}
+	baseGasPrice := k.GetBaseGasPrice(ctx)
+	priorityGasPrice := Percentile(priorityGasPrices, percentile)
+	gasPrice := baseGasPrice.Add(priorityGasPrice)
+
+	return &types.QueryEstimateFeeResponse{
+		BaseGasPrice:     baseGasPrice,
+		PriorityGasPrice: priorityGasPrice,
+		GasPrice:         gasPrice,
+		Fee:              gasPrice.MulInt(math.NewIntFromUint64(req.Gas)).Ceil().RoundInt(),
+	}, nil
+}
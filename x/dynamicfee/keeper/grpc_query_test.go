@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+func TestEstimateFee(t *testing.T) {
+	k, ctx := setupDynamicfeeKeeper(t)
+	k.SetBaseGasPrice(ctx, math.LegacyMustNewDecFromStr("0.1"))
+	for height, price := range []string{"0.04", "0.01", "0.03", "0.02"} {
+		k.SetFeeHistoryEntry(ctx, types.FeeHistoryEntry{
+			Height:           int64(height + 1),
+			BaseGasPrice:     math.LegacyMustNewDecFromStr("0.1"),
+			PriorityGasPrice: math.LegacyMustNewDecFromStr(price),
+		})
+	}
+
+	tests := []struct {
+		name        string
+		req         *types.QueryEstimateFeeRequest
+		expectedErr string
+		expected    *types.QueryEstimateFeeResponse
+	}{
+		{
+			name:        "nil request",
+			req:         nil,
+			expectedErr: "invalid request",
+		},
+		{
+			name:        "percentile above 100",
+			req:         &types.QueryEstimateFeeRequest{Gas: 1000, Percentile: 101},
+			expectedErr: "percentile must not be greater than 100: 101",
+		},
+		{
+			name: "default percentile",
+			req:  &types.QueryEstimateFeeRequest{Gas: 1000},
+			expected: &types.QueryEstimateFeeResponse{
+				BaseGasPrice:     math.LegacyMustNewDecFromStr("0.1"),
+				PriorityGasPrice: math.LegacyMustNewDecFromStr("0.02"),
+				GasPrice:         math.LegacyMustNewDecFromStr("0.12"),
+				Fee:              math.NewInt(120),
+			},
+		},
+		{
+			name: "100th percentile, fee rounded up",
+			req:  &types.QueryEstimateFeeRequest{Gas: 1001, Percentile: 100},
+			expected: &types.QueryEstimateFeeResponse{
+				BaseGasPrice:     math.LegacyMustNewDecFromStr("0.1"),
+				PriorityGasPrice: math.LegacyMustNewDecFromStr("0.04"),
+				GasPrice:         math.LegacyMustNewDecFromStr("0.14"),
+				Fee:              math.NewInt(141),
+			},
+		},
+		{
+			name: "gas above the int64 range",
+			req:  &types.QueryEstimateFeeRequest{Gas: 1 << 63},
+			expected: &types.QueryEstimateFeeResponse{
+				BaseGasPrice:     math.LegacyMustNewDecFromStr("0.1"),
+				PriorityGasPrice: math.LegacyMustNewDecFromStr("0.02"),
+				GasPrice:         math.LegacyMustNewDecFromStr("0.12"),
+				Fee:              math.NewIntFromUint64(1 << 63).MulRaw(12).QuoRaw(100).AddRaw(1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := k.EstimateFee(ctx, tt.req)
+
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected.String(), res.String())
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+// Keeper defines the dynamicfee module Keeper
+type Keeper struct {
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey  storetypes.StoreKey
+	tStoreKey storetypes.StoreKey
+
+	// The codec for binary encoding/decoding.
+	cdc codec.BinaryCodec
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper returns a dynamicfee keeper. It maintains the base gas price of
+// the transactions.
+func NewKeeper(cdc codec.BinaryCodec, key, tKey storetypes.StoreKey, authority string) *Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	return &Keeper{
+		storeKey:  key,
+		tStoreKey: tKey,
+		cdc:       cdc,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/dynamicfee module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams gets the dynamicfee module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the dynamicfee module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetBaseGasPrice gets the current base gas price.
+func (k Keeper) GetBaseGasPrice(ctx sdk.Context) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseGasPriceKey)
+	if bz == nil {
+		return math.LegacyZeroDec()
+	}
+
+	var price sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &price)
+	return price.Dec
+}
+
+// SetBaseGasPrice sets the current base gas price.
+func (k Keeper) SetBaseGasPrice(ctx sdk.Context, price math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: price})
+	store.Set(types.BaseGasPriceKey, bz)
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/keeper"
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+func TestMsgServerUpdateParams(t *testing.T) {
+	k, ctx := setupDynamicfeeKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	params := types.DefaultParams()
+	params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.1")
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress("foo").String(),
+		Params:    params,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: govAcct.String(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+	// the base gas price is raised to the new min base gas price
+	require.Equal(t, params.MinBaseGasPrice, k.GetBaseGasPrice(ctx))
+}
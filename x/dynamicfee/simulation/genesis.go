@@ -0,0 +1,73 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+// Simulation parameter constants
+const (
+	Enabled                = "enabled"
+	TargetBlockUtilization = "target_block_utilization"
+	MaxChangeRate          = "max_change_rate"
+)
+
+// GenEnabled returns a randomized Enabled param.
+func GenEnabled(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenTargetBlockUtilization returns a randomized TargetBlockUtilization param.
+func GenTargetBlockUtilization(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 101)), 2)
+}
+
+// GenMaxChangeRate returns a randomized MaxChangeRate param.
+func GenMaxChangeRate(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 100)), 2)
+}
+
+// RandomizedGenState generates a random GenesisState for dynamicfee.
+func RandomizedGenState(simState *module.SimulationState) {
+	var enabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Enabled, &enabled, simState.Rand,
+		func(r *rand.Rand) { enabled = GenEnabled(r) },
+	)
+
+	var targetBlockUtilization math.LegacyDec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetBlockUtilization, &targetBlockUtilization, simState.Rand,
+		func(r *rand.Rand) { targetBlockUtilization = GenTargetBlockUtilization(r) },
+	)
+
+	var maxChangeRate math.LegacyDec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxChangeRate, &maxChangeRate, simState.Rand,
+		func(r *rand.Rand) { maxChangeRate = GenMaxChangeRate(r) },
+	)
+
+	// The operations of the other modules pay random fees, possibly zero, so
+	// the base gas price is kept at zero.
+	dynamicfeeGenesis := types.NewGenesisState(
+		types.NewParams(enabled, math.LegacyZeroDec(), targetBlockUtilization, types.DefaultMaxBlockGas, maxChangeRate, types.DefaultFeeHistoryLength),
+		math.LegacyZeroDec(),
+	)
+
+	bz, err := json.MarshalIndent(&dynamicfeeGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated dynamicfee parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(dynamicfeeGenesis)
+}
@@ -0,0 +1,844 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: atomone/dynamicfee/v1/dynamicfee.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/dynamicfee module.
+type Params struct {
+	// enabled enables the base gas price. When disabled, the transaction fees
+	// are only checked against the validators' minimum gas prices.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// min_base_gas_price is the lower bound of the base gas price, which must be
+	// positive.
+	MinBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_gas_price"`
+	// target_block_utilization is the ratio of max_block_gas targeted by the
+	// base gas price: above it the price increases, below it decreases. It must
+	// be in (0, 1).
+	TargetBlockUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=target_block_utilization,json=targetBlockUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_block_utilization"`
+	// max_block_gas is the block gas the utilization is computed against.
+	MaxBlockGas uint64 `protobuf:"varint,4,opt,name=max_block_gas,json=maxBlockGas,proto3" json:"max_block_gas,omitempty"`
+	// max_change_rate scales the change of the base gas price between two
+	// blocks: the price changes by max_change_rate * (utilization - target) /
+	// target, so an empty block decreases it by max_change_rate.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+	// fee_history_length is the number of blocks kept in the fee history.
+	FeeHistoryLength uint64 `protobuf:"varint,6,opt,name=fee_history_length,json=feeHistoryLength,proto3" json:"fee_history_length,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e4f4ce0028db41, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxBlockGas() uint64 {
+	if m != nil {
+		return m.MaxBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetFeeHistoryLength() uint64 {
+	if m != nil {
+		return m.FeeHistoryLength
+	}
+	return 0
+}
+
+// FeeHistoryEntry records the base gas price and the gas used of a block.
+type FeeHistoryEntry struct {
+	// height is the block height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_gas_price is the base gas price of the block.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// gas_used is the gas consumed by the transactions of the block.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// priority_gas_price is the median of the gas prices paid above the base
+	// gas price by the transactions of the block.
+	PriorityGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=priority_gas_price,json=priorityGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"priority_gas_price"`
+}
+
+func (m *FeeHistoryEntry) Reset()         { *m = FeeHistoryEntry{} }
+func (m *FeeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryEntry) ProtoMessage()    {}
+func (*FeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41e4f4ce0028db41, []int{1}
+}
+func (m *FeeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryEntry.Merge(m, src)
+}
+func (m *FeeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeHistoryEntry proto.InternalMessageInfo
+
+func (m *FeeHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeeHistoryEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "atomone.dynamicfee.v1.Params")
+	proto.RegisterType((*FeeHistoryEntry)(nil), "atomone.dynamicfee.v1.FeeHistoryEntry")
+}
+
+func init() {
+	proto.RegisterFile("atomone/dynamicfee/v1/dynamicfee.proto", fileDescriptor_41e4f4ce0028db41)
+}
+
+var fileDescriptor_41e4f4ce0028db41 = []byte{
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0xb3, 0x4d, 0x4c, 0xeb, 0xf8, 0x27, 0x75, 0xd0, 0xb2, 0x6d, 0x61, 0x1b, 0x72, 0x90,
+	0x50, 0x6c, 0x96, 0x20, 0x78, 0xf0, 0x18, 0xab, 0x15, 0xec, 0xa1, 0x2c, 0xf4, 0x22, 0xe2, 0xf2,
+	0xee, 0xec, 0x9b, 0xd9, 0xa1, 0x99, 0x99, 0xb0, 0x33, 0x29, 0x59, 0x3f, 0x81, 0x78, 0xf2, 0x63,
+	0x78, 0xec, 0xc1, 0x0f, 0xd1, 0x8b, 0x50, 0x3c, 0x89, 0x87, 0x22, 0xc9, 0xa1, 0x5f, 0x43, 0xf6,
+	0x4f, 0x34, 0x07, 0x6f, 0xf1, 0xb2, 0xec, 0x3b, 0xef, 0xb3, 0xbf, 0xe7, 0x65, 0x9e, 0x77, 0xc9,
+	0x63, 0xb0, 0x5a, 0x6a, 0x85, 0x7e, 0x9c, 0x29, 0x90, 0x82, 0x0d, 0x11, 0xfd, 0xf3, 0xfe, 0x52,
+	0xd5, 0x1b, 0xa7, 0xda, 0x6a, 0xfa, 0xa8, 0xd2, 0xf5, 0x96, 0x3a, 0xe7, 0xfd, 0x9d, 0x87, 0x5c,
+	0x73, 0x5d, 0x28, 0xfc, 0xfc, 0xad, 0x14, 0xef, 0x6c, 0x33, 0x6d, 0xa4, 0x36, 0x61, 0xd9, 0x28,
+	0x8b, 0xaa, 0xf5, 0x00, 0xa4, 0x50, 0xda, 0x2f, 0x9e, 0xe5, 0x51, 0xe7, 0x5b, 0x9d, 0x34, 0x4f,
+	0x20, 0x05, 0x69, 0xa8, 0x4b, 0xd6, 0x51, 0x41, 0x34, 0xc2, 0xd8, 0x75, 0xda, 0x4e, 0x77, 0x23,
+	0x58, 0x94, 0x94, 0x11, 0x2a, 0x85, 0x0a, 0x23, 0x30, 0x18, 0x72, 0xc8, 0xd1, 0x82, 0xa1, 0xbb,
+	0xd6, 0x76, 0xba, 0xb7, 0x07, 0xcf, 0x2e, 0xaf, 0xf7, 0x6a, 0x3f, 0xaf, 0xf7, 0x76, 0x4b, 0x27,
+	0x13, 0x9f, 0xf5, 0x84, 0xf6, 0x25, 0xd8, 0xa4, 0x77, 0x8c, 0x1c, 0x58, 0x76, 0x88, 0xec, 0xfb,
+	0xd7, 0x03, 0x52, 0x0d, 0x72, 0x88, 0xec, 0xcb, 0xcd, 0xc5, 0xbe, 0x13, 0xb4, 0xa4, 0x50, 0x03,
+	0x30, 0x78, 0x04, 0xe6, 0x24, 0xc7, 0xd1, 0x31, 0x71, 0x2d, 0xa4, 0x1c, 0x6d, 0x18, 0x8d, 0x34,
+	0x3b, 0x0b, 0x27, 0x56, 0x8c, 0xc4, 0x07, 0xb0, 0x42, 0x2b, 0xb7, 0xbe, 0x92, 0xd5, 0x56, 0xc9,
+	0x1d, 0xe4, 0xd8, 0xd3, 0xbf, 0x54, 0xda, 0x21, 0xf7, 0x24, 0x4c, 0x2b, 0x3b, 0x0e, 0xc6, 0x6d,
+	0xb4, 0x9d, 0x6e, 0x23, 0xb8, 0x23, 0x61, 0x5a, 0x68, 0x8f, 0xc0, 0xd0, 0xf7, 0xa4, 0x95, 0x6b,
+	0x58, 0x02, 0x8a, 0x63, 0x98, 0x82, 0x45, 0xf7, 0xd6, 0x4a, 0xc3, 0xe4, 0x96, 0x2f, 0x0a, 0x5a,
+	0x00, 0x16, 0xe9, 0x13, 0x42, 0x87, 0x88, 0x61, 0x22, 0x8c, 0xd5, 0x69, 0x16, 0x8e, 0x50, 0x71,
+	0x9b, 0xb8, 0xcd, 0x62, 0x90, 0xcd, 0x21, 0xe2, 0xeb, 0xb2, 0x71, 0x5c, 0x9c, 0x3f, 0x6f, 0x7f,
+	0xba, 0xb9, 0xd8, 0xdf, 0x5d, 0x6c, 0xcd, 0x74, 0x79, 0x6f, 0xca, 0x10, 0x3b, 0x1f, 0xd7, 0x48,
+	0xeb, 0xd5, 0x9f, 0xcf, 0x5e, 0x2a, 0x9b, 0x66, 0x74, 0x8b, 0x34, 0x13, 0x14, 0x3c, 0xb1, 0x45,
+	0xae, 0xf5, 0xa0, 0xaa, 0xe8, 0x3b, 0x72, 0xff, 0xbf, 0x46, 0x7a, 0x37, 0x5a, 0xce, 0x73, 0x9b,
+	0x6c, 0xe4, 0xe0, 0x89, 0xc1, 0xb8, 0xc8, 0xaf, 0x11, 0xac, 0x73, 0x30, 0xa7, 0x06, 0x63, 0x1a,
+	0x13, 0x3a, 0x4e, 0x85, 0x4e, 0x85, 0xcd, 0x96, 0xcc, 0x1b, 0x2b, 0x99, 0x6f, 0x2e, 0x88, 0x8b,
+	0x01, 0x06, 0x6f, 0x2e, 0x67, 0x9e, 0x73, 0x35, 0xf3, 0x9c, 0x5f, 0x33, 0xcf, 0xf9, 0x3c, 0xf7,
+	0x6a, 0x57, 0x73, 0xaf, 0xf6, 0x63, 0xee, 0xd5, 0xde, 0xf6, 0xb9, 0xb0, 0xc9, 0x24, 0xea, 0x31,
+	0x2d, 0xfd, 0xea, 0x32, 0x0f, 0x92, 0x49, 0xe4, 0xff, 0xf3, 0x62, 0x6d, 0x36, 0x46, 0x13, 0x35,
+	0x8b, 0xdf, 0xe5, 0xe9, 0xef, 0x01, 0x00, 0x69, 0x3d, 0xfd, 0xdd, 0xb3, 0x03, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeHistoryLength != 0 {
+		i = encodeVarintDynamicfee(dAtA, i, uint64(m.FeeHistoryLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicfee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxBlockGas != 0 {
+		i = encodeVarintDynamicfee(dAtA, i, uint64(m.MaxBlockGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TargetBlockUtilization.Size()
+		i -= size
+		if _, err := m.TargetBlockUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicfee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicfee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriorityGasPrice.Size()
+		i -= size
+		if _, err := m.PriorityGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicfee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.GasUsed != 0 {
+		i = encodeVarintDynamicfee(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDynamicfee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintDynamicfee(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicfee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicfee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovDynamicfee(uint64(l))
+	l = m.TargetBlockUtilization.Size()
+	n += 1 + l + sovDynamicfee(uint64(l))
+	if m.MaxBlockGas != 0 {
+		n += 1 + sovDynamicfee(uint64(m.MaxBlockGas))
+	}
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovDynamicfee(uint64(l))
+	if m.FeeHistoryLength != 0 {
+		n += 1 + sovDynamicfee(uint64(m.FeeHistoryLength))
+	}
+	return n
+}
+
+func (m *FeeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDynamicfee(uint64(m.Height))
+	}
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovDynamicfee(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovDynamicfee(uint64(m.GasUsed))
+	}
+	l = m.PriorityGasPrice.Size()
+	n += 1 + l + sovDynamicfee(uint64(l))
+	return n
+}
+
+func sovDynamicfee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicfee(x uint64) (n int) {
+	return sovDynamicfee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicfee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlockUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockGas", wireType)
+			}
+			m.MaxBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistoryLength", wireType)
+			}
+			m.FeeHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicfee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicfee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriorityGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicfee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicfee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicfee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicfee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicfee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicfee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicfee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicfee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicfee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicfee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicfee = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/dynamicfee module sentinel errors
+var (
+	ErrInvalidSigner   = sdkerrors.Register(ModuleName, 10, "expected authority account as only signer") //nolint:staticcheck
+	ErrInsufficientFee = sdkerrors.Register(ModuleName, 20, "insufficient fee for the base gas price")   //nolint:staticcheck
+)
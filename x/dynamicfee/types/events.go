@@ -0,0 +1,9 @@
+package types
+
+// dynamicfee module event types
+const (
+	EventTypeBaseGasPrice = "base_gas_price"
+
+	AttributeKeyBaseGasPrice = "base_gas_price"
+	AttributeKeyGasUsed      = "gas_used"
+)
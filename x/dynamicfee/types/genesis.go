@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewGenesisState creates a new genesis state for the dynamicfee module
+func NewGenesisState(params Params, baseGasPrice math.LegacyDec) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		BaseGasPrice: baseGasPrice,
+	}
+}
+
+// DefaultGenesisState defines the default dynamicfee genesis state
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return NewGenesisState(params, params.MinBaseGasPrice)
+}
+
+// ValidateGenesis checks if the dynamicfee genesis state is valid
+func ValidateGenesis(data *GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return err
+	}
+	if data.BaseGasPrice.IsNil() || data.BaseGasPrice.LT(data.Params.MinBaseGasPrice) {
+		return fmt.Errorf("base gas price %s must be greater or equal to the min base gas price %s", data.BaseGasPrice, data.Params.MinBaseGasPrice)
+	}
+
+	return nil
+}
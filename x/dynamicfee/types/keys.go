@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "dynamicfee"
+
+	// StoreKey is the store key string for dynamicfee
+	StoreKey = ModuleName
+
+	// TStoreKey is the transient store key string for dynamicfee
+	TStoreKey = "transient_" + ModuleName
+
+	// RouterKey is the message route for dynamicfee
+	RouterKey = ModuleName
+)
+
+// Keys for dynamicfee store
+// Items are stored with the following key: values
+//
+// - 0x00: Params
+//
+// - 0x01: BaseGasPrice
+//
+// - 0x02<height_Bytes>: FeeHistoryEntry
+var (
+	// ParamsKey is the key to query all dynamicfee params
+	ParamsKey = []byte{0x00}
+
+	// BaseGasPriceKey is the key of the current base gas price
+	BaseGasPriceKey = []byte{0x01}
+
+	// FeeHistoryKeyPrefix is the prefix of the fee history entries
+	FeeHistoryKeyPrefix = []byte{0x02}
+)
+
+// FeeHistoryKey gets the key of the fee history entry of a block height
+func FeeHistoryKey(height int64) []byte {
+	return append(FeeHistoryKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// Keys for dynamicfee transient store, reset at each block
+//
+// - 0x00: number of priority gas prices recorded in the block
+//
+// - 0x01<index_Bytes>: priority gas price of a transaction
+var (
+	// PriorityGasPriceCountKey is the key of the number of priority gas
+	// prices recorded in the block
+	PriorityGasPriceCountKey = []byte{0x00}
+
+	// PriorityGasPriceKeyPrefix is the prefix of the priority gas prices
+	// recorded in the block
+	PriorityGasPriceKeyPrefix = []byte{0x01}
+)
+
+// PriorityGasPriceKey gets the key of the priority gas price of the index-th
+// transaction of the block recording one
+func PriorityGasPriceKey(index uint64) []byte {
+	return append(PriorityGasPriceKeyPrefix, sdk.Uint64ToBigEndian(index)...)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Default dynamicfee parameters
+var (
+	DefaultMinBaseGasPrice        = math.LegacyMustNewDecFromStr("0.01")
+	DefaultTargetBlockUtilization = math.LegacyMustNewDecFromStr("0.5")
+	DefaultMaxBlockGas            = uint64(100_000_000)
+	DefaultMaxChangeRate          = math.LegacyMustNewDecFromStr("0.125")
+	DefaultFeeHistoryLength       = uint64(20)
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	enabled bool, minBaseGasPrice, targetBlockUtilization math.LegacyDec,
+	maxBlockGas uint64, maxChangeRate math.LegacyDec, feeHistoryLength uint64,
+) Params {
+	return Params{
+		Enabled:                enabled,
+		MinBaseGasPrice:        minBaseGasPrice,
+		TargetBlockUtilization: targetBlockUtilization,
+		MaxBlockGas:            maxBlockGas,
+		MaxChangeRate:          maxChangeRate,
+		FeeHistoryLength:       feeHistoryLength,
+	}
+}
+
+// DefaultParams returns the default dynamicfee parameters
+func DefaultParams() Params {
+	return NewParams(
+		true, DefaultMinBaseGasPrice, DefaultTargetBlockUtilization,
+		DefaultMaxBlockGas, DefaultMaxChangeRate, DefaultFeeHistoryLength,
+	)
+}
+
+// ValidateBasic performs basic validation on dynamicfee parameters. As the
+// base gas price is only multiplied by its change rate, a zero min base gas
+// price or a target block utilization of 1 would prevent it from ever rising.
+func (p Params) ValidateBasic() error {
+	if p.MinBaseGasPrice.IsNil() || !p.MinBaseGasPrice.IsPositive() {
+		return fmt.Errorf("min base gas price must be positive: %s", p.MinBaseGasPrice)
+	}
+	if p.TargetBlockUtilization.IsNil() || !p.TargetBlockUtilization.IsPositive() || p.TargetBlockUtilization.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("target block utilization must be in (0, 1): %s", p.TargetBlockUtilization)
+	}
+	if p.MaxBlockGas == 0 {
+		return fmt.Errorf("max block gas must be positive")
+	}
+	if p.MaxChangeRate.IsNil() || !p.MaxChangeRate.IsPositive() || p.MaxChangeRate.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("max change rate must be in (0, 1): %s", p.MaxChangeRate)
+	}
+
+	return nil
+}
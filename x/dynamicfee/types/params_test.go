@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/atomone-hub/atomone/x/dynamicfee/types"
+)
+
+func TestParamsValidateBasic(t *testing.T) {
+	tests := []struct {
+		name        string
+		malleate    func(*types.Params)
+		expectedErr string
+	}{
+		{
+			name:     "ok: default params",
+			malleate: func(*types.Params) {},
+		},
+		{
+			name:        "fail: negative min base gas price",
+			malleate:    func(p *types.Params) { p.MinBaseGasPrice = math.LegacyNewDec(-1) },
+			expectedErr: "min base gas price must be positive: -1.000000000000000000",
+		},
+		{
+			name:        "fail: zero min base gas price",
+			malleate:    func(p *types.Params) { p.MinBaseGasPrice = math.LegacyZeroDec() },
+			expectedErr: "min base gas price must be positive: 0.000000000000000000",
+		},
+		{
+			name:        "fail: zero target block utilization",
+			malleate:    func(p *types.Params) { p.TargetBlockUtilization = math.LegacyZeroDec() },
+			expectedErr: "target block utilization must be in (0, 1): 0.000000000000000000",
+		},
+		{
+			name:        "fail: target block utilization of 1",
+			malleate:    func(p *types.Params) { p.TargetBlockUtilization = math.LegacyOneDec() },
+			expectedErr: "target block utilization must be in (0, 1): 1.000000000000000000",
+		},
+		{
+			name:        "fail: target block utilization above 1",
+			malleate:    func(p *types.Params) { p.TargetBlockUtilization = math.LegacyNewDec(2) },
+			expectedErr: "target block utilization must be in (0, 1): 2.000000000000000000",
+		},
+		{
+			name:        "fail: zero max block gas",
+			malleate:    func(p *types.Params) { p.MaxBlockGas = 0 },
+			expectedErr: "max block gas must be positive",
+		},
+		{
+			name:        "fail: max change rate of 1",
+			malleate:    func(p *types.Params) { p.MaxChangeRate = math.LegacyOneDec() },
+			expectedErr: "max change rate must be in (0, 1): 1.000000000000000000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tt.malleate(&params)
+
+			err := params.ValidateBasic()
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -13,6 +13,18 @@ import (
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
+// The per block limits of the EndBlocker. As they change the state transition
+// of each block, they are part of the consensus and can't be node settings.
+const (
+	// MaxTalliesPerBlock is the maximum number of proposals tallied in a
+	// block. Proposals left over are tallied in the next blocks.
+	MaxTalliesPerBlock = 10
+	// MaxQueueEntriesPerBlock is the maximum number of entries of each of the
+	// proposal queues processed in a block. Entries left over are processed in
+	// the next blocks.
+	MaxQueueEntriesPerBlock = 100
+)
+
 // EndBlocker called every block, process inflation, update validator set.
 func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
@@ -21,6 +33,9 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 
 	// delete dead proposals from store and returns theirs deposits.
 	// A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
+	// The number of proposals processed per block is bounded, the remaining ones
+	// are left in the queue and processed in the next blocks.
+	var dropped uint64
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.Id)
 
@@ -49,14 +64,80 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
-		return false
+		dropped++
+		return budgetExhausted(dropped, MaxQueueEntriesPerBlock)
+	})
+
+	// start the voting period of the proposals whose review period has ended.
+	// The number of proposals activated per block is bounded, the remaining
+	// ones are left in the queue and activated in the next blocks.
+	var reviewed uint64
+	keeper.IterateReviewProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
+		keeper.ActivateVotingPeriod(ctx, proposal)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReviewProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.Id)),
+			),
+		)
+
+		logger.Info(
+			"proposal review period ended; voting period started",
+			"proposal", proposal.Id,
+		)
+
+		reviewed++
+		return budgetExhausted(reviewed, MaxQueueEntriesPerBlock)
+	})
+
+	// submit the proposals of the recurring proposals scheduled by now. The
+	// number of proposals submitted per block is bounded, the remaining ones
+	// are left in the queue and submitted in the next blocks.
+	var submitted uint64
+	keeper.IterateRecurringProposalsQueue(ctx, ctx.BlockHeader().Time, func(recurring v1.RecurringProposal) bool {
+		proposal, err := keeper.SubmitRecurringProposal(ctx, recurring)
+		if err != nil {
+			logger.Error(
+				"recurring proposal submission failed",
+				"recurring_proposal", recurring.Id,
+				"err", err,
+			)
+		} else {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRecurringProposal,
+					sdk.NewAttribute(types.AttributeKeyRecurringProposalID, fmt.Sprintf("%d", recurring.Id)),
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+					sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.Id)),
+				),
+			)
+
+			logger.Info(
+				"recurring proposal submitted; voting period started",
+				"recurring_proposal", recurring.Id,
+				"proposal", proposal.Id,
+			)
+		}
+
+		submitted++
+		return budgetExhausted(submitted, MaxQueueEntriesPerBlock)
 	})
 
 	// fetch active proposals whose voting periods have ended (are passed the block time)
+	// The number of proposals tallied per block is bounded, the remaining ones
+	// are left in the queue and tallied in the next blocks.
+	var tallied uint64
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
-		var tagValue, logMsg string
+		var (
+			tagValue, logMsg string
+			execResults      []*sdk.Result
+			execErr          error
+		)
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		passes, burnDeposits, vetoed, tallyResults, quadraticResults := keeper.Tally(ctx, proposal)
+		keeper.RecordProposalTurnout(ctx, proposal.Id, tallyResults)
 
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
@@ -65,47 +146,31 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 		}
 
 		if passes {
-			var (
-				idx    int
-				events sdk.Events
-				msg    sdk.Msg
-			)
-
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			cacheCtx, writeCache := ctx.CacheContext()
-			messages, err := proposal.GetMsgs()
-			if err == nil {
-				for idx, msg = range messages {
-					handler := keeper.Router().Handler(msg)
-					var res *sdk.Result
-					res, err = safeExecuteHandler(cacheCtx, msg, handler)
-					if err != nil {
-						break
-					}
-
-					events = append(events, res.GetEvents()...)
-				}
+			// the proposals spending from the community pool are queued when
+			// the spend limit of the period is reached, or when other
+			// proposals are already queued, so that it doesn't take the
+			// spend limit ahead of the queued ones, processed below. The
+			// execution order isn't kept though, as a queued proposal the
+			// limit doesn't allow doesn't block the next ones.
+			spend := keeper.ProposalCommunityPoolSpend(proposal)
+			allowed, spendErr := keeper.CheckCommunityPoolSpendLimit(ctx, spend)
+			if spendErr == nil && !spend.IsZero() && keeper.HasCommunityPoolSpendQueue(ctx) {
+				allowed = false
 			}
 
-			// `err == nil` when all handlers passed.
-			// Or else, `idx` and `err` are populated with the msg index and error.
-			if err == nil {
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				// write state to the underlying multi-store
-				writeCache()
-
-				// propagate the msg events to the current context
-				ctx.EventManager().EmitEvents(events)
-			} else {
+			switch {
+			case spendErr != nil:
 				proposal.Status = v1.StatusFailed
+				execErr = spendErr
 				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+				logMsg = fmt.Sprintf("passed, but failed on execution: %s", spendErr)
+			case !allowed:
+				proposal.Status = v1.StatusQueued
+				tagValue = types.AttributeValueProposalQueued
+				logMsg = "passed, queued until the community pool spend limit allows its execution"
+			default:
+				proposal.Status, execResults, execErr = executeProposal(ctx, keeper, proposal, spend)
+				tagValue, logMsg = executionResult(proposal.Status, execErr)
 			}
 		} else {
 			proposal.Status = v1.StatusRejected
@@ -114,13 +179,29 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 		}
 
 		proposal.FinalTallyResult = &tallyResults
+		proposal.FinalQuadraticTallyResult = quadraticResults
 
+		keeper.UpdateProposalStatusCount(ctx, v1.StatusVotingPeriod, proposal.Status)
 		keeper.SetProposal(ctx, proposal)
 		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+		if proposal.Status == v1.StatusQueued {
+			keeper.InsertCommunityPoolSpendQueue(ctx, proposal.Id)
+		} else {
+			keeper.InsertCompletedProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+		}
 
 		// when proposal become active
 		keeper.Hooks().AfterProposalVotingPeriodEnded(ctx, proposal.Id)
 
+		switch {
+		case proposal.Status == v1.StatusPassed:
+			keeper.Hooks().AfterProposalExecuted(ctx, proposal.Id, execResults)
+		case proposal.Status == v1.StatusFailed:
+			keeper.Hooks().AfterProposalFailed(ctx, proposal.Id, execErr)
+		case vetoed:
+			keeper.Hooks().AfterProposalVetoed(ctx, proposal.Id)
+		}
+
 		logger.Info(
 			"proposal tallied",
 			"proposal", proposal.Id,
@@ -134,8 +215,204 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
 			),
 		)
-		return false
+
+		tallied++
+		return budgetExhausted(tallied, MaxTalliesPerBlock)
+	})
+
+	// execute the queued proposals, in order, when the community pool spend
+	// limit allows it. A proposal the limit doesn't allow yet stays queued,
+	// without blocking the next ones. The queue is read before any execution,
+	// as the executions write to the store.
+	var processed uint64
+	for _, proposalID := range keeper.GetCommunityPoolSpendQueue(ctx) {
+		if budgetExhausted(processed, MaxQueueEntriesPerBlock) {
+			break
+		}
+
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			keeper.RemoveFromCommunityPoolSpendQueue(ctx, proposalID)
+			continue
+		}
+
+		var (
+			logMsg      string
+			execResults []*sdk.Result
+			execErr     error
+		)
+		spend := keeper.ProposalCommunityPoolSpend(proposal)
+		allowed, spendErr := keeper.CheckCommunityPoolSpendLimit(ctx, spend)
+		if spendErr == nil && !allowed {
+			// retried at the next blocks, the next proposals may fit
+			processed++
+			continue
+		}
+
+		if spendErr != nil {
+			proposal.Status = v1.StatusFailed
+			execErr = spendErr
+			logMsg = fmt.Sprintf("queued, but failed on execution: %s", spendErr)
+		} else {
+			proposal.Status, execResults, execErr = executeProposal(ctx, keeper, proposal, spend)
+			_, logMsg = executionResult(proposal.Status, execErr)
+		}
+		tagValue, _ := executionResult(proposal.Status, execErr)
+
+		keeper.UpdateProposalStatusCount(ctx, v1.StatusQueued, proposal.Status)
+		keeper.SetProposal(ctx, proposal)
+		keeper.RemoveFromCommunityPoolSpendQueue(ctx, proposal.Id)
+		keeper.InsertCompletedProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+		if proposal.Status == v1.StatusPassed {
+			keeper.Hooks().AfterProposalExecuted(ctx, proposal.Id, execResults)
+		} else {
+			keeper.Hooks().AfterProposalFailed(ctx, proposal.Id, execErr)
+		}
+
+		logger.Info(
+			"queued proposal executed",
+			"proposal", proposal.Id,
+			"results", logMsg,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeQueuedProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			),
+		)
+
+		processed++
+	}
+
+	// prune the completed proposals whose retention period has elapsed, only
+	// keeping their archived summary.
+	params := keeper.GetParams(ctx)
+	if params.ProposalRetentionPeriod != nil && *params.ProposalRetentionPeriod > 0 {
+		retentionEndTime := ctx.BlockHeader().Time.Add(-*params.ProposalRetentionPeriod)
+		var archived uint64
+		keeper.IterateCompletedProposalsQueue(ctx, retentionEndTime, func(proposal v1.Proposal) bool {
+			keeper.ArchiveProposal(ctx, proposal)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeArchiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				),
+			)
+
+			logger.Info(
+				"proposal archived",
+				"proposal", proposal.Id,
+				"status", proposal.Status,
+			)
+
+			archived++
+			return budgetExhausted(archived, MaxQueueEntriesPerBlock)
+		})
+	}
+
+	// unlock the stake of the vote locks that have ended.
+	var unlocked uint64
+	keeper.IterateVoteLocksQueue(ctx, ctx.BlockHeader().Time, func(lock v1.VoteLock) bool {
+		voter := sdk.MustAccAddressFromBech32(lock.Voter)
+		keeper.RemoveFromVoteLockQueue(ctx, voter, *lock.EndTime)
+		keeper.RemoveVoteLock(ctx, voter)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVoteUnlock,
+				sdk.NewAttribute(types.AttributeKeyVoter, lock.Voter),
+			),
+		)
+
+		unlocked++
+		return budgetExhausted(unlocked, MaxQueueEntriesPerBlock)
 	})
+
+	// reindex the proposals by total deposit after a change of the deposit
+	// denom weights, the remaining ones being reindexed in the next blocks.
+	keeper.ReindexProposalsByTotalDeposit(ctx, MaxQueueEntriesPerBlock)
+
+	setProposalGauges(ctx, keeper)
+}
+
+// setProposalGauges exports the number of proposals in the deposit period, in
+// the review and voting periods and queued for the community pool spend limit.
+func setProposalGauges(ctx sdk.Context, keeper *keeper.Keeper) {
+	gauges := []struct {
+		status v1.ProposalStatus
+		key    string
+	}{
+		{v1.StatusDepositPeriod, types.MetricKeyDepositPeriod},
+		{v1.StatusVotingPeriod, types.MetricKeyVotingPeriod},
+		{v1.StatusQueued, types.MetricKeyQueued},
+		{v1.StatusReviewPeriod, types.MetricKeyReviewPeriod},
+	}
+	for _, g := range gauges {
+		telemetry.SetGauge(float32(keeper.GetProposalStatusCount(ctx, g.status)), types.ModuleName, types.MetricKeyProposals, g.key)
+	}
+}
+
+// executeProposal executes the messages of a passed proposal, and returns its
+// resulting status. Messages may mutate state thus a cached context is used.
+// If one of the handlers fails, no state mutation is written and the error is
+// returned.
+func executeProposal(ctx sdk.Context, keeper *keeper.Keeper, proposal v1.Proposal, spend sdk.Coins) (v1.ProposalStatus, []*sdk.Result, error) {
+	var (
+		idx         int
+		events      sdk.Events
+		msg         sdk.Msg
+		execResults []*sdk.Result
+	)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	keeper.RecordCommunityPoolSpend(cacheCtx, spend)
+	messages, err := proposal.GetMsgs()
+	if err == nil {
+		for idx, msg = range messages {
+			handler := keeper.Router().Handler(msg)
+			var res *sdk.Result
+			res, err = safeExecuteHandler(cacheCtx, msg, handler)
+			if err != nil {
+				break
+			}
+
+			events = append(events, res.GetEvents()...)
+			execResults = append(execResults, res)
+		}
+	}
+
+	// `err == nil` when all handlers passed.
+	// Or else, `idx` and `err` are populated with the msg index and error.
+	if err != nil {
+		return v1.StatusFailed, nil, fmt.Errorf("msg %d (%s) failed on execution: %w", idx, sdk.MsgTypeURL(msg), err)
+	}
+
+	// write state to the underlying multi-store
+	writeCache()
+
+	keeper.PayProposerBounty(ctx, proposal)
+
+	// propagate the msg events to the current context
+	ctx.EventManager().EmitEvents(events)
+	return v1.StatusPassed, execResults, nil
+}
+
+// executionResult returns the event attribute value and the log message of an
+// executed proposal.
+func executionResult(status v1.ProposalStatus, execErr error) (tagValue, logMsg string) {
+	if status == v1.StatusPassed {
+		return types.AttributeValueProposalPassed, "passed"
+	}
+	return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but %s", execErr)
+}
+
+// budgetExhausted returns true if count reached the per block budget.
+func budgetExhausted(count, budget uint64) bool {
+	return count >= budget
 }
 
 // executes handle(msg) and recovers from panic.
@@ -1,6 +1,7 @@
 package gov_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -312,7 +314,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0])
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -361,7 +363,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	staking.EndBlocker(ctx, suite.StakingKeeper)
 
 	msg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000))))
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "Bank Msg Send", "send message", addrs[0])
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "Bank Msg Send", "send message", addrs[0], nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -402,3 +404,342 @@ func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sd
 		require.NotNil(t, res)
 	}
 }
+
+func TestCompletedProposalArchivedEndblocker(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	retentionPeriod := time.Hour
+	params := suite.GovKeeper.GetParams(ctx)
+	params.ProposalRetentionPeriod = &retentionPeriod
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], nil, false)
+	require.NoError(t, err)
+	suite.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusRejected, proposal.Status)
+
+	// the proposal is kept until the end of the retention period
+	newHeader.Time = newHeader.Time.Add(retentionPeriod).Add(-time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	_, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+
+	newHeader.Time = newHeader.Time.Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	_, ok = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.False(t, ok)
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeArchiveProposal,
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+	))
+
+	archived, ok := suite.GovKeeper.GetArchivedProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusRejected, archived.Status)
+	require.Equal(t, proposal.FinalTallyResult, archived.FinalTallyResult)
+	require.Equal(t, uint64(1), suite.GovKeeper.GetProposalStatusCount(ctx, v1.StatusRejected))
+
+	completedQueue := suite.GovKeeper.CompletedProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, completedQueue.Valid())
+	completedQueue.Close()
+}
+
+func TestEndBlockerTallyBudget(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	maxTallies := uint64(gov.MaxTalliesPerBlock)
+
+	var proposals []v1.Proposal
+	for i := uint64(0); i < maxTallies+2; i++ {
+		proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], nil, false)
+		require.NoError(t, err)
+		suite.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+		proposals = append(proposals, proposal)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*suite.GovKeeper.GetParams(ctx).VotingPeriod).Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the proposals over budget are left in the active queue
+	require.Equal(t, maxTallies, suite.GovKeeper.GetProposalStatusCount(ctx, v1.StatusRejected))
+	require.Equal(t, uint64(2), suite.GovKeeper.GetProposalStatusCount(ctx, v1.StatusVotingPeriod))
+
+	// and they don't accept votes anymore
+	lastProposal := proposals[len(proposals)-1]
+	err := suite.GovKeeper.AddVote(ctx, lastProposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.ErrorIs(t, err, types.ErrInactiveProposal)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	require.Equal(t, maxTallies+2, suite.GovKeeper.GetProposalStatusCount(ctx, v1.StatusRejected))
+	activeQueue := suite.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+}
+
+func TestReviewPeriodEndblocker(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	params := suite.GovKeeper.GetParams(ctx)
+	reviewPeriod := time.Hour
+	params.ReviewPeriod = &reviewPeriod
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	newProposalMsg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{mkTestLegacyContent(t)},
+		params.MinDeposit,
+		addrs[0].String(),
+		"",
+		"Proposal",
+		"description of proposal",
+	)
+	require.NoError(t, err)
+
+	res, err := govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), newProposalMsg)
+	require.NoError(t, err)
+
+	// the proposal reached its min deposit, but is frozen during the review period
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusReviewPeriod, proposal.Status)
+	require.Equal(t, ctx.BlockHeader().Time.Add(reviewPeriod), *proposal.ReviewEndTime)
+	require.Nil(t, proposal.VotingStartTime)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.ErrorIs(t, err, types.ErrInactiveProposal)
+	_, err = suite.GovKeeper.AddDeposit(ctx, proposal.Id, addrs[1], params.MinDeposit)
+	require.ErrorIs(t, err, types.ErrInactiveProposal)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	proposal, _ = suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.Equal(t, v1.StatusReviewPeriod, proposal.Status)
+
+	// the voting period starts once the review period ends
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(reviewPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, _ = suite.GovKeeper.GetProposal(ctx, res.ProposalId)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, ctx.BlockHeader().Time, *proposal.VotingStartTime)
+	require.Equal(t, ctx.BlockHeader().Time.Add(*params.VotingPeriod), *proposal.VotingEndTime)
+	require.Zero(t, suite.GovKeeper.GetProposalStatusCount(ctx, v1.StatusReviewPeriod))
+	require.Equal(t, uint64(1), suite.GovKeeper.GetProposalStatusCount(ctx, v1.StatusVotingPeriod))
+
+	reviewQueue := suite.GovKeeper.ReviewProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+	require.False(t, reviewQueue.Valid())
+	reviewQueue.Close()
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+}
+
+func TestCommunityPoolSpendQueuedEndblocker(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 3, valTokens)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)))
+	}
+	require.NoError(t, suite.DistrKeeper.FundCommunityPool(ctx, coins(1000), addrs[0]))
+
+	spendPeriod := 30 * 24 * time.Hour
+	params := suite.GovKeeper.GetParams(ctx)
+	params.CommunityPoolSpendLimit = coins(100)
+	params.CommunityPoolSpendPeriod = &spendPeriod
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	govAcct := suite.GovKeeper.GetGovernanceAccount(ctx).GetAddress().String()
+	var proposalIDs []uint64
+	for _, recipient := range addrs[1:] {
+		msg := &distrtypes.MsgCommunityPoolSpend{Authority: govAcct, Recipient: recipient.String(), Amount: coins(60)}
+		proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "title", "summary", addrs[0], nil, false)
+		require.NoError(t, err)
+		suite.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+		require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+		proposalIDs = append(proposalIDs, proposal.Id)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the second proposal exceeds the spend limit of the period and is queued
+	proposal, ok := suite.GovKeeper.GetProposal(ctx, proposalIDs[0])
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposalIDs[1])
+	require.True(t, ok)
+	require.Equal(t, v1.StatusQueued, proposal.Status)
+	require.Equal(t, []uint64{proposalIDs[1]}, suite.GovKeeper.GetCommunityPoolSpendQueue(ctx))
+	require.Equal(t, coins(40), suite.GovKeeper.RemainingCommunityPoolSpend(ctx))
+	require.Equal(t, valTokens, suite.BankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom).Amount)
+
+	// the queued proposal is executed once a new period starts
+	newHeader.Time = newHeader.Time.Add(spendPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposal, ok = suite.GovKeeper.GetProposal(ctx, proposalIDs[1])
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+	require.Empty(t, suite.GovKeeper.GetCommunityPoolSpendQueue(ctx))
+	require.Equal(t, coins(40), suite.GovKeeper.RemainingCommunityPoolSpend(ctx))
+	require.Equal(t, valTokens.AddRaw(60), suite.BankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom).Amount)
+	require.Equal(t, uint64(2), suite.GovKeeper.GetProposalStatusCount(ctx, v1.StatusPassed))
+	require.Zero(t, suite.GovKeeper.GetProposalStatusCount(ctx, v1.StatusQueued))
+}
+
+func TestCommunityPoolSpendQueueSkipsUnfundable(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 4, valTokens)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)))
+	}
+	require.NoError(t, suite.DistrKeeper.FundCommunityPool(ctx, coins(1000), addrs[0]))
+
+	spendPeriod := 30 * 24 * time.Hour
+	params := suite.GovKeeper.GetParams(ctx)
+	params.CommunityPoolSpendLimit = coins(100)
+	params.CommunityPoolSpendPeriod = &spendPeriod
+	require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+	govAcct := suite.GovKeeper.GetGovernanceAccount(ctx).GetAddress().String()
+	var proposalIDs []uint64
+	for i, amount := range []int64{60, 60, 30} {
+		msg := &distrtypes.MsgCommunityPoolSpend{Authority: govAcct, Recipient: addrs[i+1].String(), Amount: coins(amount)}
+		proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "title", "summary", addrs[0], nil, false)
+		require.NoError(t, err)
+		suite.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+		require.NoError(t, suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+		proposalIDs = append(proposalIDs, proposal.Id)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	// the second proposal exceeds the remaining spend and stays queued, the
+	// third one is queued behind it but executed as it fits
+	for i, status := range []v1.ProposalStatus{v1.StatusPassed, v1.StatusQueued, v1.StatusPassed} {
+		proposal, ok := suite.GovKeeper.GetProposal(ctx, proposalIDs[i])
+		require.True(t, ok)
+		require.Equal(t, status, proposal.Status, "proposal %d", proposal.Id)
+	}
+	require.Equal(t, []uint64{proposalIDs[1]}, suite.GovKeeper.GetCommunityPoolSpendQueue(ctx))
+	require.Equal(t, coins(10), suite.GovKeeper.RemainingCommunityPoolSpend(ctx))
+	require.Equal(t, valTokens.AddRaw(30), suite.BankKeeper.GetBalance(ctx, addrs[3], sdk.DefaultBondDenom).Amount)
+}
+
+func TestRecurringProposalEndblocker(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	params := suite.GovKeeper.GetParams(ctx)
+	interval := *params.VotingPeriod
+	govAddr := suite.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+
+	scheduleMsg, err := v1.NewMsgScheduleRecurringProposal(govAddr, []sdk.Msg{mkTestLegacyContent(t)}, "", "Budget renewal", "Quarterly budget renewal", interval)
+	require.NoError(t, err)
+	res, err := govMsgSvr.ScheduleRecurringProposal(sdk.WrapSDKContext(ctx), scheduleMsg)
+	require.NoError(t, err)
+	recurring, found := suite.GovKeeper.GetRecurringProposal(ctx, res.RecurringProposalId)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(interval), *recurring.NextSubmitTime)
+
+	// nothing is submitted before the next submit time
+	gov.EndBlocker(ctx, suite.GovKeeper)
+	require.Empty(t, suite.GovKeeper.GetProposals(ctx))
+
+	// the proposal is submitted by the module account and enters the voting
+	// period right away
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(interval)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	proposals := suite.GovKeeper.GetProposals(ctx)
+	require.Len(t, proposals, 1)
+	proposal := proposals[0]
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, govAddr.String(), proposal.Proposer)
+	require.Equal(t, "Budget renewal", proposal.Title)
+	require.Equal(t, ctx.BlockTime(), *proposal.VotingStartTime)
+	require.Empty(t, suite.GovKeeper.GetDeposits(ctx, proposal.Id))
+
+	recurring, _ = suite.GovKeeper.GetRecurringProposal(ctx, res.RecurringProposalId)
+	require.Equal(t, proposal.Id, recurring.LastProposalId)
+	require.Equal(t, ctx.BlockTime().Add(interval), *recurring.NextSubmitTime)
+
+	// no proposal is submitted once cancelled
+	_, err = govMsgSvr.CancelRecurringProposal(sdk.WrapSDKContext(ctx), v1.NewMsgCancelRecurringProposal(govAddr, res.RecurringProposalId))
+	require.NoError(t, err)
+	newHeader.Time = ctx.BlockHeader().Time.Add(interval)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, suite.GovKeeper)
+
+	require.Len(t, suite.GovKeeper.GetProposals(ctx), 1)
+	_, found = suite.GovKeeper.GetRecurringProposal(ctx, res.RecurringProposalId)
+	require.False(t, found)
+}
@@ -0,0 +1,103 @@
+package gov_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/atomone/x/gov"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// The size of the state seeded for the tally benchmarks can be set with the
+// following flags, e.g. `make bench-gov` seeds 100k delegators and 50k votes.
+var (
+	benchValidators = flag.Int("gov.bench-validators", 10, "number of validators seeded for the gov benchmarks")
+	benchDelegators = flag.Int("gov.bench-delegators", 1000, "number of delegators seeded for the gov benchmarks")
+	benchVotes      = flag.Int("gov.bench-votes", 500, "number of delegators voting in the gov benchmarks")
+)
+
+// setupTallyBenchmark seeds validators, delegators and the votes of the
+// delegators on a proposal in voting period, and returns the context and the
+// proposal.
+func setupTallyBenchmark(b *testing.B) (suite, sdk.Context, v1.Proposal) {
+	b.Helper()
+	require.LessOrEqual(b, *benchVotes, *benchDelegators, "more votes than delegators")
+
+	suite := createTestSuite(b)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	valAddrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, *benchValidators, valTokens)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+	for _, addr := range valAddrs {
+		valCreateMsg, err := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(addr), ed25519.GenPrivKey().PubKey(), sdk.NewCoin(sdk.DefaultBondDenom, valTokens.QuoRaw(2)),
+			TestDescription, TestCommissionRates, sdk.OneInt(),
+		)
+		require.NoError(b, err)
+		_, err = stakingMsgSvr.CreateValidator(sdk.WrapSDKContext(ctx), valCreateMsg)
+		require.NoError(b, err)
+	}
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	delTokens := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	delAddrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, *benchDelegators, delTokens)
+	for i, addr := range delAddrs {
+		validator, found := suite.StakingKeeper.GetValidator(ctx, sdk.ValAddress(valAddrs[i%len(valAddrs)]))
+		require.True(b, found)
+		_, err := suite.StakingKeeper.Delegate(ctx, addr, delTokens, stakingtypes.Unbonded, validator, true)
+		require.NoError(b, err)
+	}
+	staking.EndBlocker(ctx, suite.StakingKeeper)
+
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(b)}, "", "title", "summary", delAddrs[0], nil, false)
+	require.NoError(b, err)
+	suite.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, _ = suite.GovKeeper.GetProposal(ctx, proposal.Id)
+
+	options := []v1.VoteOption{v1.OptionYes, v1.OptionNo, v1.OptionAbstain, v1.OptionNoWithVeto}
+	for i, addr := range delAddrs[:*benchVotes] {
+		err := suite.GovKeeper.AddVote(ctx, proposal.Id, addr, v1.NewNonSplitVoteOption(options[i%len(options)]), "")
+		require.NoError(b, err)
+	}
+
+	return suite, ctx, proposal
+}
+
+func BenchmarkTally(b *testing.B) {
+	suite, ctx, proposal := setupTallyBenchmark(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// the tally deletes the votes, so they are restored in each iteration
+		// by discarding the writes of the cached context
+		cacheCtx, _ := ctx.CacheContext()
+		suite.GovKeeper.Tally(cacheCtx, proposal)
+	}
+}
+
+func BenchmarkEndBlocker(b *testing.B) {
+	suite, ctx, proposal := setupTallyBenchmark(b)
+	ctx = ctx.WithBlockTime(*proposal.VotingEndTime)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cacheCtx, _ := ctx.CacheContext()
+		gov.EndBlocker(cacheCtx, suite.GovKeeper)
+	}
+}
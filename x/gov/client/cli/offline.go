@@ -0,0 +1,334 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
+)
+
+// NewCmdVerifyVote implements the offline verification of a vote transaction
+// command.
+func NewCmdVerifyVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-vote [file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Verify a vote transaction before signing or broadcasting it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Verify that a transaction generated with --generate-only only holds valid
+votes, and print the votes along with their lock periods and the fee. The
+signatures of the transaction, if any, are verified too. Votes locking the
+stake of the voter are refused unless --allow-locked is set.
+
+With --offline, no node is reached: the account number and the sequence of the
+signer must be set explicitly, so that every signer of a multisig account
+verifies and signs the same bytes.
+
+Example:
+$ %s tx gov vote 1 yes --from cosmos1multisig.. --fees 5000uphoton --generate-only > vote.json
+$ %s tx gov verify-vote vote.json --offline --account-number 12 --sequence 3 --chain-id atomone-1
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			allowLocked, err := cmd.Flags().GetBool(flagAllowLocked)
+			if err != nil {
+				return err
+			}
+			summary, err := verifyVoteTx(stdTx, allowLocked)
+			if err != nil {
+				return err
+			}
+
+			sigTx, ok := stdTx.(authsigning.SigVerifiableTx)
+			if !ok {
+				return errors.New("invalid transaction type")
+			}
+			sigs, err := sigTx.GetSignaturesV2()
+			if err != nil {
+				return err
+			}
+			if len(sigs) > 0 {
+				if err := verifyVoteSignatures(clientCtx, cmd.Flags(), sigTx, sigs); err != nil {
+					return err
+				}
+				summary += fmt.Sprintf("signatures: %d verified\n", len(sigs))
+			}
+
+			return clientCtx.PrintString(summary)
+		},
+	}
+
+	cmd.Flags().Bool(flagAllowLocked, false, "Accept votes locking the stake of the voter")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdMultisignVote implements the aggregation of the signatures of a
+// multisig vote transaction command.
+func NewCmdMultisignVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-vote [file] [multisig-key] [signature-files]...",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "Aggregate the signatures of a multisig vote transaction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Aggregate the signatures of the members of a multisig account on a vote
+transaction into the multisig signature, and print the signed transaction.
+The transaction is verified to only hold valid votes, and each signature is
+verified before being aggregated. Votes locking the stake of the voter are
+refused unless --allow-locked is set.
+
+With --offline, no node is reached: the account number and the sequence of the
+multisig account must be set explicitly, as they were when the members signed.
+
+Example:
+$ %s tx gov multisign-vote vote.json multisig-key signer1.json signer2.json \
+    --offline --account-number 12 --sequence 3 --chain-id atomone-1 > signed_vote.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			allowLocked, err := cmd.Flags().GetBool(flagAllowLocked)
+			if err != nil {
+				return err
+			}
+			if _, err := verifyVoteTx(stdTx, allowLocked); err != nil {
+				return err
+			}
+
+			record, err := clientCtx.Keyring.Key(args[1])
+			if err != nil {
+				return err
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+			multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+			if !ok {
+				return fmt.Errorf("%s is not a multisig key", args[1])
+			}
+			addr := sdk.AccAddress(multisigPub.Address())
+
+			accNum, seq, err := accountNumberSequence(clientCtx, cmd.Flags(), addr)
+			if err != nil {
+				return err
+			}
+
+			txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(stdTx)
+			if err != nil {
+				return err
+			}
+
+			multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+			for _, file := range args[2:] {
+				bz, err := os.ReadFile(file)
+				if err != nil {
+					return err
+				}
+				sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+				if err != nil {
+					return err
+				}
+
+				for _, sig := range sigs {
+					signerData := authsigning.SignerData{
+						Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
+						ChainID:       clientCtx.ChainID,
+						AccountNumber: accNum,
+						Sequence:      seq,
+						PubKey:        sig.PubKey,
+					}
+					if err := authsigning.VerifySignature(sig.PubKey, signerData, sig.Data, clientCtx.TxConfig.SignModeHandler(), txBuilder.GetTx()); err != nil {
+						return fmt.Errorf("couldn't verify the signature of %s in %s: %w", signerData.Address, file, err)
+					}
+					if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
+						return err
+					}
+				}
+			}
+
+			err = txBuilder.SetSignatures(signingtypes.SignatureV2{
+				PubKey:   multisigPub,
+				Data:     multisigSig,
+				Sequence: seq,
+			})
+			if err != nil {
+				return err
+			}
+
+			bz, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+		},
+	}
+
+	cmd.Flags().Bool(flagAllowLocked, false, "Accept votes locking the stake of the voter")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// verifyVoteTx checks that tx only holds valid votes and sets a fee, and
+// returns a summary of the votes and the fee. Locked votes are refused unless
+// allowLocked is set.
+func verifyVoteTx(tx sdk.Tx, allowLocked bool) (string, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return "", errors.New("the transaction holds no vote")
+	}
+
+	var summary strings.Builder
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return "", err
+		}
+
+		switch msg := msg.(type) {
+		case *v1.MsgVote:
+			if err := checkLockPeriods(msg.LockPeriods, allowLocked); err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&summary, "proposal %d: %s votes %s, lock periods: %d\n", msg.ProposalId, msg.Voter, msg.Option, msg.LockPeriods)
+		case *v1.MsgVoteWeighted:
+			if err := checkLockPeriods(msg.LockPeriods, allowLocked); err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&summary, "proposal %d: %s votes %s, lock periods: %d\n", msg.ProposalId, msg.Voter, v1.WeightedVoteOptions(msg.Options), msg.LockPeriods)
+		case *v1beta1.MsgVote:
+			fmt.Fprintf(&summary, "proposal %d: %s votes %s\n", msg.ProposalId, msg.Voter, msg.Option)
+		case *v1beta1.MsgVoteWeighted:
+			fmt.Fprintf(&summary, "proposal %d: %s votes %s\n", msg.ProposalId, msg.Voter, v1beta1.WeightedVoteOptions(msg.Options))
+		default:
+			return "", fmt.Errorf("the transaction holds a %s message, only votes are allowed", sdk.MsgTypeURL(msg))
+		}
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return "", errors.New("invalid transaction type")
+	}
+	if feeTx.GetGas() == 0 {
+		return "", errors.New("the transaction sets no gas limit")
+	}
+	fmt.Fprintf(&summary, "fee: %s, gas: %d\n", feeTx.GetFee(), feeTx.GetGas())
+
+	return summary.String(), nil
+}
+
+// checkLockPeriods refuses a vote locking the stake of the voter unless
+// allowLocked is set.
+func checkLockPeriods(lockPeriods uint32, allowLocked bool) error {
+	if lockPeriods > 0 && !allowLocked {
+		return fmt.Errorf("the vote locks the stake of the voter for %d periods, set --%s to accept it", lockPeriods, flagAllowLocked)
+	}
+	return nil
+}
+
+// verifyVoteSignatures verifies the signatures of a vote transaction.
+func verifyVoteSignatures(clientCtx client.Context, fs *pflag.FlagSet, tx authsigning.SigVerifiableTx, sigs []signingtypes.SignatureV2) error {
+	signers := tx.GetSigners()
+	if len(sigs) != len(signers) {
+		return fmt.Errorf("expected %d signatures, got %d", len(signers), len(sigs))
+	}
+	pubKeys, err := tx.GetPubKeys()
+	if err != nil {
+		return err
+	}
+
+	for i, sig := range sigs {
+		if pubKeys[i] == nil || !signers[i].Equals(sdk.AccAddress(pubKeys[i].Address())) {
+			return fmt.Errorf("the public key of signature %d doesn't match the signer %s", i, signers[i])
+		}
+
+		accNum, seq, err := accountNumberSequence(clientCtx, fs, signers[i])
+		if err != nil {
+			return err
+		}
+		signerData := authsigning.SignerData{
+			Address:       signers[i].String(),
+			ChainID:       clientCtx.ChainID,
+			AccountNumber: accNum,
+			Sequence:      seq,
+			PubKey:        pubKeys[i],
+		}
+		if err := authsigning.VerifySignature(pubKeys[i], signerData, sig.Data, clientCtx.TxConfig.SignModeHandler(), tx); err != nil {
+			return fmt.Errorf("couldn't verify the signature of %s: %w", signers[i], err)
+		}
+	}
+	return nil
+}
+
+// accountNumberSequence returns the account number and sequence of addr. In
+// offline mode they must be set explicitly with the flags, instead of
+// defaulting to zero, so that every signer uses the same values.
+func accountNumberSequence(clientCtx client.Context, fs *pflag.FlagSet, addr sdk.AccAddress) (uint64, uint64, error) {
+	if !clientCtx.Offline {
+		return clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+	}
+
+	if !fs.Changed(flags.FlagAccountNumber) || !fs.Changed(flags.FlagSequence) {
+		return 0, 0, fmt.Errorf("--%s and --%s must be set in offline mode", flags.FlagAccountNumber, flags.FlagSequence)
+	}
+	accNum, err := fs.GetUint64(flags.FlagAccountNumber)
+	if err != nil {
+		return 0, 0, err
+	}
+	seq, err := fs.GetUint64(flags.FlagSequence)
+	if err != nil {
+		return 0, 0, err
+	}
+	return accNum, seq, nil
+}
+
+// checkOfflineVoteFees checks that an offline vote transaction sets its fees
+// explicitly, as the fee market can't be queried. Otherwise the multisig
+// members would sign a transaction rejected for its lack of fees.
+func checkOfflineVoteFees(clientCtx client.Context, fs *pflag.FlagSet) error {
+	if !clientCtx.GenerateOnly || !clientCtx.Offline {
+		return nil
+	}
+	if !fs.Changed(flags.FlagFees) && !fs.Changed(flags.FlagGasPrices) {
+		return fmt.Errorf("--%s or --%s must be set for offline vote transactions", flags.FlagFees, flags.FlagGasPrices)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package cli_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+
+	"github.com/atomone-hub/atomone/x/gov/client/cli"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func (s *CLITestSuite) TestOfflineMultisigVote() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+	pubKeys := make([]cryptotypes.PubKey, len(accounts))
+	for i, account := range accounts {
+		record, err := s.kr.Key(account.Name)
+		s.Require().NoError(err)
+		pubKey, err := record.GetPubKey()
+		s.Require().NoError(err)
+		pubKeys[i] = pubKey
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	_, err := s.kr.SaveMultisig("multi", multisigPub)
+	s.Require().NoError(err)
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	// the unsigned vote transaction of the multisig account
+	txBuilder := s.encCfg.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(v1.NewMsgVote(multisigAddr, 1, v1.OptionYes, "")))
+	txBuilder.SetGasLimit(flags.DefaultGasLimit)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	unsignedTx := s.writeTx(txBuilder)
+
+	offlineFlags := []string{
+		fmt.Sprintf("--%s=true", flags.FlagOffline),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=12", flags.FlagAccountNumber),
+		fmt.Sprintf("--%s=3", flags.FlagSequence),
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(s.baseCtx, cli.NewCmdVerifyVote(), append([]string{unsignedTx}, offlineFlags...))
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), fmt.Sprintf("proposal 1: %s votes VOTE_OPTION_YES, lock periods: 0", multisigAddr))
+	s.Require().Contains(out.String(), "fee: 10stake, gas: 200000")
+
+	// each member signs the transaction offline
+	txf := tx.Factory{}.
+		WithTxConfig(s.encCfg.TxConfig).
+		WithKeybase(s.kr).
+		WithChainID("test-chain").
+		WithAccountNumber(12).
+		WithSequence(3).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	var sigFiles []string
+	for _, account := range accounts {
+		stdTx, err := authclient.ReadTxFromFile(s.baseCtx, unsignedTx)
+		s.Require().NoError(err)
+		signerBuilder, err := s.encCfg.TxConfig.WrapTxBuilder(stdTx)
+		s.Require().NoError(err)
+		s.Require().NoError(tx.Sign(txf, account.Name, signerBuilder, true))
+		sigs, err := signerBuilder.GetTx().GetSignaturesV2()
+		s.Require().NoError(err)
+		bz, err := s.encCfg.TxConfig.MarshalSignatureJSON(sigs)
+		s.Require().NoError(err)
+		sigFiles = append(sigFiles, testutil.WriteToNewTempFile(s.T(), string(bz)).Name())
+	}
+
+	// the account number and sequence are required offline
+	_, err = clitestutil.ExecTestCLICmd(s.baseCtx, cli.NewCmdMultisignVote(), append([]string{unsignedTx, "multi"}, append(sigFiles, offlineFlags[:2]...)...))
+	s.Require().ErrorContains(err, "must be set in offline mode")
+
+	// signatures of another sequence are rejected
+	wrongSeqFlags := append(append([]string{}, offlineFlags[:3]...), fmt.Sprintf("--%s=4", flags.FlagSequence))
+	_, err = clitestutil.ExecTestCLICmd(s.baseCtx, cli.NewCmdMultisignVote(), append([]string{unsignedTx, "multi"}, append(sigFiles, wrongSeqFlags...)...))
+	s.Require().ErrorContains(err, "couldn't verify the signature")
+
+	out, err = clitestutil.ExecTestCLICmd(s.baseCtx, cli.NewCmdMultisignVote(), append([]string{unsignedTx, "multi"}, append(sigFiles, offlineFlags...)...))
+	s.Require().NoError(err)
+	signedTx := testutil.WriteToNewTempFile(s.T(), out.String()).Name()
+
+	out, err = clitestutil.ExecTestCLICmd(s.baseCtx, cli.NewCmdVerifyVote(), append([]string{signedTx}, offlineFlags...))
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), "signatures: 1 verified")
+
+	_, err = clitestutil.ExecTestCLICmd(s.baseCtx, cli.NewCmdVerifyVote(), append([]string{signedTx}, wrongSeqFlags...))
+	s.Require().ErrorContains(err, "couldn't verify the signature")
+}
+
+func (s *CLITestSuite) TestVerifyVoteRejectsOtherMessages() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	addr := accounts[0].Address
+
+	txBuilder := s.encCfg.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(
+		v1.NewMsgVote(addr, 1, v1.OptionYes, ""),
+		v1.NewMsgDeposit(addr, 1, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+	))
+	txBuilder.SetGasLimit(flags.DefaultGasLimit)
+
+	_, err := clitestutil.ExecTestCLICmd(s.baseCtx, cli.NewCmdVerifyVote(), []string{s.writeTx(txBuilder), fmt.Sprintf("--%s=true", flags.FlagOffline)})
+	s.Require().ErrorContains(err, "only votes are allowed")
+}
+
+func (s *CLITestSuite) TestVerifyVoteLockedVotes() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	addr := accounts[0].Address
+
+	msg := v1.NewMsgVote(addr, 1, v1.OptionYes, "")
+	msg.LockPeriods = 2
+	txBuilder := s.encCfg.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(msg))
+	txBuilder.SetGasLimit(flags.DefaultGasLimit)
+	lockedTx := s.writeTx(txBuilder)
+
+	_, err := clitestutil.ExecTestCLICmd(s.baseCtx, cli.NewCmdVerifyVote(), []string{lockedTx, fmt.Sprintf("--%s=true", flags.FlagOffline)})
+	s.Require().ErrorContains(err, "locks the stake of the voter for 2 periods")
+
+	out, err := clitestutil.ExecTestCLICmd(s.baseCtx, cli.NewCmdVerifyVote(), []string{lockedTx, fmt.Sprintf("--%s=true", flags.FlagOffline), "--allow-locked"})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), fmt.Sprintf("proposal 1: %s votes VOTE_OPTION_YES, lock periods: 2", addr))
+}
+
+// writeTx writes the transaction of txBuilder to a JSON file, and returns its
+// name.
+func (s *CLITestSuite) writeTx(txBuilder client.TxBuilder) string {
+	bz, err := s.encCfg.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	return testutil.WriteToNewTempFile(s.T(), string(bz)).Name()
+}
@@ -19,6 +19,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
 const (
@@ -45,6 +46,10 @@ var suggestedProposalTypes = []proposalType{
 		Name:    "cancel-software-upgrade",
 		MsgType: "/cosmos.upgrade.v1beta1.MsgCancelUpgrade",
 	},
+	{
+		Name:    "constitution-amendment",
+		MsgType: "/atomone.gov.v1.MsgProposeConstitutionAmendment",
+	},
 	{
 		Name:    proposalOther,
 		MsgType: "", // user will input the message type
@@ -175,7 +180,14 @@ func (p *proposalType) Prompt(cdc codec.Codec, skipMetadata bool) (*proposal, ty
 	}
 
 	// set messages field
-	result, err := Prompt(p.Msg, "msg")
+	var result sdk.Msg
+	switch msg := p.Msg.(type) {
+	case *v1.MsgProposeConstitutionAmendment:
+		// the amended constitution is too long to be prompted, read it from a file
+		result, err = promptConstitutionAmendment(msg)
+	default:
+		result, err = Prompt(p.Msg, "msg")
+	}
 	if err != nil {
 		return nil, metadata, fmt.Errorf("failed to set proposal message: %w", err)
 	}
@@ -189,6 +201,33 @@ func (p *proposalType) Prompt(cdc codec.Codec, skipMetadata bool) (*proposal, ty
 	return proposal, metadata, nil
 }
 
+// promptConstitutionAmendment prompts for the file containing the full text of
+// the amended constitution, and sets it in the message.
+func promptConstitutionAmendment(msg *v1.MsgProposeConstitutionAmendment) (*v1.MsgProposeConstitutionAmendment, error) {
+	msg.Authority = authtypes.NewModuleAddress(types.ModuleName).String()
+
+	pathPrompt := promptui.Prompt{
+		Label:    "Enter path to the amended constitution file",
+		Validate: client.ValidatePromptNotEmpty,
+	}
+
+	path, err := pathPrompt.Run()
+	if err != nil {
+		return nil, fmt.Errorf("failed to prompt for constitution file: %w", err)
+	}
+
+	constitution, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read constitution file: %w", err)
+	}
+	if len(strings.TrimSpace(string(constitution))) == 0 {
+		return nil, fmt.Errorf("constitution file %s is empty", path)
+	}
+	msg.Constitution = string(constitution)
+
+	return msg, nil
+}
+
 // getProposalSuggestions suggests a list of proposal types
 func getProposalSuggestions() []string {
 	types := make([]string, len(suggestedProposalTypes))
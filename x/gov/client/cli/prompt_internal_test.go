@@ -0,0 +1,62 @@
+//go:build !race
+// +build !race
+
+// Disabled -race because the package github.com/manifoldco/promptui@v0.9.0
+// has a data race and this code exposes it.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chzyer/readline"
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestPromptConstitutionAmendment(t *testing.T) {
+	dir := t.TempDir()
+	constitutionFile := filepath.Join(dir, "constitution.md")
+	require.NoError(t, os.WriteFile(constitutionFile, []byte("# Constitution\n\nAmended.\n"), 0o600))
+	emptyFile := filepath.Join(dir, "empty.md")
+	require.NoError(t, os.WriteFile(emptyFile, []byte("\n"), 0o600))
+
+	testCases := []struct {
+		name   string
+		path   string
+		expErr bool
+	}{
+		{"constitution file", constitutionFile, false},
+		{"empty file", emptyFile, true},
+		{"missing file", filepath.Join(dir, "missing.md"), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			origStdin := readline.Stdin
+			defer func() {
+				readline.Stdin = origStdin
+			}()
+
+			fin, fw := readline.NewFillableStdin(os.Stdin)
+			readline.Stdin = fin
+			fw.Write([]byte(tc.path + "\n"))
+
+			msg, err := promptConstitutionAmendment(&v1.MsgProposeConstitutionAmendment{})
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), msg.Authority)
+			require.Equal(t, "# Constitution\n\nAmended.\n", msg.Constitution)
+			require.NoError(t, msg.ValidateBasic())
+		})
+	}
+}
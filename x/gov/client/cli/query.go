@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"math"
+	"os"
 	"strconv"
 	"strings"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	gcutils "github.com/atomone-hub/atomone/x/gov/client/utils"
@@ -31,6 +34,7 @@ func GetQueryCmd() *cobra.Command {
 	govQueryCmd.AddCommand(
 		GetCmdQueryProposal(),
 		GetCmdQueryProposals(),
+		GetCmdQueryArchivedProposal(),
 		GetCmdQueryVote(),
 		GetCmdQueryVotes(),
 		GetCmdQueryParams(),
@@ -39,6 +43,16 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdConstitution(),
+		GetCmdQueryGovernanceStats(),
+		GetCmdQueryProposalCount(),
+		GetCmdQueryExportVotes(),
+		GetCmdQueryBountyPool(),
+		GetCmdQueryProposerBounty(),
+		GetCmdQueryCommunityPoolSpend(),
+		GetCmdQueryVoteLock(),
+		GetCmdQuerySimulateProposalExecution(),
+		GetCmdQueryRecurringProposals(),
 	)
 
 	return govQueryCmd
@@ -91,6 +105,53 @@ $ %s query gov proposal 1
 	return cmd
 }
 
+// GetCmdQueryArchivedProposal implements the query archived proposal command.
+func GetCmdQueryArchivedProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archived-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the archived summary of a pruned proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the archived summary of a completed proposal that has been pruned
+from state after the proposal retention period, including its final status
+and tally result.
+
+Example:
+$ %s query gov archived-proposal 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ArchivedProposal(
+				cmd.Context(),
+				&v1.QueryArchivedProposalRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.ArchivedProposal)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryProposals implements a query proposals command. Command to Get
 // Proposals Information.
 func GetCmdQueryProposals() *cobra.Command {
@@ -103,16 +164,24 @@ func GetCmdQueryProposals() *cobra.Command {
 Example:
 $ %s query gov proposals --depositor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 $ %s query gov proposals --voter cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %s query gov proposals --proposer cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %s query gov proposals --msg-type-url /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade
 $ %s query gov proposals --status (DepositPeriod|VotingPeriod|Passed|Rejected)
 $ %s query gov proposals --page=2 --limit=100
+$ %s query gov proposals --summary-only
+$ %s query gov proposals --order-by (id_asc|id_desc|voting_end_time|total_deposit)
 `,
-				version.AppName, version.AppName, version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			bechDepositorAddr, _ := cmd.Flags().GetString(flagDepositor)
 			bechVoterAddr, _ := cmd.Flags().GetString(flagVoter)
+			bechProposerAddr, _ := cmd.Flags().GetString(flagProposer)
 			strProposalStatus, _ := cmd.Flags().GetString(flagStatus)
+			msgTypeURL, _ := cmd.Flags().GetString(flagMsgTypeURL)
+			summaryOnly, _ := cmd.Flags().GetBool(flagSummaryOnly)
+			strOrderBy, _ := cmd.Flags().GetString(flagOrderBy)
 
 			var proposalStatus v1.ProposalStatus
 
@@ -130,6 +199,13 @@ $ %s query gov proposals --page=2 --limit=100
 				}
 			}
 
+			if len(bechProposerAddr) != 0 {
+				_, err := sdk.AccAddressFromBech32(bechProposerAddr)
+				if err != nil {
+					return err
+				}
+			}
+
 			if len(strProposalStatus) != 0 {
 				proposalStatus1, err := v1.ProposalStatusFromString(gcutils.NormalizeProposalStatus(strProposalStatus))
 				proposalStatus = proposalStatus1
@@ -138,6 +214,15 @@ $ %s query gov proposals --page=2 --limit=100
 				}
 			}
 
+			var orderBy v1.ProposalsOrderBy
+			if len(strOrderBy) != 0 {
+				num, ok := v1.ProposalsOrderBy_value[gcutils.NormalizeProposalsOrderBy(strOrderBy)]
+				if !ok {
+					return fmt.Errorf("'%s' is not a valid proposals order", strOrderBy)
+				}
+				orderBy = v1.ProposalsOrderBy(num)
+			}
+
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -155,7 +240,11 @@ $ %s query gov proposals --page=2 --limit=100
 					ProposalStatus: proposalStatus,
 					Voter:          bechVoterAddr,
 					Depositor:      bechDepositorAddr,
+					Proposer:       bechProposerAddr,
+					MsgTypeUrl:     msgTypeURL,
 					Pagination:     pageReq,
+					Summary:        summaryOnly,
+					OrderBy:        orderBy,
 				},
 			)
 			if err != nil {
@@ -172,7 +261,11 @@ $ %s query gov proposals --page=2 --limit=100
 
 	cmd.Flags().String(flagDepositor, "", "(optional) filter by proposals deposited on by depositor")
 	cmd.Flags().String(flagVoter, "", "(optional) filter by proposals voted on by voted")
+	cmd.Flags().String(flagProposer, "", "(optional) filter by proposals submitted by proposer")
+	cmd.Flags().String(flagMsgTypeURL, "", "(optional) filter by proposals containing a message of the given type URL")
 	cmd.Flags().String(flagStatus, "", "(optional) filter proposals by proposal status, status: deposit_period/voting_period/passed/rejected")
+	cmd.Flags().Bool(flagSummaryOnly, false, "(optional) return the proposals without their messages")
+	cmd.Flags().String(flagOrderBy, "", "(optional) order of the proposals: id_asc/id_desc/voting_end_time/total_deposit, reversed by --reverse")
 	flags.AddPaginationFlagsToCmd(cmd, "proposals")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -293,7 +386,7 @@ $ %[1]s query gov votes 1 --page=2 --limit=100
 			}
 
 			propStatus := proposalRes.GetProposal().Status
-			if !(propStatus == v1.StatusVotingPeriod || propStatus == v1.StatusDepositPeriod) {
+			if !(propStatus == v1.StatusVotingPeriod || propStatus == v1.StatusDepositPeriod || propStatus == v1.StatusReviewPeriod) {
 				page, _ := cmd.Flags().GetInt(flags.FlagPage)
 				limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
 
@@ -462,10 +555,14 @@ func GetCmdQueryTally() *cobra.Command {
 			fmt.Sprintf(`Query tally of votes on a proposal. You can find
 the proposal-id by running "%s query gov proposals".
 
+When querying an archive node, the --height flag can be used to recompute the
+tally of a proposal in voting period as of a past block height.
+
 Example:
 $ %s query gov tally 1
+$ %s query gov tally 1 --height 123456
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -653,3 +750,487 @@ $ %s query gov proposer 1
 
 	return cmd
 }
+
+// GetCmdConstitution implements the query constitution command.
+func GetCmdConstitution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "constitution",
+		Short: "Get the constitution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current constitution of the chain.
+
+Example:
+$ %s query gov constitution
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Constitution(cmd.Context(), &v1.QueryConstitutionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernanceStats implements the query governance stats command.
+func GetCmdQueryGovernanceStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Query aggregate statistics about the governance process",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of proposals in each status and the average turnout
+of the most recently tallied proposals.
+
+Example:
+$ %s query gov stats --last-n 20
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			lastN, err := cmd.Flags().GetUint64(flagLastN)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovernanceStats(
+				cmd.Context(),
+				&v1.QueryGovernanceStatsRequest{LastN: lastN},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagLastN, 0, "number of most recently tallied proposals to average the turnout over (default 10, max 100)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProposalCount implements the query proposal count command.
+func GetCmdQueryProposalCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-count",
+		Short: "Query the number of proposals and the next proposal ID",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total number of proposals, including the archived ones,
+the number of proposals in each status and the ID that the next submitted
+proposal will get.
+
+Example:
+$ %s query gov proposal-count
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalCount(cmd.Context(), &v1.QueryProposalCountRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExportVotes implements the command exporting all the votes of a
+// proposal to a file.
+func GetCmdQueryExportVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-votes [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Export all the votes on a proposal in CSV or JSON format",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export all the votes on a proposal, fetching every page of votes, in CSV
+(one row per vote option) or JSON format. The votes are written to the file
+given by --out-file, or to the standard output if not set.
+
+Votes of proposals whose voting period has ended are no longer in state and are
+rebuilt from the vote transactions, which requires a node with tx indexing.
+
+Example:
+$ %[1]s query gov export-votes 1 --format csv --out-file votes.csv
+$ %[1]s query gov export-votes 1 --format json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			format, _ := cmd.Flags().GetString(flagFormat)
+			if format != "csv" && format != "json" {
+				return fmt.Errorf("invalid format %s, must be csv or json", format)
+			}
+
+			// check to see if the proposal is in the store
+			ctx := cmd.Context()
+			proposalRes, err := queryClient.Proposal(
+				ctx,
+				&v1.QueryProposalRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to fetch proposal-id %d: %s", proposalID, err)
+			}
+
+			var votes []*v1.Vote
+			propStatus := proposalRes.GetProposal().Status
+			if !(propStatus == v1.StatusVotingPeriod || propStatus == v1.StatusDepositPeriod || propStatus == v1.StatusReviewPeriod) {
+				params := v1.NewQueryProposalVotesParams(proposalID, 1, math.MaxInt32)
+				resByTxQuery, err := gcutils.QueryVotesByTxQuery(clientCtx, params)
+				if err != nil {
+					return err
+				}
+
+				clientCtx.LegacyAmino.MustUnmarshalJSON(resByTxQuery, &votes)
+			} else {
+				pageReq := &query.PageRequest{}
+				for {
+					res, err := queryClient.Votes(
+						ctx,
+						&v1.QueryVotesRequest{ProposalId: proposalID, Pagination: pageReq},
+					)
+					if err != nil {
+						return err
+					}
+
+					votes = append(votes, res.Votes...)
+					if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+						break
+					}
+					pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+				}
+			}
+
+			out := cmd.OutOrStdout()
+			if outFile, _ := cmd.Flags().GetString(flagOutFile); outFile != "" {
+				f, err := os.Create(outFile)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			if format == "csv" {
+				return writeVotesCSV(out, votes)
+			}
+			return writeVotesJSON(clientCtx.Codec, out, votes)
+		},
+	}
+
+	cmd.Flags().String(flagFormat, "csv", "export format, csv or json")
+	cmd.Flags().String(flagOutFile, "", "file to write the votes to, defaults to the standard output")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBountyPool implements the query proposer bounty pool command.
+func GetCmdQueryBountyPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bounty-pool",
+		Args:  cobra.NoArgs,
+		Short: "Query the proposer bounty pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proposer bounty pool, funded by a share of the burned deposits
+and paying a bounty to the proposers of passed proposals.
+
+Example:
+$ %s query gov bounty-pool
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BountyPool(cmd.Context(), &v1.QueryBountyPoolRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.BountyPool)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProposerBounty implements the query proposer bounty command.
+func GetCmdQueryProposerBounty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposer-bounty [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the bounty paid to the proposer of a passed proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bounty paid from the proposer bounty pool to the proposer of a
+passed proposal.
+
+Example:
+$ %s query gov proposer-bounty 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ProposerBounty(
+				cmd.Context(),
+				&v1.QueryProposerBountyRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.ProposerBounty)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCommunityPoolSpend implements the query community pool spend
+// command.
+func GetCmdQueryCommunityPoolSpend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-spend",
+		Args:  cobra.NoArgs,
+		Short: "Query the community pool spend limit and the queued proposals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current community pool spend period, the spend limit and the
+remaining amount that can be spent during the period, and the passed
+proposals queued until the limit allows their execution.
+
+Example:
+$ %s query gov community-pool-spend
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CommunityPoolSpend(cmd.Context(), &v1.QueryCommunityPoolSpendRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVoteLock implements the query vote lock command.
+func GetCmdQueryVoteLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-lock [voter-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the stake locked by a voter to boost its votes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the delegation shares locked by a voter to boost its votes, and
+the time they are unlocked at.
+
+Example:
+$ %s query gov vote-lock cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.VoteLock(
+				cmd.Context(),
+				&v1.QueryVoteLockRequest{Voter: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.VoteLock)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySimulateProposalExecution implements the query proposal execution
+// simulation command.
+func GetCmdQuerySimulateProposalExecution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-execution [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the execution of the messages of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Run the messages of a proposal as if it passed in the current state,
+without writing any state change, and return the gas used and the error of
+each message.
+
+Example:
+$ %s query gov simulate-execution 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.SimulateProposalExecution(
+				cmd.Context(),
+				&v1.QuerySimulateProposalExecutionRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRecurringProposals implements the query recurring proposals
+// command.
+func GetCmdQueryRecurringProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recurring-proposals",
+		Args:  cobra.NoArgs,
+		Short: "Query the recurring proposals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the recurring proposals scheduled by governance, with the time
+of their next submission.
+
+Example:
+$ %s query gov recurring-proposals
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RecurringProposals(
+				cmd.Context(),
+				&v1.QueryRecurringProposalsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "recurring proposals")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 
 	govutils "github.com/atomone-hub/atomone/x/gov/client/utils"
 	"github.com/atomone-hub/atomone/x/gov/types"
@@ -25,13 +28,30 @@ const (
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagDescription = "description"
 	// Deprecated: only used for v1beta1 legacy proposals.
-	FlagProposalType = "type"
-	FlagDeposit      = "deposit"
-	flagVoter        = "voter"
-	flagDepositor    = "depositor"
-	flagStatus       = "status"
-	FlagMetadata     = "metadata"
-	FlagSummary      = "summary"
+	FlagProposalType   = "type"
+	FlagDeposit        = "deposit"
+	flagVoter          = "voter"
+	flagDepositor      = "depositor"
+	flagProposer       = "proposer"
+	flagStatus         = "status"
+	flagMsgTypeURL     = "msg-type-url"
+	flagSummaryOnly    = "summary-only"
+	flagOrderBy        = "order-by"
+	flagFormat         = "format"
+	flagOutFile        = "out-file"
+	flagLastN          = "last-n"
+	flagAllowed        = "allowed-options"
+	flagExpiration     = "expiration"
+	flagValidateOnly   = "validate-only"
+	flagVotingPeriod   = "voting-period"
+	flagQuadratic      = "quadratic"
+	flagLockPeriods    = "lock-periods"
+	flagMaxLockPeriods = "max-lock-periods"
+	flagAllowLocked    = "allow-locked"
+	flagRefundAddr     = "refund-address"
+	flagSpendLimit     = "spend-limit"
+	FlagMetadata       = "metadata"
+	FlagSummary        = "summary"
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
 )
@@ -72,6 +92,10 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
+		NewCmdGrantVote(),
+		NewCmdGrantGovFees(),
+		NewCmdVerifyVote(),
+		NewCmdMultisignVote(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -142,10 +166,36 @@ metadata example:
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			votingPeriod, err := cmd.Flags().GetDuration(flagVotingPeriod)
+			if err != nil {
+				return err
+			}
+			if votingPeriod != 0 {
+				msg.VotingPeriod = &votingPeriod
+			}
+			msg.Quadratic, err = cmd.Flags().GetBool(flagQuadratic)
+			if err != nil {
+				return err
+			}
+
+			validateOnly, _ := cmd.Flags().GetBool(flagValidateOnly)
+			if validateOnly {
+				queryClient := v1.NewQueryClient(clientCtx)
+				_, err := queryClient.ValidateProposal(cmd.Context(), &v1.QueryValidateProposalRequest{Proposal: msg})
+				if err != nil {
+					return fmt.Errorf("invalid proposal: %w", err)
+				}
+
+				return clientCtx.PrintString("proposal is valid\n")
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(flagValidateOnly, false, "Validate the proposal against the chain state without broadcasting it")
+	cmd.Flags().Duration(flagVotingPeriod, 0, "Voting period requested for the proposal, within the min_voting_period and max_voting_period params (defaults to the voting_period param)")
+	cmd.Flags().Bool(flagQuadratic, false, "Tally the proposal quadratically, requires the quadratic_voting_enabled param")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -230,6 +280,9 @@ func NewCmdDeposit() *cobra.Command {
 			fmt.Sprintf(`Submit a deposit for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
 
+The deposit is refunded to the depositor, or to the --refund-address if set,
+for instance when depositing on behalf of a user.
+
 Example:
 $ %s tx gov deposit 1 10stake --from mykey
 `,
@@ -258,11 +311,13 @@ $ %s tx gov deposit 1 10stake --from mykey
 			}
 
 			msg := v1.NewMsgDeposit(from, proposalID, amount)
+			msg.RefundAddress, _ = cmd.Flags().GetString(flagRefundAddr)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(flagRefundAddr, "", "Address the deposit is refunded to instead of the depositor")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -309,14 +364,25 @@ $ %s tx gov vote 1 yes --from mykey
 				return err
 			}
 
+			lockPeriods, err := cmd.Flags().GetUint32(flagLockPeriods)
+			if err != nil {
+				return err
+			}
+
+			if err := checkOfflineVoteFees(clientCtx, cmd.Flags()); err != nil {
+				return err
+			}
+
 			// Build vote message and run basic validation
 			msg := v1.NewMsgVote(from, proposalID, byteVoteOption, metadata)
+			msg.LockPeriods = lockPeriods
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the vote")
+	cmd.Flags().Uint32(flagLockPeriods, 0, "Lock the delegated stake for a number of unbonding periods to multiply its voting power, up to the max_vote_lock_periods param")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -364,13 +430,167 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 				return err
 			}
 
+			lockPeriods, err := cmd.Flags().GetUint32(flagLockPeriods)
+			if err != nil {
+				return err
+			}
+
+			if err := checkOfflineVoteFees(clientCtx, cmd.Flags()); err != nil {
+				return err
+			}
+
 			// Build vote message and run basic validation
 			msg := v1.NewMsgVoteWeighted(from, proposalID, options, metadata)
+			msg.LockPeriods = lockPeriods
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(FlagMetadata, "", "Specify metadata of the weighted vote")
+	cmd.Flags().Uint32(flagLockPeriods, 0, "Lock the delegated stake for a number of unbonding periods to multiply its voting power, up to the max_vote_lock_periods param")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdGrantVote implements granting a vote authorization transaction command.
+func NewCmdGrantVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-vote [grantee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Grant an account the authorization to vote on your behalf",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an account the authorization to vote on your behalf, through
+authz, optionally restricted to a set of vote options. The grantee can't lock
+your stake when voting, unless --max-lock-periods is set.
+
+Example:
+$ %s tx gov grant-vote cosmos1... --allowed-options yes,abstain --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowed, err := cmd.Flags().GetStringSlice(flagAllowed)
+			if err != nil {
+				return err
+			}
+
+			var options []v1.VoteOption
+			for _, option := range allowed {
+				voteOption, err := v1.VoteOptionFromString(govutils.NormalizeVoteOption(option))
+				if err != nil {
+					return err
+				}
+				options = append(options, voteOption)
+			}
+
+			exp, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+			var expiration *time.Time
+			if exp != 0 {
+				e := time.Unix(exp, 0)
+				expiration = &e
+			}
+
+			maxLockPeriods, err := cmd.Flags().GetUint32(flagMaxLockPeriods)
+			if err != nil {
+				return err
+			}
+			authorization := v1.NewVoteAuthorization(options...)
+			authorization.MaxLockPeriods = maxLockPeriods
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagAllowed, nil, "Vote options the grantee can vote with (yes/no/no_with_veto/abstain), defaults to any option")
+	cmd.Flags().Uint32(flagMaxLockPeriods, 0, "Maximum number of unbonding periods the grantee can lock your stake for when voting, defaults to none")
+	cmd.Flags().Int64(flagExpiration, 0, "Expire time of the authorization as a unix timestamp, defaults to no expiration")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdGrantGovFees implements granting a fee allowance restricted to the
+// governance actions transaction command.
+func NewCmdGrantGovFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-fees [grantee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Sponsor the fees of the votes and deposits of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant an account a fee allowance restricted to the governance actions, its
+votes and deposits, optionally limited to a total amount of fees. The grantee
+uses the allowance by setting the --fee-granter flag to the granter address.
+
+Example:
+$ %s tx gov grant-fees cosmos1... --spend-limit 1000000uphoton --from mykey
+$ %s tx gov vote 1 yes --fee-granter cosmos1... --from grantee
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetString(flagSpendLimit)
+			if err != nil {
+				return err
+			}
+			spendLimit, err := sdk.ParseCoinsNormalized(limit)
+			if err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(flagExpiration)
+			if err != nil {
+				return err
+			}
+			var expiration *time.Time
+			if exp != 0 {
+				e := time.Unix(exp, 0)
+				expiration = &e
+			}
+
+			allowance, err := v1.NewGovActionsAllowance(spendLimit, expiration)
+			if err != nil {
+				return err
+			}
+			msg, err := feegrant.NewMsgGrantAllowance(allowance, clientCtx.GetFromAddress(), grantee)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "Maximum amount of fees the grantee can spend, defaults to no limit")
+	cmd.Flags().Int64(flagExpiration, 0, "Expire time of the allowance as a unix timestamp, defaults to no expiration")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -382,6 +382,17 @@ func (s *CLITestSuite) TestNewCmdVote() {
 			},
 			false, 0,
 		},
+		{
+			"offline vote without fees",
+			[]string{
+				"1",
+				"yes",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+				fmt.Sprintf("--%s=true", flags.FlagOffline),
+			},
+			true, 0,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -170,3 +173,49 @@ func ReadGovPropFlags(clientCtx client.Context, flagSet *pflag.FlagSet) (*govv1.
 
 	return rv, nil
 }
+
+// writeVotesCSV writes the votes to w in CSV format, with one row per vote
+// option and weight.
+func writeVotesCSV(w io.Writer, votes []*govv1.Vote) error {
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write([]string{"proposal_id", "voter", "option", "weight"}); err != nil {
+		return err
+	}
+
+	for _, vote := range votes {
+		for _, option := range vote.Options {
+			record := []string{
+				strconv.FormatUint(vote.ProposalId, 10),
+				vote.Voter,
+				option.Option.String(),
+				option.Weight,
+			}
+			if err := csvWriter.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// writeVotesJSON writes the votes to w as a JSON array.
+func writeVotesJSON(cdc codec.JSONCodec, w io.Writer, votes []*govv1.Vote) error {
+	jsonVotes := make([]json.RawMessage, 0, len(votes))
+	for _, vote := range votes {
+		bz, err := cdc.MarshalJSON(vote)
+		if err != nil {
+			return err
+		}
+		jsonVotes = append(jsonVotes, bz)
+	}
+
+	bz, err := json.MarshalIndent(jsonVotes, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, string(bz))
+	return err
+}
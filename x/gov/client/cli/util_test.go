@@ -3,6 +3,7 @@ package cli
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -685,3 +686,36 @@ func TestReadGovPropFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteVotes(t *testing.T) {
+	vote1 := v1.NewVote(1, sdk.AccAddress("voter1"), v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	vote2 := v1.NewVote(1, sdk.AccAddress("voter2"), v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionNo, sdk.NewDecWithPrec(7, 1)),
+		v1.NewWeightedVoteOption(v1.OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	}, "")
+	votes := []*v1.Vote{&vote1, &vote2}
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, writeVotesCSV(&buf, votes))
+
+		expected := fmt.Sprintf(`proposal_id,voter,option,weight
+1,%[1]s,VOTE_OPTION_YES,1.000000000000000000
+1,%[2]s,VOTE_OPTION_NO,0.700000000000000000
+1,%[2]s,VOTE_OPTION_ABSTAIN,0.300000000000000000
+`, sdk.AccAddress("voter1"), sdk.AccAddress("voter2"))
+		require.Equal(t, expected, buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+		require.NoError(t, writeVotesJSON(cdc, &buf, votes))
+
+		var exported []map[string]interface{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &exported))
+		require.Len(t, exported, 2)
+		require.Equal(t, sdk.AccAddress("voter2").String(), exported[1]["voter"])
+		require.Len(t, exported[1]["options"], 2)
+	})
+}
@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// StoreQueryPath is the ABCI query path of the raw entries of the gov store,
+// the key of the entry being passed as the query data. When queried with
+// prove set, the response holds the ICS-23 proof of the entry, or of its
+// absence.
+const StoreQueryPath = "/store/" + types.StoreKey + "/key"
+
+// StoreProof is the proof of a gov store entry at a height. It is verified
+// against the app hash of the header of the next block, which commits the
+// state of the height.
+type StoreProof struct {
+	Key    []byte
+	Value  []byte
+	Height int64
+	Proof  *cmtcrypto.ProofOps
+}
+
+// NewStoreProof returns the proof of a gov store entry from the response of a
+// proven query of StoreQueryPath.
+func NewStoreProof(res abci.ResponseQuery) StoreProof {
+	return StoreProof{
+		Key:    res.Key,
+		Value:  res.Value,
+		Height: res.Height,
+		Proof:  res.ProofOps,
+	}
+}
+
+// MerkleKeyPath returns the key path of a gov store entry from the app hash,
+// through the gov store root, as expected by the proof runtime.
+func MerkleKeyPath(key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingHex).
+		String()
+}
+
+// Verify verifies the proof against appHash: the existence of the entry with
+// its value, or its absence if the value is empty.
+func (p StoreProof) Verify(appHash []byte) error {
+	if p.Proof == nil {
+		return fmt.Errorf("no proof for key %X", p.Key)
+	}
+	prt := rootmulti.DefaultProofRuntime()
+	if len(p.Value) == 0 {
+		return prt.VerifyAbsence(p.Proof, appHash, MerkleKeyPath(p.Key))
+	}
+	return prt.VerifyValue(p.Proof, appHash, MerkleKeyPath(p.Key), p.Value)
+}
+
+// QueryStoreWithProof queries a gov store entry with its proof, at the height
+// of clientCtx or the latest one if unset.
+func QueryStoreWithProof(clientCtx client.Context, key []byte) (StoreProof, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   StoreQueryPath,
+		Data:   key,
+		Height: clientCtx.Height,
+		Prove:  true,
+	})
+	if err != nil {
+		return StoreProof{}, err
+	}
+	return NewStoreProof(res), nil
+}
+
+// QueryProposalWithProof queries a proposal with the proof of its store entry.
+// The final tally result of a completed proposal is part of the proposal, so
+// the proof also proves its tally.
+func QueryProposalWithProof(clientCtx client.Context, proposalID uint64) (v1.Proposal, StoreProof, error) {
+	proof, err := QueryStoreWithProof(clientCtx, types.ProposalKey(proposalID))
+	if err != nil {
+		return v1.Proposal{}, StoreProof{}, err
+	}
+	if len(proof.Value) == 0 {
+		return v1.Proposal{}, proof, fmt.Errorf("proposal %d doesn't exist", proposalID)
+	}
+
+	var proposal v1.Proposal
+	if err := clientCtx.Codec.Unmarshal(proof.Value, &proposal); err != nil {
+		return v1.Proposal{}, StoreProof{}, err
+	}
+	return proposal, proof, nil
+}
+
+// QueryArchivedProposalWithProof queries the archived summary of a pruned
+// proposal, holding its final tally result, with the proof of its store entry.
+func QueryArchivedProposalWithProof(clientCtx client.Context, proposalID uint64) (v1.ArchivedProposal, StoreProof, error) {
+	proof, err := QueryStoreWithProof(clientCtx, types.ArchivedProposalKey(proposalID))
+	if err != nil {
+		return v1.ArchivedProposal{}, StoreProof{}, err
+	}
+	if len(proof.Value) == 0 {
+		return v1.ArchivedProposal{}, proof, fmt.Errorf("archived proposal %d doesn't exist", proposalID)
+	}
+
+	var archived v1.ArchivedProposal
+	if err := clientCtx.Codec.Unmarshal(proof.Value, &archived); err != nil {
+		return v1.ArchivedProposal{}, StoreProof{}, err
+	}
+	return archived, proof, nil
+}
+
+// QueryVoteWithProof queries a vote with the proof of its store entry. The
+// votes are removed from the store once the proposal is tallied, so they can
+// only be proven at the heights of the voting period.
+func QueryVoteWithProof(clientCtx client.Context, proposalID uint64, voter sdk.AccAddress) (v1.Vote, StoreProof, error) {
+	proof, err := QueryStoreWithProof(clientCtx, types.VoteKey(proposalID, voter))
+	if err != nil {
+		return v1.Vote{}, StoreProof{}, err
+	}
+	if len(proof.Value) == 0 {
+		return v1.Vote{}, proof, fmt.Errorf("voter %s has not voted on proposal %d", voter, proposalID)
+	}
+
+	var vote v1.Vote
+	if err := clientCtx.Codec.Unmarshal(proof.Value, &vote); err != nil {
+		return v1.Vote{}, StoreProof{}, err
+	}
+	return vote, proof, nil
+}
+
+// QueryProofAppHash returns the app hash the proofs at height are verified
+// against, from the header of the next block. The header is trusted from the
+// node of clientCtx, light clients verify the app hash of their own headers
+// instead.
+func QueryProofAppHash(clientCtx client.Context, height int64) ([]byte, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	next := height + 1
+	commit, err := node.Commit(context.Background(), &next)
+	if err != nil {
+		return nil, err
+	}
+	return commit.AppHash, nil
+}
@@ -0,0 +1,104 @@
+package utils_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/rpc/client/mock"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/atomone-hub/atomone/x/gov"
+	"github.com/atomone-hub/atomone/x/gov/client/utils"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// storeNodeMock serves the store queries and the commits of a multistore, as
+// a node would.
+type storeNodeMock struct {
+	mock.Client
+	store *rootmulti.Store
+}
+
+func (m storeNodeMock) ABCIQueryWithOptions(_ context.Context, path string, data bytes.HexBytes, opts client.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res := m.store.Query(abci.RequestQuery{
+		Path:   strings.TrimPrefix(path, "/store"),
+		Data:   data,
+		Height: opts.Height,
+		Prove:  opts.Prove,
+	})
+	return &coretypes.ResultABCIQuery{Response: res}, nil
+}
+
+func (m storeNodeMock) Commit(_ context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	// the header of the next block holds the app hash of the height
+	commitInfo, err := m.store.GetCommitInfo(*height - 1)
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultCommit{SignedHeader: tmtypes.SignedHeader{
+		Header: &tmtypes.Header{AppHash: commitInfo.Hash()},
+	}}, nil
+}
+
+func TestStoreProofs(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	voter := sdk.AccAddress("voter")
+	proposal := v1.Proposal{Id: 1, Status: v1.StatusVotingPeriod, Title: "title"}
+	vote := v1.NewVote(1, voter, v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	store := ms.GetKVStore(key)
+	store.Set(types.ProposalKey(1), cdc.MustMarshal(&proposal))
+	store.Set(types.VoteKey(1, voter), cdc.MustMarshal(&vote))
+	ms.Commit()
+
+	clientCtx := sdkclient.Context{}.
+		WithClient(storeNodeMock{store: ms}).
+		WithCodec(cdc).
+		WithHeight(1)
+	appHash, err := utils.QueryProofAppHash(clientCtx, 1)
+	require.NoError(t, err)
+
+	gotProposal, proof, err := utils.QueryProposalWithProof(clientCtx, 1)
+	require.NoError(t, err)
+	require.Equal(t, proposal, gotProposal)
+	require.Equal(t, int64(1), proof.Height)
+	require.NoError(t, proof.Verify(appHash))
+
+	gotVote, proof, err := utils.QueryVoteWithProof(clientCtx, 1, voter)
+	require.NoError(t, err)
+	require.Equal(t, vote, gotVote)
+	require.NoError(t, proof.Verify(appHash))
+
+	// a tampered value doesn't verify
+	proof.Value = cdc.MustMarshal(&v1.Vote{ProposalId: 1, Voter: voter.String(), Options: v1.NewNonSplitVoteOption(v1.OptionNo)})
+	require.Error(t, proof.Verify(appHash))
+
+	// the absence of an entry is proven
+	_, proof, err = utils.QueryVoteWithProof(clientCtx, 1, sdk.AccAddress("other"))
+	require.Error(t, err)
+	require.NoError(t, proof.Verify(appHash))
+	_, _, err = utils.QueryArchivedProposalWithProof(clientCtx, 1)
+	require.Error(t, err)
+}
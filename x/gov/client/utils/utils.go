@@ -3,6 +3,7 @@ package utils
 import (
 	"strings"
 
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
 
@@ -62,7 +63,27 @@ func NormalizeProposalStatus(status string) string {
 		return v1beta1.StatusPassed.String()
 	case "Rejected", "rejected":
 		return v1beta1.StatusRejected.String()
+	case "Queued", "queued":
+		return v1.StatusQueued.String()
+	case "ReviewPeriod", "review_period":
+		return v1.StatusReviewPeriod.String()
 	default:
 		return status
 	}
 }
+
+// NormalizeProposalsOrderBy - normalize user specified proposals order.
+func NormalizeProposalsOrderBy(orderBy string) string {
+	switch orderBy {
+	case "IDAsc", "id_asc":
+		return v1.OrderByIDAsc.String()
+	case "IDDesc", "id_desc":
+		return v1.OrderByIDDesc.String()
+	case "VotingEndTime", "voting_end_time":
+		return v1.OrderByVotingEndTime.String()
+	case "TotalDeposit", "total_deposit":
+		return v1.OrderByTotalDeposit.String()
+	default:
+		return orderBy
+	}
+}
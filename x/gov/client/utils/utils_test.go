@@ -82,3 +82,21 @@ func TestNormalizeProposalStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeProposalsOrderBy(t *testing.T) {
+	tests := []struct {
+		orderBy string
+		want    string
+	}{
+		{"unknown", "unknown"},
+		{"id_asc", "PROPOSALS_ORDER_BY_ID_ASC"},
+		{"IDDesc", "PROPOSALS_ORDER_BY_ID_DESC"},
+		{"voting_end_time", "PROPOSALS_ORDER_BY_VOTING_END_TIME"},
+		{"TotalDeposit", "PROPOSALS_ORDER_BY_TOTAL_DEPOSIT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.orderBy, func(t *testing.T) {
+			require.Equal(t, tt.want, utils.NormalizeProposalsOrderBy(tt.orderBy))
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/auth"
 	_ "github.com/cosmos/cosmos-sdk/x/bank"
 	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/distribution"
 	_ "github.com/cosmos/cosmos-sdk/x/params"
 	_ "github.com/cosmos/cosmos-sdk/x/staking"
 
@@ -25,6 +26,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -42,7 +44,7 @@ var (
 )
 
 // mkTestLegacyContent creates a MsgExecLegacyContent for testing purposes.
-func mkTestLegacyContent(t *testing.T) *v1.MsgExecLegacyContent {
+func mkTestLegacyContent(t testing.TB) *v1.MsgExecLegacyContent {
 	msgContent, err := v1.NewLegacyContent(TestProposal, authtypes.NewModuleAddress(types.ModuleName).String())
 	require.NoError(t, err)
 
@@ -104,12 +106,13 @@ var pubkeys = []cryptotypes.PubKey{
 type suite struct {
 	AccountKeeper authkeeper.AccountKeeper
 	BankKeeper    bankkeeper.Keeper
+	DistrKeeper   distrkeeper.Keeper
 	GovKeeper     *keeper.Keeper
 	StakingKeeper *stakingkeeper.Keeper
 	App           *runtime.App
 }
 
-func createTestSuite(t *testing.T) suite {
+func createTestSuite(t testing.TB) suite {
 	res := suite{}
 
 	app, err := simtestutil.SetupWithConfiguration(
@@ -118,11 +121,12 @@ func createTestSuite(t *testing.T) suite {
 			configurator.AuthModule(),
 			configurator.StakingModule(),
 			configurator.BankModule(),
+			configurator.DistributionModule(),
 			configurator.GovModule(),
 			configurator.ConsensusModule(),
 		),
 		simtestutil.DefaultStartUpConfig(),
-		&res.AccountKeeper, &res.BankKeeper, &res.GovKeeper, &res.StakingKeeper,
+		&res.AccountKeeper, &res.BankKeeper, &res.DistrKeeper, &res.GovKeeper, &res.StakingKeeper,
 	)
 	require.NoError(t, err)
 
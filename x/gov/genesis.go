@@ -16,6 +16,7 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	if err := k.SetParams(ctx, *data.Params); err != nil {
 		panic(fmt.Sprintf("%s module params has not been set", types.ModuleName))
 	}
+	k.SetConstitution(ctx, data.Constitution)
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -37,10 +38,55 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		switch proposal.Status {
 		case v1.StatusDepositPeriod:
 			k.InsertInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+		case v1.StatusReviewPeriod:
+			k.InsertReviewProposalQueue(ctx, proposal.Id, *proposal.ReviewEndTime)
 		case v1.StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+		case v1.StatusPassed, v1.StatusRejected, v1.StatusFailed:
+			if proposal.VotingEndTime != nil {
+				k.InsertCompletedProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+			}
+		case v1.StatusQueued:
+			k.InsertCommunityPoolSpendQueue(ctx, proposal.Id)
 		}
 		k.SetProposal(ctx, *proposal)
+		k.UpdateProposalStatusCount(ctx, v1.StatusNil, proposal.Status)
+	}
+
+	for _, archived := range data.ArchivedProposals {
+		k.SetArchivedProposal(ctx, *archived)
+		k.UpdateProposalStatusCount(ctx, v1.StatusNil, archived.Status)
+	}
+
+	for _, turnout := range data.Turnouts {
+		k.SetProposalTurnout(ctx, *turnout)
+	}
+
+	// the proposer bounty pool is held by the module account with the deposits
+	if data.BountyPool != nil {
+		k.SetBountyPool(ctx, *data.BountyPool)
+		totalDeposits = totalDeposits.Add(data.BountyPool.Amount...)
+	}
+
+	for _, bounty := range data.ProposerBounties {
+		k.SetProposerBounty(ctx, *bounty)
+	}
+
+	if data.CommunityPoolSpendPeriod != nil {
+		k.SetCommunityPoolSpendPeriod(ctx, *data.CommunityPoolSpendPeriod)
+	}
+
+	for _, lock := range data.VoteLocks {
+		k.SetVoteLock(ctx, *lock)
+		k.InsertVoteLockQueue(ctx, sdk.MustAccAddressFromBech32(lock.Voter), *lock.EndTime)
+	}
+
+	for _, recurring := range data.RecurringProposals {
+		k.SetRecurringProposal(ctx, *recurring)
+		k.InsertRecurringProposalQueue(ctx, recurring.Id, *recurring.NextSubmitTime)
+	}
+	if data.NextRecurringProposalId > 0 {
+		k.SetNextRecurringProposalID(ctx, data.NextRecurringProposalId)
 	}
 
 	// if account has zero balance it probably means it's not set, so we set it
@@ -59,7 +105,45 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 	startingProposalID, _ := k.GetProposalID(ctx)
 	proposals := k.GetProposals(ctx)
+	archivedProposals := k.GetArchivedProposals(ctx)
 	params := k.GetParams(ctx)
+	constitution := k.GetConstitution(ctx)
+	proposerBounties := k.GetProposerBounties(ctx)
+
+	var turnouts []*v1.ProposalTurnout
+	k.IterateProposalTurnouts(ctx, func(turnout v1.ProposalTurnout) bool {
+		turnouts = append(turnouts, &turnout)
+		return false
+	})
+
+	var bountyPool *v1.BountyPool
+	if pool := k.GetBountyPool(ctx); len(pool.Amount) > 0 {
+		bountyPool = &pool
+	}
+
+	var communityPoolSpendPeriod *v1.CommunityPoolSpendPeriod
+	if period, found := k.GetCommunityPoolSpendPeriod(ctx); found {
+		communityPoolSpendPeriod = &period
+	}
+
+	var voteLocks []*v1.VoteLock
+	k.IterateVoteLocks(ctx, func(lock v1.VoteLock) bool {
+		voteLocks = append(voteLocks, &lock)
+		return false
+	})
+
+	var recurringProposals []*v1.RecurringProposal
+	k.IterateRecurringProposals(ctx, func(recurring v1.RecurringProposal) bool {
+		recurringProposals = append(recurringProposals, &recurring)
+		return false
+	})
+
+	// the next recurring proposal id is left unset until a recurring proposal
+	// is scheduled
+	var nextRecurringProposalID uint64
+	if id := k.GetNextRecurringProposalID(ctx); id > 1 {
+		nextRecurringProposalID = id
+	}
 
 	var proposalsDeposits v1.Deposits
 	var proposalsVotes v1.Votes
@@ -72,10 +156,19 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 	}
 
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
+		StartingProposalId:       startingProposalID,
+		Deposits:                 proposalsDeposits,
+		Votes:                    proposalsVotes,
+		Proposals:                proposals,
+		Params:                   &params,
+		Constitution:             constitution,
+		Turnouts:                 turnouts,
+		ArchivedProposals:        archivedProposals,
+		BountyPool:               bountyPool,
+		ProposerBounties:         proposerBounties,
+		CommunityPoolSpendPeriod: communityPoolSpendPeriod,
+		VoteLocks:                voteLocks,
+		RecurringProposals:       recurringProposals,
+		NextRecurringProposalId:  nextRecurringProposalID,
 	}
 }
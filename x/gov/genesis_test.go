@@ -37,3 +37,17 @@ func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 	genState := gov.ExportGenesis(ctx, suite.GovKeeper)
 	require.Equal(t, genState, v1.DefaultGenesisState())
 }
+
+func TestImportExportConstitution(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	genState := v1.DefaultGenesisState()
+	genState.Constitution = "Constitution"
+	gov.InitGenesis(ctx, suite.AccountKeeper, suite.BankKeeper, suite.GovKeeper, genState)
+	require.Equal(t, "Constitution", suite.GovKeeper.GetConstitution(ctx))
+
+	exported := gov.ExportGenesis(ctx, suite.GovKeeper)
+	require.Equal(t, genState, exported)
+}
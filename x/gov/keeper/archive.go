@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// ArchiveProposal prunes a completed proposal from the store, only keeping
+// its archived summary. The proposal status counts are left untouched, so
+// archived proposals keep being accounted for in the governance statistics.
+func (keeper Keeper) ArchiveProposal(ctx sdk.Context, proposal v1.Proposal) {
+	keeper.SetArchivedProposal(ctx, v1.ArchivedProposal{
+		Id:               proposal.Id,
+		Status:           proposal.Status,
+		FinalTallyResult: proposal.FinalTallyResult,
+		SubmitTime:       proposal.SubmitTime,
+		VotingEndTime:    proposal.VotingEndTime,
+		Title:            proposal.Title,
+		Proposer:         proposal.Proposer,
+	})
+
+	if proposal.VotingEndTime != nil {
+		keeper.RemoveFromCompletedProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	}
+	keeper.deleteProposal(ctx, proposal)
+}
+
+// GetArchivedProposal gets an archived proposal from store by ProposalID.
+func (keeper Keeper) GetArchivedProposal(ctx sdk.Context, proposalID uint64) (v1.ArchivedProposal, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.ArchivedProposalKey(proposalID))
+	if bz == nil {
+		return v1.ArchivedProposal{}, false
+	}
+
+	var archived v1.ArchivedProposal
+	keeper.cdc.MustUnmarshal(bz, &archived)
+	return archived, true
+}
+
+// SetArchivedProposal sets an archived proposal to store.
+func (keeper Keeper) SetArchivedProposal(ctx sdk.Context, archived v1.ArchivedProposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&archived)
+	store.Set(types.ArchivedProposalKey(archived.Id), bz)
+}
+
+// IterateArchivedProposals iterates over all the archived proposals and
+// performs a callback function.
+func (keeper Keeper) IterateArchivedProposals(ctx sdk.Context, cb func(archived v1.ArchivedProposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ArchivedProposalsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var archived v1.ArchivedProposal
+		keeper.cdc.MustUnmarshal(iterator.Value(), &archived)
+
+		if cb(archived) {
+			break
+		}
+	}
+}
+
+// GetArchivedProposals returns all the archived proposals from store
+func (keeper Keeper) GetArchivedProposals(ctx sdk.Context) (archived []*v1.ArchivedProposal) {
+	keeper.IterateArchivedProposals(ctx, func(p v1.ArchivedProposal) bool {
+		archived = append(archived, &p)
+		return false
+	})
+	return
+}
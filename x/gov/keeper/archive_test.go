@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestArchiveProposal() {
+	suite.reset()
+	proposer := sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r")
+
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", proposer, nil, false)
+	suite.Require().NoError(err)
+	suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+	proposal, _ = suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+
+	tallyResult := v1.NewTallyResult(sdk.NewInt(10), sdk.ZeroInt(), sdk.NewInt(3), sdk.ZeroInt())
+	proposal.FinalTallyResult = &tallyResult
+	suite.govKeeper.UpdateProposalStatusCount(suite.ctx, proposal.Status, v1.StatusPassed)
+	proposal.Status = v1.StatusPassed
+	suite.govKeeper.SetProposal(suite.ctx, proposal)
+	suite.govKeeper.RemoveFromActiveProposalQueue(suite.ctx, proposal.Id, *proposal.VotingEndTime)
+	suite.govKeeper.InsertCompletedProposalQueue(suite.ctx, proposal.Id, *proposal.VotingEndTime)
+
+	suite.govKeeper.ArchiveProposal(suite.ctx, proposal)
+
+	_, found := suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().False(found)
+	archived, found := suite.govKeeper.GetArchivedProposal(suite.ctx, proposal.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(v1.ArchivedProposal{
+		Id:               proposal.Id,
+		Status:           v1.StatusPassed,
+		FinalTallyResult: &tallyResult,
+		SubmitTime:       proposal.SubmitTime,
+		VotingEndTime:    proposal.VotingEndTime,
+		Title:            "test",
+		Proposer:         proposer.String(),
+	}, archived)
+	suite.Require().Equal(uint64(1), suite.govKeeper.GetProposalStatusCount(suite.ctx, v1.StatusPassed))
+
+	completedQueue := suite.govKeeper.CompletedProposalQueueIterator(suite.ctx, *proposal.VotingEndTime)
+	suite.Require().False(completedQueue.Valid())
+	completedQueue.Close()
+
+	res, err := suite.queryClient.ArchivedProposal(gocontext.Background(), &v1.QueryArchivedProposalRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&archived, res.ArchivedProposal)
+
+	// the final tally of an archived proposal stays queryable
+	tallyRes, err := suite.queryClient.TallyResult(gocontext.Background(), &v1.QueryTallyResultRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&tallyResult, tallyRes.Tally)
+
+	_, err = suite.queryClient.ArchivedProposal(gocontext.Background(), &v1.QueryArchivedProposalRequest{ProposalId: proposal.Id + 1})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCompletedProposalQueueDanglingEntry() {
+	suite.reset()
+	proposer := sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r")
+
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", proposer, nil, false)
+	suite.Require().NoError(err)
+	endTime := suite.ctx.BlockTime()
+	suite.govKeeper.InsertCompletedProposalQueue(suite.ctx, proposal.Id, endTime)
+	// an entry of a proposal that doesn't exist
+	suite.govKeeper.InsertCompletedProposalQueue(suite.ctx, proposal.Id+1, endTime)
+
+	var ids []uint64
+	suite.Require().NotPanics(func() {
+		suite.govKeeper.IterateCompletedProposalsQueue(suite.ctx, endTime, func(proposal v1.Proposal) bool {
+			ids = append(ids, proposal.Id)
+			return false
+		})
+	})
+	suite.Require().Equal([]uint64{proposal.Id}, ids)
+
+	// the dangling entry is dropped from the queue
+	completedQueue := suite.govKeeper.CompletedProposalQueueIterator(suite.ctx, endTime)
+	defer completedQueue.Close()
+	suite.Require().True(completedQueue.Valid())
+	completedQueue.Next()
+	suite.Require().False(completedQueue.Valid())
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetBountyPool gets the proposer bounty pool from store. The pool is held by
+// the gov module account, alongside the deposits.
+func (keeper Keeper) GetBountyPool(ctx sdk.Context) v1.BountyPool {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.BountyPoolKey)
+	if bz == nil {
+		return v1.BountyPool{}
+	}
+
+	var pool v1.BountyPool
+	keeper.cdc.MustUnmarshal(bz, &pool)
+	return pool
+}
+
+// SetBountyPool sets the proposer bounty pool to store.
+func (keeper Keeper) SetBountyPool(ctx sdk.Context, pool v1.BountyPool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&pool)
+	store.Set(types.BountyPoolKey, bz)
+}
+
+// fundBountyPool moves the proposer bounty ratio of an amount about to be
+// burned to the bounty pool, and returns the remaining amount to burn.
+func (keeper Keeper) fundBountyPool(ctx sdk.Context, amount sdk.Coins) sdk.Coins {
+	params := keeper.GetParams(ctx)
+	if params.ProposerBountyRatio == "" {
+		return amount
+	}
+
+	ratio := math.LegacyMustNewDecFromStr(params.ProposerBountyRatio)
+	funds, _ := sdk.NewDecCoinsFromCoins(amount...).MulDecTruncate(ratio).TruncateDecimal()
+	if funds.IsZero() {
+		return amount
+	}
+
+	pool := keeper.GetBountyPool(ctx)
+	pool.Amount = sdk.NewCoins(pool.Amount...).Add(funds...)
+	keeper.SetBountyPool(ctx, pool)
+
+	return amount.Sub(funds...)
+}
+
+// PayProposerBounty pays the proposer bounty from the bounty pool to the
+// proposer of a passed proposal. The bounty is capped by the pool balance, and
+// nothing is paid when the pool is empty.
+func (keeper Keeper) PayProposerBounty(ctx sdk.Context, proposal v1.Proposal) {
+	params := keeper.GetParams(ctx)
+	pool := keeper.GetBountyPool(ctx)
+
+	bounty := sdk.NewCoins(params.ProposerBounty...).Min(sdk.NewCoins(pool.Amount...))
+	if bounty.IsZero() {
+		return
+	}
+
+	// the recurring proposals are submitted by the module account, which
+	// doesn't earn a bounty
+	proposer := sdk.MustAccAddressFromBech32(proposal.Proposer)
+	if proposer.Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
+		return
+	}
+	err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposer, bounty)
+	if err != nil {
+		panic(err)
+	}
+
+	pool.Amount = sdk.NewCoins(pool.Amount...).Sub(bounty...)
+	keeper.SetBountyPool(ctx, pool)
+	keeper.SetProposerBounty(ctx, v1.ProposerBounty{
+		ProposalId: proposal.Id,
+		Proposer:   proposal.Proposer,
+		Amount:     bounty,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposerBounty,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposal.Proposer),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, bounty.String()),
+		),
+	)
+}
+
+// GetProposerBounty gets the bounty paid to the proposer of a proposal from
+// store by ProposalID.
+func (keeper Keeper) GetProposerBounty(ctx sdk.Context, proposalID uint64) (v1.ProposerBounty, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.ProposerBountyKey(proposalID))
+	if bz == nil {
+		return v1.ProposerBounty{}, false
+	}
+
+	var bounty v1.ProposerBounty
+	keeper.cdc.MustUnmarshal(bz, &bounty)
+	return bounty, true
+}
+
+// SetProposerBounty sets the bounty paid to the proposer of a proposal to store.
+func (keeper Keeper) SetProposerBounty(ctx sdk.Context, bounty v1.ProposerBounty) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&bounty)
+	store.Set(types.ProposerBountyKey(bounty.ProposalId), bz)
+}
+
+// IterateProposerBounties iterates over all the proposer bounties and performs
+// a callback function.
+func (keeper Keeper) IterateProposerBounties(ctx sdk.Context, cb func(bounty v1.ProposerBounty) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposerBountiesKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var bounty v1.ProposerBounty
+		keeper.cdc.MustUnmarshal(iterator.Value(), &bounty)
+
+		if cb(bounty) {
+			break
+		}
+	}
+}
+
+// GetProposerBounties returns all the proposer bounties from store
+func (keeper Keeper) GetProposerBounties(ctx sdk.Context) (bounties []*v1.ProposerBounty) {
+	keeper.IterateProposerBounties(ctx, func(bounty v1.ProposerBounty) bool {
+		bounties = append(bounties, &bounty)
+		return false
+	})
+	return
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestProposerBounty() {
+	ctx, _ := suite.ctx.CacheContext()
+	proposer := suite.addrs[0]
+
+	params := suite.govKeeper.GetParams(ctx)
+	params.ProposerBountyRatio = sdk.NewDecWithPrec(5, 1).String()
+	params.ProposerBounty = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(300)))
+	suite.Require().NoError(suite.govKeeper.SetParams(ctx, params))
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", proposer, nil, false)
+	suite.Require().NoError(err)
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(201)))
+	_, err = suite.govKeeper.AddDeposit(ctx, proposal.Id, proposer, deposit)
+	suite.Require().NoError(err)
+
+	// half of the burned deposits funds the bounty pool, rounded down
+	suite.govKeeper.DeleteAndBurnDeposits(ctx, proposal.Id)
+	suite.Require().Empty(suite.govKeeper.GetDeposits(ctx, proposal.Id))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), sdk.Coins(suite.govKeeper.GetBountyPool(ctx).Amount))
+
+	// the bounty is capped by the pool balance
+	balance := suite.bankKeeper.GetAllBalances(ctx, proposer)
+	suite.govKeeper.PayProposerBounty(ctx, proposal)
+	suite.Require().Empty(suite.govKeeper.GetBountyPool(ctx).Amount)
+	suite.Require().Equal(balance.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), suite.bankKeeper.GetAllBalances(ctx, proposer))
+
+	bounty, found := suite.govKeeper.GetProposerBounty(ctx, proposal.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(v1.ProposerBounty{
+		ProposalId: proposal.Id,
+		Proposer:   proposer.String(),
+		Amount:     sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+	}, bounty)
+
+	res, err := suite.govKeeper.ProposerBounty(sdk.WrapSDKContext(ctx), &v1.QueryProposerBountyRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&bounty, res.ProposerBounty)
+
+	// nothing is paid from an empty pool
+	proposal.Id++
+	suite.govKeeper.PayProposerBounty(ctx, proposal)
+	_, found = suite.govKeeper.GetProposerBounty(ctx, proposal.Id)
+	suite.Require().False(found)
+
+	poolRes, err := suite.govKeeper.BountyPool(sdk.WrapSDKContext(ctx), &v1.QueryBountyPoolRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(poolRes.BountyPool.Amount)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
+	treasurytypes "github.com/atomone-hub/atomone/x/treasury/types"
 )
 
 var (
@@ -47,9 +48,10 @@ func getTestProposal() []sdk.Msg {
 }
 
 type mocks struct {
-	acctKeeper    *govtestutil.MockAccountKeeper
-	bankKeeper    *govtestutil.MockBankKeeper
-	stakingKeeper *govtestutil.MockStakingKeeper
+	acctKeeper     *govtestutil.MockAccountKeeper
+	bankKeeper     *govtestutil.MockBankKeeper
+	stakingKeeper  *govtestutil.MockStakingKeeper
+	treasuryKeeper *govtestutil.MockTreasuryKeeper
 }
 
 func mockAccountKeeperExpectations(ctx sdk.Context, m mocks) {
@@ -64,7 +66,6 @@ func mockDefaultExpectations(ctx sdk.Context, m mocks) {
 		return sdk.TokensFromConsensusPower(power, math.NewIntFromUint64(1000000))
 	}).AnyTimes()
 
-	m.stakingKeeper.EXPECT().BondDenom(ctx).Return("stake").AnyTimes()
 	m.stakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).AnyTimes()
 	m.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	m.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000000)).AnyTimes()
@@ -92,9 +93,10 @@ func setupGovKeeper(t *testing.T, expectations ...func(sdk.Context, mocks)) (
 	// gomock initializations
 	ctrl := gomock.NewController(t)
 	m := mocks{
-		acctKeeper:    govtestutil.NewMockAccountKeeper(ctrl),
-		bankKeeper:    govtestutil.NewMockBankKeeper(ctrl),
-		stakingKeeper: govtestutil.NewMockStakingKeeper(ctrl),
+		acctKeeper:     govtestutil.NewMockAccountKeeper(ctrl),
+		bankKeeper:     govtestutil.NewMockBankKeeper(ctrl),
+		stakingKeeper:  govtestutil.NewMockStakingKeeper(ctrl),
+		treasuryKeeper: govtestutil.NewMockTreasuryKeeper(ctrl),
 	}
 	if len(expectations) == 0 {
 		mockDefaultExpectations(ctx, m)
@@ -103,9 +105,19 @@ func setupGovKeeper(t *testing.T, expectations ...func(sdk.Context, mocks)) (
 			exp(ctx, m)
 		}
 	}
+	// the proposals are indexed by the bond denom amount of their total deposit
+	m.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("stake").AnyTimes()
+	// the treasury streams are created from the community pool
+	m.treasuryKeeper.EXPECT().CommunityPoolSpend(gomock.Any()).DoAndReturn(func(msg sdk.Msg) sdk.Coins {
+		if msg, ok := msg.(*treasurytypes.MsgCreateStream); ok {
+			return msg.Amount
+		}
+		return nil
+	}).AnyTimes()
 
 	// Gov keeper initializations
 	govKeeper := keeper.NewKeeper(encCfg.Codec, key, m.acctKeeper, m.bankKeeper, m.stakingKeeper, msr, types.DefaultConfig(), govAcct.String())
+	govKeeper.SetTreasuryKeeper(m.treasuryKeeper)
 	govKeeper.SetProposalID(ctx, 1)
 
 	govRouter := v1beta1.NewRouter() // Also register legacy gov handlers to test them too.
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetCommunityPoolSpendPeriod gets the community pool spend period from store.
+// The returned period may have ended, see CurrentCommunityPoolSpendPeriod.
+func (keeper Keeper) GetCommunityPoolSpendPeriod(ctx sdk.Context) (v1.CommunityPoolSpendPeriod, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.CommunityPoolSpendPeriodKey)
+	if bz == nil {
+		return v1.CommunityPoolSpendPeriod{}, false
+	}
+
+	var period v1.CommunityPoolSpendPeriod
+	keeper.cdc.MustUnmarshal(bz, &period)
+	return period, true
+}
+
+// SetCommunityPoolSpendPeriod sets the community pool spend period to store.
+func (keeper Keeper) SetCommunityPoolSpendPeriod(ctx sdk.Context, period v1.CommunityPoolSpendPeriod) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&period)
+	store.Set(types.CommunityPoolSpendPeriodKey, bz)
+}
+
+// CurrentCommunityPoolSpendPeriod returns the community pool spend period
+// including the block time. When the stored period has ended, a new empty
+// period starts at the end of the last elapsed period, so the periods stay
+// aligned on the first one.
+func (keeper Keeper) CurrentCommunityPoolSpendPeriod(ctx sdk.Context) v1.CommunityPoolSpendPeriod {
+	now := ctx.BlockTime()
+	period, found := keeper.GetCommunityPoolSpendPeriod(ctx)
+	if !found || period.StartTime == nil {
+		return v1.CommunityPoolSpendPeriod{StartTime: &now}
+	}
+
+	duration := keeper.communityPoolSpendPeriodDuration(ctx)
+	if duration <= 0 || now.Before(period.StartTime.Add(duration)) {
+		return period
+	}
+
+	elapsed := now.Sub(*period.StartTime) / duration
+	startTime := period.StartTime.Add(elapsed * duration)
+	return v1.CommunityPoolSpendPeriod{StartTime: &startTime}
+}
+
+// CommunityPoolSpendPeriodEndTime returns the end time of a community pool
+// spend period, nil if the period duration is not set.
+func (keeper Keeper) CommunityPoolSpendPeriodEndTime(ctx sdk.Context, period v1.CommunityPoolSpendPeriod) *time.Time {
+	duration := keeper.communityPoolSpendPeriodDuration(ctx)
+	if duration <= 0 || period.StartTime == nil {
+		return nil
+	}
+	endTime := period.StartTime.Add(duration)
+	return &endTime
+}
+
+func (keeper Keeper) communityPoolSpendPeriodDuration(ctx sdk.Context) time.Duration {
+	params := keeper.GetParams(ctx)
+	if params.CommunityPoolSpendPeriod == nil {
+		return 0
+	}
+	return *params.CommunityPoolSpendPeriod
+}
+
+// RecordCommunityPoolSpend adds an amount spent from the community pool to the
+// current community pool spend period.
+func (keeper Keeper) RecordCommunityPoolSpend(ctx sdk.Context, amount sdk.Coins) {
+	period := keeper.CurrentCommunityPoolSpendPeriod(ctx)
+	period.Spent = sdk.NewCoins(period.Spent...).Add(amount...)
+	keeper.SetCommunityPoolSpendPeriod(ctx, period)
+}
+
+// RemainingCommunityPoolSpend returns the amount of the limited denoms that
+// can still be spent from the community pool during the current period.
+func (keeper Keeper) RemainingCommunityPoolSpend(ctx sdk.Context) sdk.Coins {
+	spent := sdk.NewCoins(keeper.CurrentCommunityPoolSpendPeriod(ctx).Spent...)
+	remaining := sdk.NewCoins()
+	for _, limit := range keeper.GetParams(ctx).CommunityPoolSpendLimit {
+		if amount := limit.Amount.Sub(spent.AmountOf(limit.Denom)); amount.IsPositive() {
+			remaining = remaining.Add(sdk.NewCoin(limit.Denom, amount))
+		}
+	}
+	return remaining
+}
+
+// CheckCommunityPoolSpendLimit returns true if the amount can be spent from
+// the community pool during the current period. It returns an error if the
+// amount exceeds the limit of a whole period, as it can never be spent.
+func (keeper Keeper) CheckCommunityPoolSpendLimit(ctx sdk.Context, amount sdk.Coins) (bool, error) {
+	limit := sdk.NewCoins(keeper.GetParams(ctx).CommunityPoolSpendLimit...)
+	if limit.Empty() || amount.IsZero() {
+		return true, nil
+	}
+
+	spent := sdk.NewCoins(keeper.CurrentCommunityPoolSpendPeriod(ctx).Spent...)
+	allowed := true
+	for _, c := range limit {
+		if amount.AmountOf(c.Denom).GT(c.Amount) {
+			return false, fmt.Errorf("community pool spend %s exceeds the limit per period %s", amount, limit)
+		}
+		if spent.AmountOf(c.Denom).Add(amount.AmountOf(c.Denom)).GT(c.Amount) {
+			allowed = false
+		}
+	}
+	return allowed, nil
+}
+
+// ProposalCommunityPoolSpend returns the amount spent from the community pool
+// by the messages of a proposal.
+func (keeper Keeper) ProposalCommunityPoolSpend(proposal v1.Proposal) sdk.Coins {
+	amount := sdk.NewCoins()
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return amount
+	}
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *distrtypes.MsgCommunityPoolSpend:
+			amount = amount.Add(msg.Amount...)
+		default:
+			if keeper.treasuryKeeper != nil {
+				amount = amount.Add(keeper.treasuryKeeper.CommunityPoolSpend(msg)...)
+			}
+		}
+	}
+	return amount
+}
+
+// InsertCommunityPoolSpendQueue inserts a proposal into the community pool
+// spend queue, where it waits for the spend limit to allow its execution.
+func (keeper Keeper) InsertCommunityPoolSpendQueue(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.CommunityPoolSpendQueueKey(proposalID), []byte{1})
+}
+
+// RemoveFromCommunityPoolSpendQueue removes a proposal from the community pool
+// spend queue.
+func (keeper Keeper) RemoveFromCommunityPoolSpendQueue(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.CommunityPoolSpendQueueKey(proposalID))
+}
+
+// HasCommunityPoolSpendQueue returns true if proposals are queued for the
+// community pool spend limit.
+func (keeper Keeper) HasCommunityPoolSpendQueue(ctx sdk.Context) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CommunityPoolSpendQueuePrefix)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// GetCommunityPoolSpendQueue returns the ids of the queued proposals, in
+// execution order.
+func (keeper Keeper) GetCommunityPoolSpendQueue(ctx sdk.Context) (proposalIDs []uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CommunityPoolSpendQueuePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		proposalIDs = append(proposalIDs, types.GetProposalIDFromBytes(iterator.Key()[1:]))
+	}
+	return proposalIDs
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	treasurytypes "github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+func (suite *KeeperTestSuite) TestCommunityPoolSpendLimit() {
+	ctx, _ := suite.ctx.CacheContext()
+	startTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(startTime)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)))
+	}
+
+	// no limit by default
+	allowed, err := suite.govKeeper.CheckCommunityPoolSpendLimit(ctx, coins(1000000))
+	suite.Require().NoError(err)
+	suite.Require().True(allowed)
+
+	params := suite.govKeeper.GetParams(ctx)
+	params.CommunityPoolSpendLimit = coins(100)
+	period := 24 * time.Hour
+	params.CommunityPoolSpendPeriod = &period
+	suite.Require().NoError(suite.govKeeper.SetParams(ctx, params))
+
+	_, err = suite.govKeeper.CheckCommunityPoolSpendLimit(ctx, coins(101))
+	suite.Require().Error(err)
+
+	suite.govKeeper.RecordCommunityPoolSpend(ctx, coins(60))
+	suite.Require().Equal(coins(40), suite.govKeeper.RemainingCommunityPoolSpend(ctx))
+	allowed, err = suite.govKeeper.CheckCommunityPoolSpendLimit(ctx, coins(40))
+	suite.Require().NoError(err)
+	suite.Require().True(allowed)
+	allowed, err = suite.govKeeper.CheckCommunityPoolSpendLimit(ctx, coins(41))
+	suite.Require().NoError(err)
+	suite.Require().False(allowed)
+
+	// denoms without a limit are not restricted
+	other := sdk.NewCoins(sdk.NewCoin("other", sdk.NewInt(1000)))
+	allowed, err = suite.govKeeper.CheckCommunityPoolSpendLimit(ctx, other)
+	suite.Require().NoError(err)
+	suite.Require().True(allowed)
+
+	// a new period aligned on the first one starts once the period has ended
+	ctx = ctx.WithBlockTime(startTime.Add(2*period + time.Hour))
+	current := suite.govKeeper.CurrentCommunityPoolSpendPeriod(ctx)
+	suite.Require().Equal(startTime.Add(2*period), current.StartTime.UTC())
+	suite.Require().Empty(current.Spent)
+	suite.Require().Equal(startTime.Add(3*period), suite.govKeeper.CommunityPoolSpendPeriodEndTime(ctx, current).UTC())
+	suite.Require().Equal(coins(100), suite.govKeeper.RemainingCommunityPoolSpend(ctx))
+
+	res, err := suite.govKeeper.CommunityPoolSpend(sdk.WrapSDKContext(ctx), &v1.QueryCommunityPoolSpendRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins(100), sdk.NewCoins(res.Remaining...))
+	suite.Require().Empty(res.QueuedProposalIds)
+}
+
+func (suite *KeeperTestSuite) TestCommunityPoolSpendQueue() {
+	ctx, _ := suite.ctx.CacheContext()
+	govAcct := suite.govKeeper.GetGovernanceAccount(ctx).GetAddress().String()
+
+	spend := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+	msgs := []sdk.Msg{
+		&distrtypes.MsgCommunityPoolSpend{Authority: govAcct, Recipient: suite.addrs[0].String(), Amount: spend},
+		&distrtypes.MsgCommunityPoolSpend{Authority: govAcct, Recipient: suite.addrs[1].String(), Amount: spend},
+		&treasurytypes.MsgCreateStream{Authority: govAcct, Recipient: suite.addrs[1].String(), Amount: spend},
+	}
+	proposal, err := v1.NewProposal(msgs, 1, ctx.BlockTime(), ctx.BlockTime(), "", "title", "summary", suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(spend.Add(spend...).Add(spend...), suite.govKeeper.ProposalCommunityPoolSpend(proposal))
+
+	suite.Require().False(suite.govKeeper.HasCommunityPoolSpendQueue(ctx))
+	suite.govKeeper.InsertCommunityPoolSpendQueue(ctx, 3)
+	suite.Require().True(suite.govKeeper.HasCommunityPoolSpendQueue(ctx))
+	suite.govKeeper.InsertCommunityPoolSpendQueue(ctx, 1)
+	suite.govKeeper.InsertCommunityPoolSpendQueue(ctx, 2)
+	suite.Require().Equal([]uint64{1, 2, 3}, suite.govKeeper.GetCommunityPoolSpendQueue(ctx))
+
+	suite.govKeeper.RemoveFromCommunityPoolSpendQueue(ctx, 2)
+	suite.Require().Equal([]uint64{1, 3}, suite.govKeeper.GetCommunityPoolSpendQueue(ctx))
+}
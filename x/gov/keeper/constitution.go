@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+// GetConstitution gets the chain's constitution.
+func (keeper Keeper) GetConstitution(ctx sdk.Context) string {
+	store := ctx.KVStore(keeper.storeKey)
+	return string(store.Get(types.ConstitutionKey))
+}
+
+// SetConstitution sets the chain's constitution.
+func (keeper Keeper) SetConstitution(ctx sdk.Context, constitution string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ConstitutionKey, []byte(constitution))
+}
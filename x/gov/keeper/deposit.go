@@ -54,20 +54,29 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits v
 }
 
 // DeleteAndBurnDeposits deletes and burns all the deposits on a specific proposal.
+// The proposer bounty ratio of the deposits funds the proposer bounty pool
+// instead of being burned.
 func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
 
+	var deposits sdk.Coins
 	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
-		err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount)
-		if err != nil {
-			panic(err)
-		}
+		deposits = deposits.Add(deposit.Amount...)
 
 		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
 
 		store.Delete(types.DepositKey(proposalID, depositor))
 		return false
 	})
+
+	burn := keeper.fundBountyPool(ctx, deposits)
+	if burn.IsZero() {
+		return
+	}
+	err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burn)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // IterateAllDeposits iterates over all the stored deposits and performs a callback function.
@@ -107,8 +116,22 @@ func (keeper Keeper) IterateDeposits(ctx sdk.Context, proposalID uint64, cb func
 }
 
 // AddDeposit adds or updates a deposit of a specific depositor on a specific proposal.
-// Activates voting period when appropriate and returns true in that case, else returns false.
+// Activates voting period, or the review period preceding it, when appropriate and
+// returns true if the voting period was activated, else returns false.
 func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins) (bool, error) {
+	return keeper.AddDepositWithRefundAddress(ctx, proposalID, depositorAddr, depositAmount, nil)
+}
+
+// AddDepositWithRefundAddress adds or updates a deposit like AddDeposit. The
+// deposit is refunded to refundAddr instead of the depositor if it is not
+// empty, which replaces the refund address of the former deposits of the
+// depositor on the proposal.
+func (keeper Keeper) AddDepositWithRefundAddress(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress, depositAmount sdk.Coins, refundAddr sdk.AccAddress) (bool, error) {
+	// the refunds are sent at the end of the block, they must not fail
+	if !refundAddr.Empty() && keeper.bankKeeper.BlockedAddr(refundAddr) {
+		return false, sdkerrors.Wrapf(types.ErrInvalidRefundAddress, "%s is not allowed to receive funds", refundAddr)
+	}
+
 	// Checks to see if proposal exists
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
@@ -120,6 +143,21 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 		return false, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
+	// the deposit or voting period may have ended without the proposal being
+	// processed yet, when the EndBlocker left it over for the next blocks
+	endTime := proposal.DepositEndTime
+	if proposal.Status == v1.StatusVotingPeriod {
+		endTime = proposal.VotingEndTime
+	}
+	if endTime != nil && ctx.BlockTime().After(*endTime) {
+		return false, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d: period ended", proposalID)
+	}
+
+	params := keeper.GetParams(ctx)
+	if err := params.ValidateDepositDenoms(depositAmount); err != nil {
+		return false, err
+	}
+
 	// update the governance module's account coins pool
 	err := keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, depositAmount)
 	if err != nil {
@@ -133,10 +171,23 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetParams(ctx).MinDeposit) {
-		keeper.ActivateVotingPeriod(ctx, proposal)
-
-		activatedVotingPeriod = true
+	if proposal.Status == v1.StatusDepositPeriod && params.IsMinDepositReached(proposal.TotalDeposit) {
+		// the proposals with a review period only enter the voting period
+		// once it ends
+		if reviewPeriod := keeper.ProposalReviewPeriod(ctx, proposal); reviewPeriod > 0 {
+			keeper.ActivateReviewPeriod(ctx, proposal, reviewPeriod)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeProposalDeposit,
+					sdk.NewAttribute(types.AttributeKeyReviewPeriodStart, fmt.Sprintf("%d", proposalID)),
+				),
+			)
+		} else {
+			keeper.ActivateVotingPeriod(ctx, proposal)
+
+			activatedVotingPeriod = true
+		}
 	}
 
 	// Add or update deposit object
@@ -147,6 +198,9 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	} else {
 		deposit = v1.NewDeposit(proposalID, depositorAddr, depositAmount)
 	}
+	if !refundAddr.Empty() {
+		deposit.RefundAddress = refundAddr.String()
+	}
 
 	// called when deposit has been added to a proposal, however the proposal may not be active
 	keeper.Hooks().AfterProposalDeposit(ctx, proposalID, depositorAddr)
@@ -165,13 +219,18 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 }
 
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
+// The deposits are refunded to their refund address, if set, else to their depositor.
 func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
 
 	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
 		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+		recipient := depositor
+		if deposit.RefundAddress != "" {
+			recipient = sdk.MustAccAddressFromBech32(deposit.RefundAddress)
+		}
 
-		err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount)
+		err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, deposit.Amount)
 		if err != nil {
 			panic(err)
 		}
@@ -183,7 +242,8 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
+// the deposit parameters, and weighted by the deposit denom weights if set. Returns nil
+// on success, error otherwise.
 func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
 	params := keeper.GetParams(ctx)
 	minInitialDepositRatio, err := sdk.NewDecFromStr(params.MinInitialDepositRatio)
@@ -193,6 +253,16 @@ func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.
 	if minInitialDepositRatio.IsZero() {
 		return nil
 	}
+	// with deposit denom weights, the value of the initial deposit must reach
+	// the ratio of the value of the min deposit
+	if len(params.DepositDenomWeights) > 0 {
+		value := params.DepositValue(initialDeposit)
+		minValue := params.DepositValue(params.MinDeposit).Mul(minInitialDepositRatio)
+		if value.LT(minValue) {
+			return sdkerrors.Wrapf(types.ErrMinDepositTooSmall, "was (%s) worth %s, need %s", initialDeposit, value, minValue)
+		}
+		return nil
+	}
 	minDepositCoins := params.MinDeposit
 	for i := range minDepositCoins {
 		minDepositCoins[i].Amount = sdk.NewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
@@ -3,11 +3,14 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
+	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
@@ -23,7 +26,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0])
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0], nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -107,7 +110,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0])
+	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0], nil, false)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -118,11 +121,97 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial.Sub(fourStake...), bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
 
+func TestDepositRefundAddress(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	bankKeeper, stakingKeeper := mocks.bankKeeper, mocks.stakingKeeper
+	trackMockBalances(bankKeeper)
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(10000000))
+	blockedAddr := sdk.AccAddress("blocked_____________")
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any()).DoAndReturn(func(addr sdk.AccAddress) bool {
+		return addr.Equals(blockedAddr)
+	}).AnyTimes()
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", TestAddrs[0], nil, false)
+	require.NoError(t, err)
+	stake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 1)))
+	addr1Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[1])
+	addr2Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[2])
+
+	// the refund address must be allowed to receive funds
+	_, err = govKeeper.AddDepositWithRefundAddress(ctx, proposal.Id, TestAddrs[0], stake, blockedAddr)
+	require.ErrorIs(t, err, types.ErrInvalidRefundAddress)
+
+	// TestAddrs[0] deposits on behalf of TestAddrs[1], the refund address is
+	// kept by the deposits without one
+	_, err = govKeeper.AddDepositWithRefundAddress(ctx, proposal.Id, TestAddrs[0], stake, TestAddrs[1])
+	require.NoError(t, err)
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], stake)
+	require.NoError(t, err)
+	deposit, found := govKeeper.GetDeposit(ctx, proposal.Id, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, TestAddrs[1].String(), deposit.RefundAddress)
+
+	// TestAddrs[2] deposits without refund address
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[2], stake)
+	require.NoError(t, err)
+
+	govKeeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+	require.Empty(t, govKeeper.GetDeposits(ctx, proposal.Id))
+	require.Equal(t, addr1Initial.Add(stake...).Add(stake...), bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+	require.Equal(t, addr2Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[2]))
+}
+
+func TestDepositDenomWeights(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	bankKeeper, stakingKeeper := mocks.bankKeeper, mocks.stakingKeeper
+	trackMockBalances(bankKeeper)
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(10000000))
+	photons := sdk.NewCoins(sdk.NewInt64Coin("uphoton", 10000000))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, TestAddrs[1], photons))
+
+	params := v1.DefaultParams()
+	params.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	params.DepositDenomWeights = []*v1.DepositDenomWeight{
+		{Denom: sdk.DefaultBondDenom, Weight: "1"},
+		{Denom: "uphoton", Weight: "0.5"},
+	}
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", TestAddrs[0], nil, false)
+	require.NoError(t, err)
+	addr0Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[0])
+	addr1Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[1])
+
+	// the denoms without weight are rejected
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000)))
+	require.ErrorIs(t, err, types.ErrInvalidDepositDenom)
+
+	// 600stake and 798uphoton are worth 999stake
+	stake := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600))
+	votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], stake)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+	votingStarted, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("uphoton", 798)))
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	// 2uphoton more reach the min deposit
+	votingStarted, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("uphoton", 2)))
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	// the deposits are refunded in their denoms
+	govKeeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+	require.Equal(t, addr0Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+	require.Equal(t, addr1Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+}
+
 func TestValidateInitialDeposit(t *testing.T) {
 	testcases := map[string]struct {
 		minDeposit               sdk.Coins
 		minInitialDepositPercent int64
 		initialDeposit           sdk.Coins
+		depositDenomWeights      []*v1.DepositDenomWeight
 
 		expectError bool
 	}{
@@ -186,6 +275,23 @@ func TestValidateInitialDeposit(t *testing.T) {
 				sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1)),
 			),
 		},
+		"min deposit * initial percent == weighted initial deposit (other denom): success": {
+			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit:           sdk.NewCoins(sdk.NewCoin("uphoton", sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100*2))),
+			depositDenomWeights:      []*v1.DepositDenomWeight{{Denom: sdk.DefaultBondDenom, Weight: "1"}, {Denom: "uphoton", Weight: "0.5"}},
+		},
+		"min deposit * initial percent > weighted initial deposit (multiple coins): error": {
+			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit: sdk.NewCoins(
+				sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100/2)),
+				sdk.NewCoin("uphoton", sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1)),
+			),
+			depositDenomWeights: []*v1.DepositDenomWeight{{Denom: sdk.DefaultBondDenom, Weight: "1"}, {Denom: "uphoton", Weight: "0.5"}},
+
+			expectError: true,
+		},
 		"0 initial percent: success": {
 			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
 			minInitialDepositPercent: 0,
@@ -200,6 +306,7 @@ func TestValidateInitialDeposit(t *testing.T) {
 			params := v1.DefaultParams()
 			params.MinDeposit = tc.minDeposit
 			params.MinInitialDepositRatio = sdk.NewDec(tc.minInitialDepositPercent).Quo(sdk.NewDec(100)).String()
+			params.DepositDenomWeights = tc.depositDenomWeights
 
 			govKeeper.SetParams(ctx, params)
 
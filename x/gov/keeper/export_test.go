@@ -1,9 +1,24 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
 
 // ValidateInitialDeposit is a helper function used only in deposit tests which returns the same
 // functionality of validateInitialDeposit private function.
 func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
 	return k.validateInitialDeposit(ctx, initialDeposit)
 }
+
+// KVStore returns the gov store, used in migration tests to simulate a state
+// without the secondary indexes.
+func (k Keeper) KVStore(ctx sdk.Context) sdk.KVStore {
+	return ctx.KVStore(k.storeKey)
+}
+
+// SetConfig overrides the keeper config, used in tests of the config guards.
+func (k *Keeper) SetConfig(config types.Config) {
+	k.config = config
+}
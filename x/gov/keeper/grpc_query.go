@@ -2,12 +2,18 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkerrors "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	v3 "github.com/atomone-hub/atomone/x/gov/migrations/v3"
@@ -18,6 +24,63 @@ import (
 
 var _ v1.QueryServer = Keeper{}
 
+// Constitution returns the chain's constitution.
+func (q Keeper) Constitution(c context.Context, req *v1.QueryConstitutionRequest) (*v1.QueryConstitutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	constitution := q.GetConstitution(ctx)
+	return &v1.QueryConstitutionResponse{Constitution: constitution}, nil
+}
+
+// GovernanceStats returns aggregate statistics about the governance process
+func (q Keeper) GovernanceStats(c context.Context, req *v1.QueryGovernanceStatsRequest) (*v1.QueryGovernanceStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	lastN := req.LastN
+	if lastN == 0 {
+		lastN = DefaultTurnoutWindow
+	}
+	if lastN > MaxTurnoutWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "last_n can not be greater than %d", MaxTurnoutWindow)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &v1.QueryGovernanceStatsResponse{
+		ProposalCounts: q.GetProposalStatusCounts(ctx),
+		AverageTurnout: q.GetAverageTurnout(ctx, lastN).String(),
+	}, nil
+}
+
+// ProposalCount returns the number of proposals created, per status, and the next proposal ID
+func (q Keeper) ProposalCount(c context.Context, req *v1.QueryProposalCountRequest) (*v1.QueryProposalCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	nextProposalID, err := q.GetProposalID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the IDs don't tell the number of proposals, as they may not start from
+	// 1, so the stored status counts are summed up.
+	statusCounts := q.GetProposalStatusCounts(ctx)
+	var total uint64
+	for _, count := range statusCounts {
+		total += count.Count
+	}
+
+	return &v1.QueryProposalCountResponse{
+		Total:          total,
+		StatusCounts:   statusCounts,
+		NextProposalId: nextProposalID,
+	}, nil
+}
+
 // Proposal returns proposal details based on ProposalID
 func (q Keeper) Proposal(c context.Context, req *v1.QueryProposalRequest) (*v1.QueryProposalResponse, error) {
 	if req == nil {
@@ -38,52 +101,183 @@ func (q Keeper) Proposal(c context.Context, req *v1.QueryProposalRequest) (*v1.Q
 	return &v1.QueryProposalResponse{Proposal: &proposal}, nil
 }
 
+// ArchivedProposal returns the archived summary of a pruned proposal based on ProposalID
+func (q Keeper) ArchivedProposal(c context.Context, req *v1.QueryArchivedProposalRequest) (*v1.QueryArchivedProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	archived, found := q.GetArchivedProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "archived proposal %d doesn't exist", req.ProposalId)
+	}
+
+	return &v1.QueryArchivedProposalResponse{ArchivedProposal: &archived}, nil
+}
+
 // Proposals implements the Query/Proposals gRPC method
 func (q Keeper) Proposals(c context.Context, req *v1.QueryProposalsRequest) (*v1.QueryProposalsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(q.storeKey)
-	proposalStore := prefix.NewStore(store, types.ProposalsKeyPrefix)
-
-	filteredProposals, pageRes, err := query.GenericFilteredPaginate(
-		q.cdc,
-		proposalStore,
-		req.Pagination,
-		func(key []byte, p *v1.Proposal) (*v1.Proposal, error) {
-			matchVoter, matchDepositor, matchStatus := true, true, true
-
-			// match status (if supplied/valid)
-			if v1.ValidProposalStatus(req.ProposalStatus) {
-				matchStatus = p.Status == req.ProposalStatus
-			}
 
-			// match voter address (if supplied)
-			if len(req.Voter) > 0 {
-				voter, err := sdk.AccAddressFromBech32(req.Voter)
-				if err != nil {
-					return nil, err
-				}
+	var voter sdk.AccAddress
+	if len(req.Voter) > 0 {
+		var err error
+		voter, err = sdk.AccAddressFromBech32(req.Voter)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var proposer sdk.AccAddress
+	if len(req.Proposer) > 0 {
+		var err error
+		proposer, err = sdk.AccAddressFromBech32(req.Proposer)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 
-				_, matchVoter = q.GetVote(ctx, p.Id, voter)
-			}
+	filter := func(p *v1.Proposal) (*v1.Proposal, error) {
+		matchVoter, matchDepositor, matchStatus, matchProposer, matchMsgTypeURL := true, true, true, true, true
 
-			// match depositor (if supplied)
-			if len(req.Depositor) > 0 {
-				depositor, err := sdk.AccAddressFromBech32(req.Depositor)
-				if err != nil {
-					return nil, err
-				}
-				_, matchDepositor = q.GetDeposit(ctx, p.Id, depositor)
+		// match status (if supplied/valid)
+		if v1.ValidProposalStatus(req.ProposalStatus) {
+			matchStatus = p.Status == req.ProposalStatus
+		}
+
+		// match voter address (if supplied)
+		if len(voter) > 0 {
+			matchVoter = q.HasVoted(ctx, p.Id, voter)
+		}
+
+		// match depositor (if supplied)
+		if len(req.Depositor) > 0 {
+			depositor, err := sdk.AccAddressFromBech32(req.Depositor)
+			if err != nil {
+				return nil, err
 			}
+			_, matchDepositor = q.GetDeposit(ctx, p.Id, depositor)
+		}
+
+		// match proposer (if supplied)
+		if len(proposer) > 0 {
+			matchProposer = store.Has(types.ProposalByProposerKey(proposer, p.Id))
+		}
+
+		// match message type URL (if supplied)
+		if len(req.MsgTypeUrl) > 0 {
+			matchMsgTypeURL = store.Has(types.ProposalByMsgTypeURLKey(req.MsgTypeUrl, p.Id))
+		}
+
+		if matchVoter && matchDepositor && matchStatus && matchProposer && matchMsgTypeURL {
+			return p, nil
+		}
 
-			if matchVoter && matchDepositor && matchStatus {
-				return p, nil
+		return nil, nil
+	}
+
+	// in summary mode, the proposals are decoded without unpacking their
+	// messages, which are left out of the response
+	decode := func(bz []byte) (*v1.Proposal, error) {
+		var proposal v1.Proposal
+		if req.Summary {
+			if err := proposal.Unmarshal(bz); err != nil {
+				return nil, err
 			}
+			proposal.Messages = nil
+			return &proposal, nil
+		}
+
+		if err := q.UnmarshalProposal(bz, &proposal); err != nil {
+			return nil, err
+		}
+		return &proposal, nil
+	}
 
+	// index keys end with the proposal ID
+	loadIndexed := func(key, _ []byte) (*v1.Proposal, error) {
+		bz := store.Get(types.ProposalKey(types.GetProposalIDFromBytes(key[len(key)-8:])))
+		if bz == nil {
 			return nil, nil
-		}, func() *v1.Proposal {
-			return &v1.Proposal{}
-		})
+		}
+		return decode(bz)
+	}
+
+	paginationStore := prefix.NewStore(store, types.ProposalsKeyPrefix)
+	load := func(_, value []byte) (*v1.Proposal, error) {
+		return decode(value)
+	}
+
+	pagination := req.Pagination
+
+	// when ordering by voting end time or total deposit, iterate over the
+	// proposals of the corresponding index, the filters being applied to each
+	// proposal. Otherwise, when filtering by voter, proposer or message type
+	// URL, only iterate over the proposals of the corresponding index instead
+	// of every proposal
+	switch {
+	case req.OrderBy == v1.OrderByVotingEndTime:
+		paginationStore = prefix.NewStore(store, types.ProposalsByVotingEndTimeKeyPrefix)
+		load = loadIndexed
+	case req.OrderBy == v1.OrderByTotalDeposit:
+		paginationStore = prefix.NewStore(store, types.ProposalsByTotalDepositKeyPrefix)
+		load = loadIndexed
+	case len(voter) > 0:
+		paginationStore = prefix.NewStore(store, types.VotesByVoterKey(voter))
+		load = loadIndexed
+	case len(proposer) > 0:
+		paginationStore = prefix.NewStore(store, types.ProposalsByProposerKey(proposer))
+		load = loadIndexed
+	case len(req.MsgTypeUrl) > 0:
+		paginationStore = prefix.NewStore(store, types.ProposalsByMsgTypeURLKey(req.MsgTypeUrl))
+		load = loadIndexed
+	}
+
+	switch req.OrderBy {
+	case v1.OrderByUnspecified, v1.OrderByIDAsc, v1.OrderByVotingEndTime, v1.OrderByTotalDeposit:
+	case v1.OrderByIDDesc:
+		// all the indexes above are ordered by proposal ID, so that descending
+		// IDs are a reverse iteration
+		pagination = &query.PageRequest{Reverse: true}
+		if req.Pagination != nil {
+			p := *req.Pagination
+			p.Reverse = !p.Reverse
+			pagination = &p
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order by %s", req.OrderBy)
+	}
+
+	pagination, err := q.guardPageRequest(paginationStore, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var filteredProposals []*v1.Proposal
+	pageRes, err := query.FilteredPaginate(paginationStore, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		proposal, err := load(key, value)
+		if err != nil || proposal == nil {
+			return false, err
+		}
+
+		p, err := filter(proposal)
+		if err != nil || p == nil {
+			return false, err
+		}
+
+		if accumulate {
+			filteredProposals = append(filteredProposals, p)
+		}
+		return true, nil
+	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -136,7 +330,12 @@ func (q Keeper) Votes(c context.Context, req *v1.QueryVotesRequest) (*v1.QueryVo
 	store := ctx.KVStore(q.storeKey)
 	votesStore := prefix.NewStore(store, types.VotesKey(req.ProposalId))
 
-	pageRes, err := query.Paginate(votesStore, req.Pagination, func(key []byte, value []byte) error {
+	pagination, err := q.guardPageRequest(votesStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	pageRes, err := query.Paginate(votesStore, pagination, func(key []byte, value []byte) error {
 		var vote v1.Vote
 		if err := q.cdc.Unmarshal(value, &vote); err != nil {
 			return err
@@ -232,7 +431,12 @@ func (q Keeper) Deposits(c context.Context, req *v1.QueryDepositsRequest) (*v1.Q
 	store := ctx.KVStore(q.storeKey)
 	depositStore := prefix.NewStore(store, types.DepositsKey(req.ProposalId))
 
-	pageRes, err := query.Paginate(depositStore, req.Pagination, func(key []byte, value []byte) error {
+	pagination, err := q.guardPageRequest(depositStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	pageRes, err := query.Paginate(depositStore, pagination, func(key []byte, value []byte) error {
 		var deposit v1.Deposit
 		if err := q.cdc.Unmarshal(value, &deposit); err != nil {
 			return err
@@ -248,8 +452,10 @@ func (q Keeper) Deposits(c context.Context, req *v1.QueryDepositsRequest) (*v1.Q
 	return &v1.QueryDepositsResponse{Deposits: deposits, Pagination: pageRes}, nil
 }
 
-// TallyResult queries the tally of a proposal vote
-func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest) (*v1.QueryTallyResultResponse, error) {
+// TallyResult queries the tally of a proposal vote. The recomputation of the
+// tally of a proposal in voting period is aborted past the TallyQueryTimeout
+// of the keeper config.
+func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest) (res *v1.QueryTallyResultResponse, err error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
@@ -258,28 +464,115 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
+	ctx := q.withTallyQueryDeadline(sdk.UnwrapSDKContext(c))
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(errTallyQueryTimeout); !ok {
+				panic(r)
+			}
+			res, err = nil, status.Errorf(codes.DeadlineExceeded, "tally of proposal %d exceeded the %s time budget", req.ProposalId, q.config.TallyQueryTimeout)
+		}
+	}()
 
-	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	tallyResult, quadraticResult, ok := q.getTallyResults(ctx, req.ProposalId)
 	if !ok {
 		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
 	}
 
-	var tallyResult v1.TallyResult
+	return &v1.QueryTallyResultResponse{Tally: &tallyResult, Height: ctx.BlockHeight(), QuadraticTally: quadraticResult}, nil
+}
 
-	switch {
-	case proposal.Status == v1.StatusDepositPeriod:
-		tallyResult = v1.EmptyTallyResult()
+// BountyPool queries the proposer bounty pool
+func (q Keeper) BountyPool(c context.Context, req *v1.QueryBountyPoolRequest) (*v1.QueryBountyPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
 
-	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed:
-		tallyResult = *proposal.FinalTallyResult
+	ctx := sdk.UnwrapSDKContext(c)
+	pool := q.GetBountyPool(ctx)
 
-	default:
-		// proposal is in voting period
-		_, _, tallyResult = q.Tally(ctx, proposal)
+	return &v1.QueryBountyPoolResponse{BountyPool: &pool}, nil
+}
+
+// CommunityPoolSpend queries the community pool spend of the current period
+func (q Keeper) CommunityPoolSpend(c context.Context, req *v1.QueryCommunityPoolSpendRequest) (*v1.QueryCommunityPoolSpendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	period := q.CurrentCommunityPoolSpendPeriod(ctx)
+
+	return &v1.QueryCommunityPoolSpendResponse{
+		Period:            &period,
+		PeriodEndTime:     q.CommunityPoolSpendPeriodEndTime(ctx, period),
+		Limit:             q.GetParams(ctx).CommunityPoolSpendLimit,
+		Remaining:         q.RemainingCommunityPoolSpend(ctx),
+		QueuedProposalIds: q.GetCommunityPoolSpendQueue(ctx),
+	}, nil
+}
+
+// ProposerBounty queries the bounty paid to the proposer of a passed proposal
+func (q Keeper) ProposerBounty(c context.Context, req *v1.QueryProposerBountyRequest) (*v1.QueryProposerBountyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bounty, found := q.GetProposerBounty(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no proposer bounty paid for proposal %d", req.ProposalId)
 	}
 
-	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
+	return &v1.QueryProposerBountyResponse{ProposerBounty: &bounty}, nil
+}
+
+// ValidateProposal runs the full validation of a proposal submission against a
+// cached context, so that nothing is written to the store.
+func (q Keeper) ValidateProposal(c context.Context, req *v1.QueryValidateProposalRequest) (*v1.QueryValidateProposalResponse, error) {
+	if req == nil || req.Proposal == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := req.Proposal.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cacheCtx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+	if _, err := NewMsgServerImpl(&q).SubmitProposal(sdk.WrapSDKContext(cacheCtx), req.Proposal); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &v1.QueryValidateProposalResponse{}, nil
+}
+
+// VoteLock returns the stake locked by a voter to boost its votes.
+func (q Keeper) VoteLock(c context.Context, req *v1.QueryVoteLockRequest) (*v1.QueryVoteLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Voter == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty voter address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+	lock, found := q.GetVoteLock(ctx, voter)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no vote lock for voter %s", req.Voter)
+	}
+
+	return &v1.QueryVoteLockResponse{VoteLock: &lock}, nil
 }
 
 var _ v1beta1.QueryServer = legacyQueryServer{}
@@ -458,3 +751,135 @@ func (q legacyQueryServer) TallyResult(c context.Context, req *v1beta1.QueryTall
 
 	return &v1beta1.QueryTallyResultResponse{Tally: tally}, nil
 }
+
+// SimulateProposalExecution runs the messages of a proposal in a cached
+// context, as the EndBlocker executes a passed proposal, and returns the gas
+// used and the error of each message. The state changes are discarded. The
+// messages share the gas limit of simulationGasLimit, and the query is aborted
+// past the TallyQueryTimeout of the keeper config.
+func (q Keeper) SimulateProposalExecution(c context.Context, req *v1.QuerySimulateProposalExecutionRequest) (res *v1.QuerySimulateProposalExecutionResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := q.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(errTallyQueryTimeout); !ok {
+				panic(r)
+			}
+			res, err = nil, status.Errorf(codes.DeadlineExceeded, "simulation of proposal %d exceeded the %s time budget", req.ProposalId, q.config.TallyQueryTimeout)
+		}
+	}()
+
+	// the messages are run in sequence in the same cached context, the ones
+	// following a failing message are not run, as on execution
+	gasMeter := sdk.NewInfiniteGasMeter()
+	if limit := q.simulationGasLimit(ctx); limit > 0 {
+		gasMeter = sdk.NewGasMeter(limit)
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = q.withTallyQueryDeadline(cacheCtx.WithGasMeter(gasMeter))
+	results := make([]*v1.MsgExecutionResult, 0, len(messages))
+	for _, msg := range messages {
+		gasBefore := gasMeter.GasConsumed()
+		msgCtx := cacheCtx.WithEventManager(sdk.NewEventManager())
+		err := simulateMsgExecution(msgCtx, q.router.Handler(msg), msg)
+
+		result := &v1.MsgExecutionResult{
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			GasUsed:    gasMeter.GasConsumed() - gasBefore,
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+		if err != nil {
+			break
+		}
+	}
+
+	return &v1.QuerySimulateProposalExecutionResponse{Results: results}, nil
+}
+
+// RecurringProposals returns the recurring proposals.
+func (q Keeper) RecurringProposals(c context.Context, req *v1.QueryRecurringProposalsRequest) (*v1.QueryRecurringProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var recurringProposals []*v1.RecurringProposal
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	recurringStore := prefix.NewStore(store, types.RecurringProposalsKeyPrefix)
+
+	pagination, err := q.guardPageRequest(recurringStore, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	pageRes, err := query.Paginate(recurringStore, pagination, func(key []byte, value []byte) error {
+		var recurring v1.RecurringProposal
+		if err := q.cdc.Unmarshal(value, &recurring); err != nil {
+			return err
+		}
+
+		recurringProposals = append(recurringProposals, &recurring)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryRecurringProposalsResponse{RecurringProposals: recurringProposals, Pagination: pageRes}, nil
+}
+
+// simulationGasLimit returns the gas limit of the SimulateProposalExecution
+// query: the MaxSimulationGas of the keeper config, lowered to the block max
+// gas if any. Zero means no limit.
+func (q Keeper) simulationGasLimit(ctx sdk.Context) uint64 {
+	limit := q.config.MaxSimulationGas
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil && cp.Block.MaxGas > 0 {
+		if maxGas := uint64(cp.Block.MaxGas); limit == 0 || maxGas < limit {
+			limit = maxGas
+		}
+	}
+	return limit
+}
+
+// simulateMsgExecution executes handler(msg), recovering from a panic. The
+// TallyQueryTimeout panic is not recovered, to abort the whole query.
+func simulateMsgExecution(ctx sdk.Context, handler baseapp.MsgServiceHandler, msg sdk.Msg) (err error) {
+	if handler == nil {
+		return sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case errTallyQueryTimeout:
+				panic(r)
+			case storetypes.ErrorOutOfGas:
+				err = sdkerrors.Wrapf(errortypes.ErrOutOfGas, "handling x/gov proposal msg [%s] ran out of gas in location: %v", sdk.MsgTypeURL(msg), r.Descriptor)
+			default:
+				err = fmt.Errorf("handling x/gov proposal msg [%s] PANICKED: %v", sdk.MsgTypeURL(msg), r)
+			}
+		}
+	}()
+	_, err = handler(ctx, msg)
+	return err
+}
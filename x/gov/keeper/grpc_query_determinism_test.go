@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// determinismNode is a gov keeper with a fixed staking state, standing for a
+// node replaying the same chain.
+type determinismNode struct {
+	keeper *keeper.Keeper
+	cdc    codec.Codec
+	ctx    sdk.Context
+}
+
+// newDeterminismNode returns a node whose state is built by the same sequence
+// of proposal submissions and votes as the other nodes.
+func newDeterminismNode(t *testing.T, voters []sdk.AccAddress) determinismNode {
+	t.Helper()
+
+	valAddr := sdk.ValAddress("validator___________")
+	validator := stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdkmath.NewInt(3000),
+		DelegatorShares: sdkmath.LegacyNewDec(3000),
+	}
+	govKeeper, _, encCfg, ctx := setupGovKeeper(t, mockAccountKeeperExpectations, func(_ sdk.Context, m mocks) {
+		m.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(3000)).AnyTimes()
+		m.stakingKeeper.EXPECT().
+			IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(index int64, validator stakingtypes.ValidatorI) bool) error {
+				fn(0, validator)
+				return nil
+			}).AnyTimes()
+		m.stakingKeeper.EXPECT().
+			IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, voter sdk.AccAddress, fn func(index int64, d stakingtypes.DelegationI) bool) error {
+				fn(0, stakingtypes.NewDelegation(voter, valAddr, sdkmath.LegacyNewDec(1000)))
+				return nil
+			}).AnyTimes()
+		m.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(validator).AnyTimes()
+	})
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	for i, voter := range voters {
+		msgs := TestProposal
+		if i%2 == 1 {
+			msgs = TestProposal[:1]
+		}
+		proposal, err := govKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", voter, nil, false)
+		require.NoError(t, err)
+		if i == 0 {
+			// leave the first proposal in deposit period
+			continue
+		}
+		govKeeper.ActivateVotingPeriod(ctx, proposal)
+	}
+	for i, voter := range voters {
+		for proposalID := uint64(2); proposalID <= uint64(len(voters)); proposalID++ {
+			option := v1.VoteOption(1 + (i+int(proposalID))%4)
+			err := govKeeper.AddVote(ctx, proposalID, voter, v1.NewNonSplitVoteOption(option), "")
+			require.NoError(t, err)
+		}
+	}
+
+	return determinismNode{keeper: govKeeper, cdc: encCfg.Codec, ctx: ctx}
+}
+
+// TestGRPCQueryDeterminism replays the same query sequence on two nodes with
+// the same state, and checks that the responses are byte-identical. The tally
+// of a proposal in voting period is computed inside the TallyResult query, so
+// it is replayed several times.
+func TestGRPCQueryDeterminism(t *testing.T) {
+	voters := []sdk.AccAddress{
+		sdk.AccAddress("voter1______________"),
+		sdk.AccAddress("voter2______________"),
+		sdk.AccAddress("voter3______________"),
+		sdk.AccAddress("voter4______________"),
+	}
+	nodes := []determinismNode{newDeterminismNode(t, voters), newDeterminismNode(t, voters)}
+
+	queries := []func(determinismNode) (codec.ProtoMarshaler, error){
+		func(n determinismNode) (codec.ProtoMarshaler, error) {
+			return n.keeper.Proposals(sdk.WrapSDKContext(n.ctx), &v1.QueryProposalsRequest{})
+		},
+		func(n determinismNode) (codec.ProtoMarshaler, error) {
+			return n.keeper.Proposals(sdk.WrapSDKContext(n.ctx), &v1.QueryProposalsRequest{
+				ProposalStatus: v1.StatusVotingPeriod,
+				Voter:          voters[1].String(),
+				Pagination:     &query.PageRequest{Limit: 2, Reverse: true},
+			})
+		},
+		func(n determinismNode) (codec.ProtoMarshaler, error) {
+			return n.keeper.Proposals(sdk.WrapSDKContext(n.ctx), &v1.QueryProposalsRequest{
+				Proposer:   voters[2].String(),
+				MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgExecLegacyContent{}),
+			})
+		},
+		func(n determinismNode) (codec.ProtoMarshaler, error) {
+			return n.keeper.Votes(sdk.WrapSDKContext(n.ctx), &v1.QueryVotesRequest{ProposalId: 3})
+		},
+		func(n determinismNode) (codec.ProtoMarshaler, error) {
+			return n.keeper.TallyResult(sdk.WrapSDKContext(n.ctx), &v1.QueryTallyResultRequest{ProposalId: 2})
+		},
+		func(n determinismNode) (codec.ProtoMarshaler, error) {
+			return n.keeper.TallyResult(sdk.WrapSDKContext(n.ctx), &v1.QueryTallyResultRequest{ProposalId: 3})
+		},
+		func(n determinismNode) (codec.ProtoMarshaler, error) {
+			return n.keeper.GovernanceStats(sdk.WrapSDKContext(n.ctx), &v1.QueryGovernanceStatsRequest{})
+		},
+	}
+
+	for round := 0; round < 3; round++ {
+		for i, q := range queries {
+			var expected []byte
+			for _, n := range nodes {
+				res, err := q(n)
+				require.NoError(t, err, "query %d", i)
+				bz, err := n.cdc.Marshal(res)
+				require.NoError(t, err)
+				if expected == nil {
+					expected = bz
+					continue
+				}
+				require.Equal(t, expected, bz, "query %d, round %d", i, round)
+			}
+		}
+	}
+
+	// the tally computed by the queries doesn't consume the votes
+	for _, n := range nodes {
+		require.Len(t, n.keeper.GetVotes(n.ctx, 2), len(voters))
+	}
+}
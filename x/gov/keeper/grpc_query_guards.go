@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// errTallyQueryTimeout is the panic value of the deadlineGasMeter once the
+// TallyQueryTimeout is exceeded.
+type errTallyQueryTimeout struct{}
+
+// deadlineGasMeter is a gas meter aborting the query it meters, by panicking,
+// on the first store access past its deadline.
+type deadlineGasMeter struct {
+	storetypes.GasMeter
+	deadline time.Time
+}
+
+// ConsumeGas implements the storetypes.GasMeter interface.
+func (m deadlineGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	if time.Now().After(m.deadline) {
+		panic(errTallyQueryTimeout{})
+	}
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// withTallyQueryDeadline returns the context with a gas meter aborting the
+// query once the TallyQueryTimeout of the keeper config is exceeded.
+func (q Keeper) withTallyQueryDeadline(ctx sdk.Context) sdk.Context {
+	if q.config.TallyQueryTimeout == 0 {
+		return ctx
+	}
+	return ctx.WithGasMeter(deadlineGasMeter{
+		GasMeter: ctx.GasMeter(),
+		deadline: time.Now().Add(q.config.TallyQueryTimeout),
+	})
+}
+
+// guardPageRequest returns the page request with its limit lowered to the
+// MaxQueryPageSize of the keeper config. It rejects the requests counting the
+// total of more than MaxQueryCountTotal store entries, or skips the total if
+// it was not requested explicitly but implied by an unset limit.
+func (q Keeper) guardPageRequest(store storetypes.KVStore, req *query.PageRequest) (*query.PageRequest, error) {
+	var pageReq query.PageRequest
+	if req != nil {
+		pageReq = *req
+	}
+
+	// an unset limit implies the count of the total, which is never computed
+	// when paginating by key
+	countTotal := len(pageReq.Key) == 0 && (pageReq.CountTotal || pageReq.Limit == 0)
+	if pageReq.Limit == 0 {
+		pageReq.Limit = query.DefaultLimit
+	}
+	if maxPageSize := q.config.MaxQueryPageSize; maxPageSize > 0 && pageReq.Limit > maxPageSize {
+		pageReq.Limit = maxPageSize
+	}
+
+	if !countTotal {
+		return &pageReq, nil
+	}
+	if maxCount := q.config.MaxQueryCountTotal; maxCount > 0 && !hasAtMostEntries(store, maxCount) {
+		if pageReq.CountTotal {
+			return nil, status.Errorf(codes.ResourceExhausted, "count_total is not supported on more than %d entries", maxCount)
+		}
+		return &pageReq, nil
+	}
+	pageReq.CountTotal = true
+	return &pageReq, nil
+}
+
+// hasAtMostEntries returns true if the store holds at most maxCount entries.
+func hasAtMostEntries(store storetypes.KVStore, maxCount uint64) bool {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		if count > maxCount {
+			return false
+		}
+	}
+	return true
+}
@@ -3,14 +3,20 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/math"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/atomone-hub/atomone/x/gov/keeper"
 	v3 "github.com/atomone-hub/atomone/x/gov/migrations/v3"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
@@ -58,7 +64,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -133,7 +139,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -199,7 +205,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+					proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -288,6 +294,116 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 			},
 			true,
 		},
+		{
+			"request with filter of proposer address",
+			func() {
+				govAddress := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+				testProposal := []sdk.Msg{
+					v1.NewMsgVote(govAddress, 5, v1.OptionYes, ""),
+				}
+				proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "", "test", "summary", addrs[1], nil, false)
+				suite.Require().NoError(err)
+				testProposals = append(testProposals, &proposal)
+
+				req = &v1.QueryProposalsRequest{
+					Proposer: addrs[1].String(),
+				}
+
+				expRes = &v1.QueryProposalsResponse{
+					Proposals: testProposals[5:],
+				}
+			},
+			true,
+		},
+		{
+			"request with filter of proposer address and status",
+			func() {
+				req = &v1.QueryProposalsRequest{
+					Proposer:       sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r").String(),
+					ProposalStatus: v1.StatusVotingPeriod,
+				}
+
+				expRes = &v1.QueryProposalsResponse{
+					Proposals: testProposals[1:2],
+				}
+			},
+			true,
+		},
+		{
+			"request with invalid proposer address",
+			func() {
+				req = &v1.QueryProposalsRequest{
+					Proposer: "invalid",
+				}
+			},
+			false,
+		},
+		{
+			"request with filter of msg type url",
+			func() {
+				req = &v1.QueryProposalsRequest{
+					MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgVote{}),
+				}
+
+				expRes = &v1.QueryProposalsResponse{
+					Proposals: testProposals,
+				}
+			},
+			true,
+		},
+		{
+			"request with filter of msg type url and proposer",
+			func() {
+				req = &v1.QueryProposalsRequest{
+					MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgVote{}),
+					Proposer:   addrs[1].String(),
+				}
+
+				expRes = &v1.QueryProposalsResponse{
+					Proposals: testProposals[5:],
+				}
+			},
+			true,
+		},
+		{
+			"request with filter of unknown msg type url",
+			func() {
+				req = &v1.QueryProposalsRequest{
+					MsgTypeUrl: sdk.MsgTypeURL(&v1.MsgDeposit{}),
+				}
+
+				expRes = &v1.QueryProposalsResponse{}
+			},
+			true,
+		},
+		{
+			"request with filter of long msg type url",
+			func() {
+				req = &v1.QueryProposalsRequest{
+					MsgTypeUrl: "/" + strings.Repeat("a", 300),
+				}
+
+				expRes = &v1.QueryProposalsResponse{}
+			},
+			true,
+		},
+		{
+			"request summary of proposals with filter of proposer",
+			func() {
+				req = &v1.QueryProposalsRequest{
+					Proposer: addrs[1].String(),
+					Summary:  true,
+				}
+
+				expRes = &v1.QueryProposalsResponse{}
+				for _, p := range testProposals[5:] {
+					summary := *p
+					summary.Messages = nil
+					expRes.Proposals = append(expRes.Proposals, &summary)
+				}
+			},
+			true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -319,6 +435,127 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryProposalsOrderBy() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	stake := func(power int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.stakingKeeper.TokensFromConsensusPower(ctx, power)))
+	}
+
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[i], nil, false)
+		suite.Require().NoError(err)
+		ids = append(ids, proposal.Id)
+	}
+
+	// proposal 3 enters the voting period before proposal 1, proposal 2 stays
+	// in the deposit period, and the total deposits are 12, 2 and 10
+	_, err := suite.govKeeper.AddDeposit(ctx, ids[0], addrs[0], stake(4))
+	suite.Require().NoError(err)
+	_, err = suite.govKeeper.AddDeposit(ctx, ids[1], addrs[1], stake(2))
+	suite.Require().NoError(err)
+	_, err = suite.govKeeper.AddDeposit(ctx, ids[2], addrs[2], stake(10))
+	suite.Require().NoError(err)
+	_, err = suite.govKeeper.AddDeposit(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), ids[0], addrs[0], stake(8))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg    string
+		req    *v1.QueryProposalsRequest
+		expIDs []uint64
+		expErr bool
+	}{
+		{
+			"ascending id",
+			&v1.QueryProposalsRequest{OrderBy: v1.OrderByIDAsc},
+			[]uint64{ids[0], ids[1], ids[2]},
+			false,
+		},
+		{
+			"descending id",
+			&v1.QueryProposalsRequest{OrderBy: v1.OrderByIDDesc},
+			[]uint64{ids[2], ids[1], ids[0]},
+			false,
+		},
+		{
+			"descending id with limit",
+			&v1.QueryProposalsRequest{OrderBy: v1.OrderByIDDesc, Pagination: &query.PageRequest{Limit: 2}},
+			[]uint64{ids[2], ids[1]},
+			false,
+		},
+		{
+			"descending id with reverse",
+			&v1.QueryProposalsRequest{OrderBy: v1.OrderByIDDesc, Pagination: &query.PageRequest{Reverse: true}},
+			[]uint64{ids[0], ids[1], ids[2]},
+			false,
+		},
+		{
+			"voting end time",
+			&v1.QueryProposalsRequest{OrderBy: v1.OrderByVotingEndTime},
+			[]uint64{ids[2], ids[0]},
+			false,
+		},
+		{
+			"voting end time with reverse",
+			&v1.QueryProposalsRequest{OrderBy: v1.OrderByVotingEndTime, Pagination: &query.PageRequest{Reverse: true}},
+			[]uint64{ids[0], ids[2]},
+			false,
+		},
+		{
+			"total deposit",
+			&v1.QueryProposalsRequest{OrderBy: v1.OrderByTotalDeposit},
+			[]uint64{ids[1], ids[2], ids[0]},
+			false,
+		},
+		{
+			"total deposit with filter of proposer",
+			&v1.QueryProposalsRequest{OrderBy: v1.OrderByTotalDeposit, Proposer: addrs[2].String()},
+			[]uint64{ids[2]},
+			false,
+		},
+		{
+			"total deposit with filter of status",
+			&v1.QueryProposalsRequest{OrderBy: v1.OrderByTotalDeposit, ProposalStatus: v1.StatusDepositPeriod},
+			[]uint64{ids[1]},
+			false,
+		},
+		{
+			"invalid order",
+			&v1.QueryProposalsRequest{OrderBy: 10},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			res, err := queryClient.Proposals(gocontext.Background(), tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var resIDs []uint64
+			for _, p := range res.Proposals {
+				resIDs = append(resIDs, p.Id)
+			}
+			suite.Require().Equal(tc.expIDs, resIDs)
+		})
+	}
+
+	// the index entries are removed with the proposal
+	suite.govKeeper.DeleteProposal(ctx, ids[0])
+	res, err := queryClient.Proposals(gocontext.Background(), &v1.QueryProposalsRequest{OrderBy: v1.OrderByTotalDeposit})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 2)
+	res, err = queryClient.Proposals(gocontext.Background(), &v1.QueryProposalsRequest{OrderBy: v1.OrderByVotingEndTime})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 1)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposals() {
 	suite.reset()
 	ctx, queryClient := suite.ctx, suite.legacyQueryClient
@@ -337,7 +574,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposals() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 			},
@@ -418,7 +655,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], nil, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVoteRequest{
@@ -532,7 +769,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], nil, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVoteRequest{
@@ -638,7 +875,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], nil, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVotesRequest{
@@ -740,7 +977,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], nil, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVotesRequest{
@@ -1025,7 +1262,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1126,7 +1363,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1216,7 +1453,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], nil, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryDepositsRequest{
@@ -1310,7 +1547,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], nil, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryDepositsRequest{
@@ -1522,6 +1759,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(tallyRes.Tally.String())
 				suite.Require().Equal(expTally.String(), tallyRes.Tally.String())
+				suite.Require().Equal(suite.ctx.BlockHeight(), tallyRes.Height)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Nil(tallyRes)
@@ -1530,6 +1768,58 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryGuards() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	config := suite.govKeeper.GetConfig()
+	config.MaxQueryPageSize = 2
+	config.MaxQueryCountTotal = 2
+	suite.govKeeper.SetConfig(config)
+
+	var proposal v1.Proposal
+	for i := 0; i < 3; i++ {
+		var err error
+		proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], nil, false)
+		suite.Require().NoError(err)
+	}
+
+	// the page limit is lowered to the max page size
+	res, err := queryClient.Proposals(gocontext.Background(), &v1.QueryProposalsRequest{Pagination: &query.PageRequest{Limit: 10}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 2)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	// the total implied by an unset limit is skipped over the max count
+	res, err = queryClient.Proposals(gocontext.Background(), &v1.QueryProposalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 2)
+	suite.Require().Zero(res.Pagination.Total)
+
+	// and rejected if requested explicitly
+	_, err = queryClient.Proposals(gocontext.Background(), &v1.QueryProposalsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	suite.Require().Equal(codes.ResourceExhausted, status.Code(err))
+
+	// the total is counted below the max count
+	suite.govKeeper.ActivateVotingPeriod(ctx, proposal)
+	suite.Require().NoError(suite.govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	votesRes, err := queryClient.Votes(gocontext.Background(), &v1.QueryVotesRequest{ProposalId: proposal.Id, Pagination: &query.PageRequest{CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), votesRes.Pagination.Total)
+
+	// the tally of the proposal in voting period is aborted past the time
+	// budget
+	config.TallyQueryTimeout = time.Nanosecond
+	suite.govKeeper.SetConfig(config)
+	_, err = queryClient.TallyResult(gocontext.Background(), &v1.QueryTallyResultRequest{ProposalId: proposal.Id})
+	suite.Require().Equal(codes.DeadlineExceeded, status.Code(err))
+
+	config.TallyQueryTimeout = time.Minute
+	suite.govKeeper.SetConfig(config)
+	_, err = queryClient.TallyResult(gocontext.Background(), &v1.QueryTallyResultRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 	suite.reset()
 	ctx, queryClient := suite.ctx, suite.legacyQueryClient
@@ -1696,3 +1986,156 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryConstitution() {
+	suite.reset()
+	queryClient := suite.queryClient
+
+	res, err := queryClient.Constitution(gocontext.Background(), &v1.QueryConstitutionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Constitution)
+
+	suite.govKeeper.SetConstitution(suite.ctx, "Constitution")
+	res, err = queryClient.Constitution(gocontext.Background(), &v1.QueryConstitutionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal("Constitution", res.Constitution)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernanceStats() {
+	suite.reset()
+	queryClient := suite.queryClient
+
+	_, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
+	suite.Require().NoError(err)
+	suite.govKeeper.SetProposalTurnout(suite.ctx, v1.ProposalTurnout{ProposalId: 1, Turnout: "0.4"})
+	suite.govKeeper.SetProposalTurnout(suite.ctx, v1.ProposalTurnout{ProposalId: 2, Turnout: "0.2"})
+
+	res, err := queryClient.GovernanceStats(gocontext.Background(), &v1.QueryGovernanceStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.StatusDepositPeriod, res.ProposalCounts[0].Status)
+	suite.Require().Equal(uint64(1), res.ProposalCounts[0].Count)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.3").String(), res.AverageTurnout)
+
+	res, err = queryClient.GovernanceStats(gocontext.Background(), &v1.QueryGovernanceStatsRequest{LastN: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.2").String(), res.AverageTurnout)
+
+	_, err = queryClient.GovernanceStats(gocontext.Background(), &v1.QueryGovernanceStatsRequest{LastN: keeper.MaxTurnoutWindow + 1})
+	suite.Require().Error(err)
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalCount() {
+	suite.reset()
+	queryClient := suite.queryClient
+
+	res, err := queryClient.ProposalCount(gocontext.Background(), &v1.QueryProposalCountRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.Total)
+	suite.Require().Equal(uint64(1), res.NextProposalId)
+
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
+	suite.Require().NoError(err)
+	suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+	_, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
+	suite.Require().NoError(err)
+
+	res, err = queryClient.ProposalCount(gocontext.Background(), &v1.QueryProposalCountRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.Total)
+	suite.Require().Equal(uint64(3), res.NextProposalId)
+	suite.Require().Equal(suite.govKeeper.GetProposalStatusCounts(suite.ctx), res.StatusCounts)
+	suite.Require().Equal(uint64(1), res.StatusCounts[0].Count)
+	suite.Require().Equal(uint64(1), res.StatusCounts[1].Count)
+
+	// the total doesn't depend on the proposal IDs, and counts the archived
+	// proposals
+	suite.govKeeper.SetProposalID(suite.ctx, 100)
+	proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
+	suite.Require().NoError(err)
+	suite.govKeeper.ArchiveProposal(suite.ctx, proposal)
+
+	res, err = queryClient.ProposalCount(gocontext.Background(), &v1.QueryProposalCountRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), res.Total)
+	suite.Require().Equal(uint64(101), res.NextProposalId)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidateProposal() {
+	suite.reset()
+	queryClient := suite.queryClient
+	proposer := suite.addrs[0]
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+
+	msg, err := v1.NewMsgSubmitProposal(TestProposal, deposit, proposer.String(), "", "test", "summary")
+	suite.Require().NoError(err)
+	_, err = queryClient.ValidateProposal(gocontext.Background(), &v1.QueryValidateProposalRequest{Proposal: msg})
+	suite.Require().NoError(err)
+
+	// nothing is written to the store
+	_, found := suite.govKeeper.GetProposal(suite.ctx, 1)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.govKeeper.GetDeposits(suite.ctx, 1))
+
+	// messages are checked against their expected signer
+	invalidMsg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{banktypes.NewMsgSend(proposer, suite.addrs[1], deposit)},
+		deposit, proposer.String(), "", "test", "summary",
+	)
+	suite.Require().NoError(err)
+	_, err = queryClient.ValidateProposal(gocontext.Background(), &v1.QueryValidateProposalRequest{Proposal: invalidMsg})
+	suite.Require().ErrorContains(err, "expected gov account as only signer for proposal message")
+
+	_, err = queryClient.ValidateProposal(gocontext.Background(), &v1.QueryValidateProposalRequest{})
+	suite.Require().ErrorContains(err, "invalid request")
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposalExecution() {
+	suite.reset()
+	queryClient := suite.queryClient
+
+	params := suite.govKeeper.GetParams(suite.ctx)
+	newParams := params
+	newParams.Threshold = "0.6"
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{
+		&v1.MsgUpdateParams{Authority: govAcct.String(), Params: newParams},
+		// the bank msg server of the test router panics on execution
+		banktypes.NewMsgSend(govAcct, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000)))),
+		&v1.MsgUpdateParams{Authority: govAcct.String(), Params: params},
+	}, "", "test", "summary", suite.addrs[0], nil, false)
+	suite.Require().NoError(err)
+
+	res, err := queryClient.SimulateProposalExecution(gocontext.Background(), &v1.QuerySimulateProposalExecutionRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	// the messages following the failing one are not run
+	suite.Require().Len(res.Results, 2)
+	suite.Require().Equal(sdk.MsgTypeURL(&v1.MsgUpdateParams{}), res.Results[0].MsgTypeUrl)
+	suite.Require().Positive(res.Results[0].GasUsed)
+	suite.Require().Empty(res.Results[0].Error)
+	suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), res.Results[1].MsgTypeUrl)
+	suite.Require().Contains(res.Results[1].Error, "PANICKED")
+
+	// nothing is written to the store
+	suite.Require().Equal(params, suite.govKeeper.GetParams(suite.ctx))
+
+	// the messages run out of the gas limit
+	config := suite.govKeeper.GetConfig()
+	config.MaxSimulationGas = res.Results[0].GasUsed - 1
+	suite.govKeeper.SetConfig(config)
+	res, err = queryClient.SimulateProposalExecution(gocontext.Background(), &v1.QuerySimulateProposalExecutionRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 1)
+	suite.Require().Contains(res.Results[0].Error, "out of gas")
+
+	// the simulation is aborted past the time budget
+	config.MaxSimulationGas = 0
+	config.TallyQueryTimeout = time.Nanosecond
+	suite.govKeeper.SetConfig(config)
+	_, err = queryClient.SimulateProposalExecution(gocontext.Background(), &v1.QuerySimulateProposalExecutionRequest{ProposalId: proposal.Id})
+	suite.Require().Equal(codes.DeadlineExceeded, status.Code(err))
+
+	_, err = queryClient.SimulateProposalExecution(gocontext.Background(), &v1.QuerySimulateProposalExecutionRequest{ProposalId: 0})
+	suite.Require().ErrorContains(err, "proposal id can not be 0")
+	_, err = queryClient.SimulateProposalExecution(gocontext.Background(), &v1.QuerySimulateProposalExecutionRequest{ProposalId: proposal.Id + 1})
+	suite.Require().ErrorContains(err, "doesn't exist")
+}
@@ -24,6 +24,9 @@ type MockGovHooksReceiver struct {
 	AfterProposalVoteValid              bool
 	AfterProposalFailedMinDepositValid  bool
 	AfterProposalVotingPeriodEndedValid bool
+	AfterProposalExecutedValid          bool
+	AfterProposalFailedValid            bool
+	AfterProposalVetoedValid            bool
 }
 
 func (h *MockGovHooksReceiver) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
@@ -46,6 +49,18 @@ func (h *MockGovHooksReceiver) AfterProposalVotingPeriodEnded(ctx sdk.Context, p
 	h.AfterProposalVotingPeriodEndedValid = true
 }
 
+func (h *MockGovHooksReceiver) AfterProposalExecuted(ctx sdk.Context, proposalID uint64, results []*sdk.Result) {
+	h.AfterProposalExecutedValid = true
+}
+
+func (h *MockGovHooksReceiver) AfterProposalFailed(ctx sdk.Context, proposalID uint64, err error) {
+	h.AfterProposalFailedValid = true
+}
+
+func (h *MockGovHooksReceiver) AfterProposalVetoed(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalVetoedValid = true
+}
+
 func TestHooks(t *testing.T) {
 	minDeposit := v1.DefaultParams().MinDeposit
 	govKeeper, mocks, _, ctx := setupGovKeeper(t)
@@ -65,7 +80,7 @@ func TestHooks(t *testing.T) {
 	require.False(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
 
 	tp := TestProposal
-	_, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	_, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 	require.NoError(t, err)
 	require.True(t, govHooksReceiver.AfterProposalSubmissionValid)
 
@@ -76,7 +91,7 @@ func TestHooks(t *testing.T) {
 
 	require.True(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
 
-	p2, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	p2, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 	require.NoError(t, err)
 
 	activated, err := govKeeper.AddDeposit(ctx, p2.Id, addrs[0], minDeposit)
@@ -93,4 +108,8 @@ func TestHooks(t *testing.T) {
 	ctx = ctx.WithBlockHeader(newHeader)
 	gov.EndBlocker(ctx, govKeeper)
 	require.True(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	// the proposal is rejected as the quorum is not reached
+	require.False(t, govHooksReceiver.AfterProposalExecutedValid)
+	require.False(t, govHooksReceiver.AfterProposalFailedValid)
+	require.False(t, govHooksReceiver.AfterProposalVetoedValid)
 }
@@ -24,7 +24,7 @@ func AllInvariants(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
 }
 
 // ModuleAccountInvariant checks that the module account coins reflects the sum of
-// deposit amounts held on store.
+// deposit amounts and of the proposer bounty pool held on store.
 func ModuleAccountInvariant(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var expectedDeposits sdk.Coins
@@ -33,6 +33,7 @@ func ModuleAccountInvariant(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
 			expectedDeposits = expectedDeposits.Add(deposit.Amount...)
 			return false
 		})
+		expectedDeposits = expectedDeposits.Add(keeper.GetBountyPool(ctx).Amount...)
 
 		macc := keeper.GetGovernanceAccount(ctx)
 		balances := bk.GetAllBalances(ctx, macc.GetAddress())
@@ -43,7 +44,7 @@ func ModuleAccountInvariant(keeper *Keeper, bk types.BankKeeper) sdk.Invariant {
 		broken := !balances.IsAllGTE(expectedDeposits)
 
 		return sdk.FormatInvariant(types.ModuleName, "deposits",
-			fmt.Sprintf("\tgov ModuleAccount coins: %s\n\tsum of deposit amounts:  %s\n",
+			fmt.Sprintf("\tgov ModuleAccount coins: %s\n\tsum of deposit amounts and bounty pool:  %s\n",
 				balances, expectedDeposits)), broken
 	}
 }
@@ -17,6 +17,8 @@ import (
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
 
+var _ v1.GovReadKeeper = Keeper{}
+
 // Keeper defines the governance module Keeper
 type Keeper struct {
 	authKeeper types.AccountKeeper
@@ -40,13 +42,28 @@ type Keeper struct {
 	// Msg server router
 	router *baseapp.MsgServiceRouter
 
+	// Registry of the governance-adjustable params
+	paramsRegistry types.ParamsRegistry
+
+	// Treasury keeper, accounting for the community pool spend of the treasury
+	// messages
+	treasuryKeeper types.TreasuryKeeper
+
 	config types.Config
 
+	// cache of the decoded params, shared by the copies of the keeper
+	paramsCache *paramsCache
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
 }
 
+// GetConfig returns the x/gov module's config.
+func (k Keeper) GetConfig() types.Config {
+	return k.config
+}
+
 // GetAuthority returns the x/gov module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -79,14 +96,15 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		storeKey:   key,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		sk:         sk,
-		cdc:        cdc,
-		router:     router,
-		config:     config,
-		authority:  authority,
+		storeKey:    key,
+		authKeeper:  authKeeper,
+		bankKeeper:  bankKeeper,
+		sk:          sk,
+		cdc:         cdc,
+		router:      router,
+		config:      config,
+		paramsCache: &paramsCache{},
+		authority:   authority,
 	}
 }
 
@@ -120,6 +138,22 @@ func (keeper *Keeper) SetLegacyRouter(router v1beta1.Router) {
 	keeper.legacyRouter = router
 }
 
+// SetParamsRegistry sets the registry the params update messages of the
+// proposals are validated against.
+func (keeper *Keeper) SetParamsRegistry(registry types.ParamsRegistry) {
+	// Like the legacy router, the registry is sealed so that no validator can
+	// be registered after the keeper is created.
+	registry.Seal()
+	keeper.paramsRegistry = registry
+}
+
+// SetTreasuryKeeper sets the treasury keeper the community pool spend of the
+// proposals is computed with. It is set after the keeper is created, as the
+// treasury keeper is created after the governance keeper.
+func (keeper *Keeper) SetTreasuryKeeper(treasuryKeeper types.TreasuryKeeper) {
+	keeper.treasuryKeeper = treasuryKeeper
+}
+
 // Logger returns a module-specific logger.
 func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -135,6 +169,11 @@ func (keeper Keeper) LegacyRouter() v1beta1.Router {
 	return keeper.legacyRouter
 }
 
+// ParamsRegistry returns the gov keeper's params registry, nil if not set
+func (keeper Keeper) ParamsRegistry() types.ParamsRegistry {
+	return keeper.paramsRegistry
+}
+
 // GetGovernanceAccount returns the governance ModuleAccount
 func (keeper Keeper) GetGovernanceAccount(ctx sdk.Context) authtypes.ModuleAccountI {
 	return keeper.authKeeper.GetModuleAccount(ctx, types.ModuleName)
@@ -168,6 +207,32 @@ func (keeper Keeper) RemoveFromInactiveProposalQueue(ctx sdk.Context, proposalID
 	store.Delete(types.InactiveProposalQueueKey(proposalID, endTime))
 }
 
+// InsertCompletedProposalQueue inserts a proposalID into the completed proposal queue at votingEndTime
+func (keeper Keeper) InsertCompletedProposalQueue(ctx sdk.Context, proposalID uint64, votingEndTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.CompletedProposalQueueKey(proposalID, votingEndTime), bz)
+}
+
+// RemoveFromCompletedProposalQueue removes a proposalID from the Completed Proposal Queue
+func (keeper Keeper) RemoveFromCompletedProposalQueue(ctx sdk.Context, proposalID uint64, votingEndTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.CompletedProposalQueueKey(proposalID, votingEndTime))
+}
+
+// InsertReviewProposalQueue inserts a proposalID into the review proposal queue at reviewEndTime
+func (keeper Keeper) InsertReviewProposalQueue(ctx sdk.Context, proposalID uint64, reviewEndTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.ReviewProposalQueueKey(proposalID, reviewEndTime), bz)
+}
+
+// RemoveFromReviewProposalQueue removes a proposalID from the Review Proposal Queue
+func (keeper Keeper) RemoveFromReviewProposalQueue(ctx sdk.Context, proposalID uint64, reviewEndTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.ReviewProposalQueueKey(proposalID, reviewEndTime))
+}
+
 // Iterators
 
 // IterateActiveProposalsQueue iterates over the proposals in the active proposal queue
@@ -208,6 +273,54 @@ func (keeper Keeper) IterateInactiveProposalsQueue(ctx sdk.Context, endTime time
 	}
 }
 
+// IterateCompletedProposalsQueue iterates over the proposals in the completed proposal queue
+// whose voting period ended by votingEndTime and performs a callback function. The entries of
+// the proposals that don't exist anymore are dropped from the queue, instead of halting the chain.
+func (keeper Keeper) IterateCompletedProposalsQueue(ctx sdk.Context, votingEndTime time.Time, cb func(proposal v1.Proposal) (stop bool)) {
+	iterator := keeper.CompletedProposalQueueIterator(ctx, votingEndTime)
+
+	var danglingKeys [][]byte
+	defer func() {
+		iterator.Close()
+		store := ctx.KVStore(keeper.storeKey)
+		for _, key := range danglingKeys {
+			store.Delete(key)
+		}
+	}()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitCompletedProposalQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			keeper.Logger(ctx).Error("completed proposal queue entry of a missing proposal; dropped", "proposal", proposalID)
+			danglingKeys = append(danglingKeys, iterator.Key())
+			continue
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// IterateReviewProposalsQueue iterates over the proposals in the review proposal queue
+// whose review period ended by reviewEndTime and performs a callback function
+func (keeper Keeper) IterateReviewProposalsQueue(ctx sdk.Context, reviewEndTime time.Time, cb func(proposal v1.Proposal) (stop bool)) {
+	iterator := keeper.ReviewProposalQueueIterator(ctx, reviewEndTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitReviewProposalQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
 // ActiveProposalQueueIterator returns an sdk.Iterator for all the proposals in the Active Queue that expire by endTime
 func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
@@ -220,6 +333,18 @@ func (keeper Keeper) InactiveProposalQueueIterator(ctx sdk.Context, endTime time
 	return store.Iterator(types.InactiveProposalQueuePrefix, sdk.PrefixEndBytes(types.InactiveProposalByTimeKey(endTime)))
 }
 
+// CompletedProposalQueueIterator returns an sdk.Iterator for all the proposals in the Completed Queue whose voting period ended by votingEndTime
+func (keeper Keeper) CompletedProposalQueueIterator(ctx sdk.Context, votingEndTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.CompletedProposalQueuePrefix, sdk.PrefixEndBytes(types.CompletedProposalByTimeKey(votingEndTime)))
+}
+
+// ReviewProposalQueueIterator returns an sdk.Iterator for all the proposals in the Review Queue whose review period ended by reviewEndTime
+func (keeper Keeper) ReviewProposalQueueIterator(ctx sdk.Context, reviewEndTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.ReviewProposalQueuePrefix, sdk.PrefixEndBytes(types.ReviewProposalByTimeKey(reviewEndTime)))
+}
+
 // assertMetadataLength returns an error if given metadata length
 // is greater than a pre-defined MaxMetadataLen.
 func (keeper Keeper) assertMetadataLength(metadata string) error {
@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/atomone-hub/atomone/x/gov/exported"
+	"github.com/atomone-hub/atomone/x/gov/migrations/fromsdk"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -17,3 +22,72 @@ func NewMigrator(keeper *Keeper, legacySubspace exported.ParamSubspace) Migrator
 		legacySubspace: legacySubspace,
 	}
 }
+
+// MigrateFromSDK migrates the stock cosmos-sdk v0.47 x/gov state to the
+// AtomOne x/gov state. It is meant to be called from the upgrade handler of a
+// chain replacing the cosmos-sdk x/gov module with this one, under the same
+// store key.
+func (m Migrator) MigrateFromSDK(ctx sdk.Context) error {
+	if err := fromsdk.MigrateStore(ctx, m.keeper.storeKey); err != nil {
+		return err
+	}
+
+	m.rebuildIndexes(ctx)
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5. The store layout is unchanged,
+// the secondary indexes introduced in version 5 are backfilled from the
+// existing proposals and votes.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.rebuildIndexes(ctx)
+	return nil
+}
+
+// rebuildIndexes rebuilds the secondary indexes of the proposals and votes:
+// the proposal status counts, the completed proposal queue, the proposals by
+// proposer, by message type URL, by voting end time and by total deposit and
+// the votes by voter.
+func (m Migrator) rebuildIndexes(ctx sdk.Context) {
+	// the status counts are recomputed from scratch, archived proposals
+	// included.
+	store := ctx.KVStore(m.keeper.storeKey)
+	var countKeys [][]byte
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposalStatusCountKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		countKeys = append(countKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range countKeys {
+		store.Delete(key)
+	}
+
+	var archivedStatuses []v1.ProposalStatus
+	m.keeper.IterateArchivedProposals(ctx, func(archived v1.ArchivedProposal) bool {
+		archivedStatuses = append(archivedStatuses, archived.Status)
+		return false
+	})
+	for _, status := range archivedStatuses {
+		m.keeper.UpdateProposalStatusCount(ctx, v1.StatusNil, status)
+	}
+
+	// the total deposit index entries keyed by a former value are replaced
+	// when the proposals are saved
+	m.keeper.clearProposalsByTotalDeposit(ctx)
+	for _, proposal := range m.keeper.GetProposals(ctx) {
+		switch proposal.Status {
+		case v1.StatusPassed, v1.StatusRejected, v1.StatusFailed:
+			if proposal.VotingEndTime != nil {
+				m.keeper.InsertCompletedProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+			}
+		case v1.StatusQueued:
+			m.keeper.InsertCommunityPoolSpendQueue(ctx, proposal.Id)
+		}
+		m.keeper.SetProposal(ctx, *proposal)
+		m.keeper.UpdateProposalStatusCount(ctx, v1.StatusNil, proposal.Status)
+	}
+
+	for _, vote := range m.keeper.GetAllVotes(ctx) {
+		m.keeper.SetVote(ctx, *vote)
+	}
+}
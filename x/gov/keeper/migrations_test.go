@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestMigrate4to5(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.CreateRandomAccounts(2)
+
+	p1, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], nil, false)
+	require.NoError(t, err)
+	p2, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[1], nil, false)
+	require.NoError(t, err)
+	govKeeper.ActivateVotingPeriod(ctx, p2)
+	govKeeper.SetVote(ctx, v1.NewVote(p2.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	p3, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[1], nil, false)
+	require.NoError(t, err)
+	govKeeper.ActivateVotingPeriod(ctx, p3)
+	p3, _ = govKeeper.GetProposal(ctx, p3.Id)
+	p3.Status = v1.StatusPassed
+	govKeeper.SetProposal(ctx, p3)
+	govKeeper.UpdateProposalStatusCount(ctx, v1.StatusVotingPeriod, v1.StatusPassed)
+	govKeeper.SetArchivedProposal(ctx, v1.ArchivedProposal{Id: 4, Status: v1.StatusRejected})
+	govKeeper.UpdateProposalStatusCount(ctx, v1.StatusNil, v1.StatusRejected)
+	statusCounts := govKeeper.GetProposalStatusCounts(ctx)
+
+	// remove the secondary indexes, as in a version 4 store
+	store := govKeeper.KVStore(ctx)
+	for _, prefix := range [][]byte{
+		types.ProposalStatusCountKeyPrefix,
+		types.ProposalsByProposerKeyPrefix,
+		types.ProposalsByMsgTypeURLKeyPrefix,
+		types.CompletedProposalQueuePrefix,
+		types.VotesByVoterKeyPrefix,
+	} {
+		var keys [][]byte
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	// and replace the total deposit index entry of p1 with a stale one
+	store.Delete(types.ProposalByTotalDepositKey(sdk.ZeroDec(), p1.Id))
+	store.Set(types.ProposalByTotalDepositKey(sdk.NewDec(42), p1.Id), []byte{1})
+
+	m := keeper.NewMigrator(govKeeper, nil)
+	require.NoError(t, m.Migrate4to5(ctx))
+
+	require.Equal(t, statusCounts, govKeeper.GetProposalStatusCounts(ctx))
+	require.True(t, store.Has(types.ProposalByProposerKey(addrs[0], p1.Id)))
+	require.True(t, store.Has(types.ProposalByProposerKey(addrs[1], p2.Id)))
+	require.True(t, store.Has(types.ProposalByMsgTypeURLKey(sdk.MsgTypeURL(TestProposal[0]), p1.Id)))
+	require.True(t, store.Has(types.VoteByVoterKey(addrs[0], p2.Id)))
+	require.True(t, store.Has(types.ProposalByTotalDepositKey(sdk.ZeroDec(), p1.Id)))
+	require.False(t, store.Has(types.ProposalByTotalDepositKey(sdk.NewDec(42), p1.Id)))
+
+	var completed []uint64
+	govKeeper.IterateCompletedProposalsQueue(ctx, p3.VotingEndTime.Add(time.Second), func(proposal v1.Proposal) bool {
+		completed = append(completed, proposal.Id)
+		return false
+	})
+	require.Equal(t, []uint64{p3.Id}, completed)
+
+	// the migration can be run again without double counting
+	require.NoError(t, m.Migrate4to5(ctx))
+	require.Equal(t, statusCounts, govKeeper.GetProposalStatusCounts(ctx))
+}
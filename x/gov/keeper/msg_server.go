@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/errors"
 
@@ -45,7 +47,22 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	if msg.VotingPeriod != nil {
+		if err := k.validateVotingPeriod(ctx, *msg.VotingPeriod); err != nil {
+			return nil, err
+		}
+	}
+
+	params := k.GetParams(ctx)
+	if err := v1.ValidateMetadata(params.ProposalMetadataSchema, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	if msg.Quadratic && !params.QuadraticVotingEnabled {
+		return nil, errors.Wrap(govtypes.ErrInvalidProposalType, "quadratic proposals are disabled")
+	}
+
+	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.VotingPeriod, msg.Quadratic)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +96,21 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 	}, nil
 }
 
+// validateVotingPeriod checks that the voting period requested by a proposer
+// is within the min_voting_period and max_voting_period params.
+func (k msgServer) validateVotingPeriod(ctx sdk.Context, votingPeriod time.Duration) error {
+	params := k.GetParams(ctx)
+	if params.MaxVotingPeriod == nil || *params.MaxVotingPeriod <= 0 {
+		return errors.Wrap(govtypes.ErrInvalidVotingPeriod, "requested voting periods are disabled")
+	}
+	if votingPeriod < *params.MinVotingPeriod || votingPeriod > *params.MaxVotingPeriod {
+		return errors.Wrapf(govtypes.ErrInvalidVotingPeriod, "voting period %s is not within [%s, %s]",
+			votingPeriod, params.MinVotingPeriod, params.MaxVotingPeriod)
+	}
+
+	return nil
+}
+
 // ExecLegacyContent implements the MsgServer.ExecLegacyContent method.
 func (k msgServer) ExecLegacyContent(goCtx context.Context, msg *v1.MsgExecLegacyContent) (*v1.MsgExecLegacyContentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -113,7 +145,7 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 	if err != nil {
 		return nil, err
 	}
-	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata)
+	err = k.Keeper.AddLockedVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata, msg.LockPeriods)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +160,7 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 	if accErr != nil {
 		return nil, accErr
 	}
-	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
+	err := k.Keeper.AddLockedVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata, msg.LockPeriods)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +175,14 @@ func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDe
 	if err != nil {
 		return nil, err
 	}
-	votingStarted, err := k.Keeper.AddDeposit(ctx, msg.ProposalId, accAddr, msg.Amount)
+	var refundAddr sdk.AccAddress
+	if msg.RefundAddress != "" {
+		refundAddr, err = sdk.AccAddressFromBech32(msg.RefundAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+	votingStarted, err := k.Keeper.AddDepositWithRefundAddress(ctx, msg.ProposalId, accAddr, msg.Amount, refundAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +213,63 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// ProposeConstitutionAmendment implements the MsgServer.ProposeConstitutionAmendment method.
+func (k msgServer) ProposeConstitutionAmendment(goCtx context.Context, msg *v1.MsgProposeConstitutionAmendment) (*v1.MsgProposeConstitutionAmendmentResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetConstitution(ctx, msg.Constitution)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(govtypes.EventTypeConstitutionAmendment,
+			sdk.NewAttribute(govtypes.AttributeKeyConstitutionHash, fmt.Sprintf("%X", sha256.Sum256([]byte(msg.Constitution)))),
+		),
+	)
+
+	return &v1.MsgProposeConstitutionAmendmentResponse{}, nil
+}
+
+// ScheduleRecurringProposal implements the MsgServer.ScheduleRecurringProposal method.
+func (k msgServer) ScheduleRecurringProposal(goCtx context.Context, msg *v1.MsgScheduleRecurringProposal) (*v1.MsgScheduleRecurringProposalResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	messages, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v1.ValidateMetadata(k.GetParams(ctx).ProposalMetadataSchema, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	recurring, err := k.Keeper.ScheduleRecurringProposal(ctx, messages, msg.Metadata, msg.Title, msg.Summary, *msg.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgScheduleRecurringProposalResponse{RecurringProposalId: recurring.Id}, nil
+}
+
+// CancelRecurringProposal implements the MsgServer.CancelRecurringProposal method.
+func (k msgServer) CancelRecurringProposal(goCtx context.Context, msg *v1.MsgCancelRecurringProposal) (*v1.MsgCancelRecurringProposalResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.CancelRecurringProposal(ctx, msg.RecurringProposalId); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelRecurringProposalResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
@@ -1123,3 +1124,204 @@ func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSubmitProposal_VotingPeriod() {
+	testcases := map[string]struct {
+		minVotingPeriod time.Duration
+		maxVotingPeriod time.Duration
+		votingPeriod    time.Duration
+
+		expErrMsg string
+	}{
+		"requested voting periods disabled - error": {
+			votingPeriod: time.Hour,
+			expErrMsg:    "requested voting periods are disabled",
+		},
+		"voting period below min - error": {
+			minVotingPeriod: 2 * time.Hour,
+			maxVotingPeriod: 4 * time.Hour,
+			votingPeriod:    time.Hour,
+			expErrMsg:       "is not within",
+		},
+		"voting period above max - error": {
+			minVotingPeriod: 2 * time.Hour,
+			maxVotingPeriod: 4 * time.Hour,
+			votingPeriod:    5 * time.Hour,
+			expErrMsg:       "is not within",
+		},
+		"voting period within bounds - success": {
+			minVotingPeriod: 2 * time.Hour,
+			maxVotingPeriod: 4 * time.Hour,
+			votingPeriod:    3 * time.Hour,
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.reset()
+			govKeeper, ctx := suite.govKeeper, suite.ctx
+			proposer := suite.addrs[0]
+
+			params := govKeeper.GetParams(ctx)
+			params.MinVotingPeriod = &tc.minVotingPeriod
+			params.MaxVotingPeriod = &tc.maxVotingPeriod
+			suite.Require().NoError(govKeeper.SetParams(ctx, params))
+
+			msg, err := v1.NewMsgSubmitProposal(TestProposal, params.MinDeposit, proposer.String(), "", "Proposal", "description of proposal")
+			suite.Require().NoError(err)
+			msg.VotingPeriod = &tc.votingPeriod
+
+			res, err := suite.msgSrvr.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			proposal, found := govKeeper.GetProposal(ctx, res.ProposalId)
+			suite.Require().True(found)
+			suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+			suite.Require().Equal(tc.votingPeriod, *proposal.VotingPeriod)
+			suite.Require().Equal(ctx.BlockTime().Add(tc.votingPeriod), *proposal.VotingEndTime)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposal_MetadataSchema() {
+	suite.reset()
+	govKeeper, ctx := suite.govKeeper, suite.ctx
+	proposer := suite.addrs[0]
+
+	params := govKeeper.GetParams(ctx)
+	params.ProposalMetadataSchema = `{"type": "object", "required": ["title", "summary"]}`
+	suite.Require().NoError(govKeeper.SetParams(ctx, params))
+
+	testcases := map[string]struct {
+		metadata string
+		expErr   bool
+	}{
+		"empty metadata - success": {
+			metadata: "",
+		},
+		"valid metadata - success": {
+			metadata: `{"title": "Proposal", "summary": "description of proposal"}`,
+		},
+		"metadata not json - error": {
+			metadata: "ipfs://CID",
+			expErr:   true,
+		},
+		"metadata missing summary - error": {
+			metadata: `{"title": "Proposal"}`,
+			expErr:   true,
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			msg, err := v1.NewMsgSubmitProposal(TestProposal, params.MinDeposit, proposer.String(), tc.metadata, "Proposal", "description of proposal")
+			suite.Require().NoError(err)
+
+			_, err = suite.msgSrvr.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrInvalidMetadata)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposal_Quadratic() {
+	testcases := map[string]struct {
+		quadraticVotingEnabled bool
+		quadratic              bool
+
+		expErr bool
+	}{
+		"quadratic proposals disabled - error": {
+			quadratic: true,
+			expErr:    true,
+		},
+		"quadratic proposals enabled - success": {
+			quadraticVotingEnabled: true,
+			quadratic:              true,
+		},
+		"stake proposal - success": {
+			quadraticVotingEnabled: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.reset()
+			govKeeper, ctx := suite.govKeeper, suite.ctx
+
+			params := govKeeper.GetParams(ctx)
+			params.QuadraticVotingEnabled = tc.quadraticVotingEnabled
+			suite.Require().NoError(govKeeper.SetParams(ctx, params))
+
+			msg, err := v1.NewMsgSubmitProposal(TestProposal, params.MinDeposit, suite.addrs[0].String(), "", "Proposal", "description of proposal")
+			suite.Require().NoError(err)
+			msg.Quadratic = tc.quadratic
+
+			res, err := suite.msgSrvr.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr {
+				suite.Require().ErrorIs(err, types.ErrInvalidProposalType)
+				return
+			}
+			suite.Require().NoError(err)
+
+			proposal, found := govKeeper.GetProposal(ctx, res.ProposalId)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.quadratic, proposal.Quadratic)
+
+			_, found = govKeeper.GetQuadraticTallyResult(ctx, res.ProposalId)
+			suite.Require().Equal(tc.quadratic, found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgProposeConstitutionAmendment() {
+	authority := suite.govKeeper.GetAuthority()
+	testCases := []struct {
+		name      string
+		input     *v1.MsgProposeConstitutionAmendment
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			input: &v1.MsgProposeConstitutionAmendment{
+				Authority:    authority,
+				Constitution: "Amended constitution",
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: &v1.MsgProposeConstitutionAmendment{
+				Authority:    "authority",
+				Constitution: "Amended constitution",
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.ProposeConstitutionAmendment(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.input.Constitution, suite.govKeeper.GetConstitution(suite.ctx))
+
+			events := suite.ctx.EventManager().Events()
+			suite.Require().Equal(types.EventTypeConstitutionAmendment, events[len(events)-1].Type)
+		})
+	}
+}
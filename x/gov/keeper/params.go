@@ -1,25 +1,138 @@
 package keeper
 
 import (
+	"bytes"
+	"sync"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
-// SetParams sets the gov module's parameters.
+// paramsCache holds the last params decoded from the store along with their
+// encoding, so that GetParams only unmarshals them when they change.
+//
+// The params are still read from the store on every call: this keeps the gas
+// consumption identical whether the cache is warm or not, and makes writes
+// discarded with a cached context or done by another context irrelevant.
+type paramsCache struct {
+	mtx    sync.RWMutex
+	bz     []byte
+	params v1.Params
+}
+
+// get returns a copy of the cached params if they were decoded from bz.
+func (c *paramsCache) get(bz []byte) (v1.Params, bool) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+
+	if c.bz == nil || !bytes.Equal(c.bz, bz) {
+		return v1.Params{}, false
+	}
+	return cloneParams(c.params), true
+}
+
+// set caches params, decoded from bz.
+func (c *paramsCache) set(bz []byte, params v1.Params) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.bz = append([]byte(nil), bz...)
+	c.params = cloneParams(params)
+}
+
+// cloneParams returns a copy of params not sharing any memory with it, so
+// that callers can't alter the cached params. proto.Clone can't be used, as it
+// panics on the math.Int custom type of the coins. The pointer and slice
+// fields are covered by TestGetParamsCacheNoAliasing, which fails on a new
+// field not copied here.
+func cloneParams(params v1.Params) v1.Params {
+	clone := params
+	clone.MinDeposit = append(sdk.Coins(nil), params.MinDeposit...)
+	clone.ProposerBounty = append(sdk.Coins(nil), params.ProposerBounty...)
+	clone.CommunityPoolSpendLimit = append(sdk.Coins(nil), params.CommunityPoolSpendLimit...)
+	if params.MaxDepositPeriod != nil {
+		maxDepositPeriod := *params.MaxDepositPeriod
+		clone.MaxDepositPeriod = &maxDepositPeriod
+	}
+	if params.VotingPeriod != nil {
+		votingPeriod := *params.VotingPeriod
+		clone.VotingPeriod = &votingPeriod
+	}
+	if params.ProposalRetentionPeriod != nil {
+		proposalRetentionPeriod := *params.ProposalRetentionPeriod
+		clone.ProposalRetentionPeriod = &proposalRetentionPeriod
+	}
+	if params.CommunityPoolSpendPeriod != nil {
+		communityPoolSpendPeriod := *params.CommunityPoolSpendPeriod
+		clone.CommunityPoolSpendPeriod = &communityPoolSpendPeriod
+	}
+	if params.MinVotingPeriod != nil {
+		minVotingPeriod := *params.MinVotingPeriod
+		clone.MinVotingPeriod = &minVotingPeriod
+	}
+	if params.MaxVotingPeriod != nil {
+		maxVotingPeriod := *params.MaxVotingPeriod
+		clone.MaxVotingPeriod = &maxVotingPeriod
+	}
+	if params.ReviewPeriod != nil {
+		reviewPeriod := *params.ReviewPeriod
+		clone.ReviewPeriod = &reviewPeriod
+	}
+	if params.MessageReviewPeriods != nil {
+		clone.MessageReviewPeriods = make([]*v1.MessageReviewPeriod, len(params.MessageReviewPeriods))
+		for i, p := range params.MessageReviewPeriods {
+			messageReviewPeriod := *p
+			if p.ReviewPeriod != nil {
+				reviewPeriod := *p.ReviewPeriod
+				messageReviewPeriod.ReviewPeriod = &reviewPeriod
+			}
+			clone.MessageReviewPeriods[i] = &messageReviewPeriod
+		}
+	}
+	if params.DepositDenomWeights != nil {
+		clone.DepositDenomWeights = make([]*v1.DepositDenomWeight, len(params.DepositDenomWeights))
+		for i, p := range params.DepositDenomWeights {
+			depositDenomWeight := *p
+			clone.DepositDenomWeights[i] = &depositDenomWeight
+		}
+	}
+	return clone
+}
+
+// SetParams sets the gov module's parameters. The proposals are reindexed by
+// total deposit in the next EndBlockers when the deposit denom weights change.
 func (k Keeper) SetParams(ctx sdk.Context, params v1.Params) error {
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
 		return err
 	}
+	oldWeights := k.GetParams(ctx).DepositDenomWeights
 	store.Set(types.ParamsKey, bz)
 
+	if !depositDenomWeightsEqual(oldWeights, params.DepositDenomWeights) {
+		k.startTotalDepositReindex(ctx)
+	}
 	return nil
 }
 
-// GetParams gets the gov module's parameters.
+// depositDenomWeightsEqual returns true if a and b hold the same weights.
+func depositDenomWeightsEqual(a, b []*v1.DepositDenomWeight) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if *a[i] != *b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// GetParams gets the gov module's parameters. The decoded params are cached
+// until they change, as they are read several times per block.
 func (k Keeper) GetParams(clientCtx sdk.Context) (params v1.Params) {
 	store := clientCtx.KVStore(k.storeKey)
 	bz := store.Get(types.ParamsKey)
@@ -27,6 +140,11 @@ func (k Keeper) GetParams(clientCtx sdk.Context) (params v1.Params) {
 		return params
 	}
 
+	if params, ok := k.paramsCache.get(bz); ok {
+		return params
+	}
+
 	k.cdc.MustUnmarshal(bz, &params)
+	k.paramsCache.set(bz, params)
 	return params
 }
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"reflect"
+	"time"
+
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestGetParamsCache() {
+	ctx, _ := suite.ctx.CacheContext()
+	suite.Require().NoError(suite.govKeeper.SetParams(ctx, v1.DefaultParams()))
+
+	// altering the returned params must not alter the cached ones
+	params := suite.govKeeper.GetParams(ctx)
+	*params.VotingPeriod = time.Hour
+	params.MinDeposit[0].Denom = "altered"
+	suite.Require().Equal(v1.DefaultParams(), suite.govKeeper.GetParams(ctx))
+
+	// updated params are returned from the next call
+	params = v1.DefaultParams()
+	*params.VotingPeriod = time.Hour
+	suite.Require().NoError(suite.govKeeper.SetParams(ctx, params))
+	suite.Require().Equal(params, suite.govKeeper.GetParams(ctx))
+
+	// writes discarded with a cached context are not visible
+	cacheCtx, _ := ctx.CacheContext()
+	discarded := v1.DefaultParams()
+	discarded.Quorum = "0.5"
+	suite.Require().NoError(suite.govKeeper.SetParams(cacheCtx, discarded))
+	suite.Require().Equal(discarded, suite.govKeeper.GetParams(cacheCtx))
+	suite.Require().Equal(params, suite.govKeeper.GetParams(ctx))
+}
+
+// TestGetParamsCacheNoAliasing checks that the params returned by GetParams
+// share no memory, whatever the field. It fails on a new pointer or slice
+// field of the params that is left unset below, or that cloneParams doesn't
+// copy.
+func (suite *KeeperTestSuite) TestGetParamsCacheNoAliasing() {
+	ctx, _ := suite.ctx.CacheContext()
+	params := v1.DefaultParams()
+	period := time.Hour
+	params.ProposalRetentionPeriod = &period
+	params.CommunityPoolSpendPeriod = &period
+	params.ReviewPeriod = &period
+	params.MessageReviewPeriods = []*v1.MessageReviewPeriod{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", ReviewPeriod: &period}}
+	params.ProposerBounty = params.MinDeposit
+	params.CommunityPoolSpendLimit = params.MinDeposit
+	params.DepositDenomWeights = []*v1.DepositDenomWeight{{Denom: params.MinDeposit[0].Denom, Weight: "1"}}
+	suite.Require().NoError(suite.govKeeper.SetParams(ctx, params))
+
+	params1 := suite.govKeeper.GetParams(ctx)
+	params2 := suite.govKeeper.GetParams(ctx)
+	v1Params, v2Params := reflect.ValueOf(params1), reflect.ValueOf(params2)
+	for i := 0; i < v1Params.NumField(); i++ {
+		name := v1Params.Type().Field(i).Name
+		f1, f2 := v1Params.Field(i), v2Params.Field(i)
+		switch f1.Kind() {
+		case reflect.Ptr, reflect.Slice:
+			suite.Require().False(f1.IsNil(), "%s is not set in the test params", name)
+			suite.Require().NotEqual(f1.Pointer(), f2.Pointer(), "%s is shared between the returned params", name)
+			if f1.Kind() == reflect.Slice && f1.Type().Elem().Kind() == reflect.Ptr {
+				suite.Require().NotEqual(f1.Index(0).Pointer(), f2.Index(0).Pointer(), "%s elements are shared between the returned params", name)
+			}
+		}
+	}
+}
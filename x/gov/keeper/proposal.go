@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 
@@ -13,8 +14,13 @@ import (
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
-// SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+// SubmitProposal creates a new proposal given an array of messages. The
+// votingPeriod, applied when the voting period starts, overrides the
+// voting_period param if not nil, and quadratic selects the quadratic tally.
+func (keeper Keeper) SubmitProposal(
+	ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress,
+	votingPeriod *time.Duration, quadratic bool,
+) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -33,6 +39,60 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	}
 
 	// Will hold a comma-separated string of all Msg type URLs.
+	msgsStr, err := keeper.validateProposalMsgs(ctx, messages)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	// limit the number of proposals a proposer has in the deposit period at
+	// the same time.
+	if maxProposals := keeper.GetParams(ctx).MaxDepositPeriodProposalsPerProposer; maxProposals > 0 &&
+		keeper.CountDepositPeriodProposalsByProposer(ctx, proposer, maxProposals) >= maxProposals {
+		return v1.Proposal{}, sdkerrors.Wrapf(types.ErrTooManyProposals, "proposer %s has reached the limit of %d", proposer, maxProposals)
+	}
+
+	proposalID, err := keeper.GetProposalID(ctx)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	submitTime := ctx.BlockHeader().Time
+	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
+
+	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+	if votingPeriod != nil {
+		period := *votingPeriod
+		proposal.VotingPeriod = &period
+	}
+	proposal.Quadratic = quadratic
+
+	keeper.SetProposal(ctx, proposal)
+	keeper.UpdateProposalStatusCount(ctx, v1.StatusNil, proposal.Status)
+	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
+	keeper.SetProposalID(ctx, proposalID+1)
+
+	// called right after a proposal is submitted
+	keeper.Hooks().AfterProposalSubmission(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+		),
+	)
+
+	return proposal, nil
+}
+
+// validateProposalMsgs validates the messages of a proposal: each message must
+// pass its basic validation, have the governance module account as only
+// signer and a route in the msg service router. It returns a comma-separated
+// string of the message type URLs.
+func (keeper Keeper) validateProposalMsgs(ctx sdk.Context, messages []sdk.Msg) (string, error) {
 	msgsStr := ""
 
 	// Loop through all messages and confirm that each has a handler and the gov module account
@@ -42,23 +102,31 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 
 		// perform a basic validation of the message
 		if err := msg.ValidateBasic(); err != nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
+			return "", sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
 		}
 
 		signers := msg.GetSigners()
 		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
+			return "", types.ErrInvalidSigner
 		}
 
 		// assert that the governance module account is the only signer of the messages
 		if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
+			return "", sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
 		}
 
 		// use the msg service router to see that there is a valid route for that message.
 		handler := keeper.router.Handler(msg)
 		if handler == nil {
-			return v1.Proposal{}, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+			return "", sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+		}
+
+		// params updates must be allowed by the params registry, so that
+		// invalid changes are rejected before entering the voting period.
+		if keeper.paramsRegistry != nil {
+			if err := keeper.paramsRegistry.Validate(ctx, msg); err != nil {
+				return "", sdkerrors.Wrapf(types.ErrInvalidParamsUpdate, "%s: %s", sdk.MsgTypeURL(msg), err)
+			}
 		}
 
 		// Only if it's a MsgExecLegacyContent do we try to execute the
@@ -70,43 +138,15 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 			cacheCtx, _ := ctx.CacheContext()
 			if _, err := handler(cacheCtx, msg); err != nil {
 				if errors.Is(err, types.ErrNoProposalHandlerExists) {
-					return v1.Proposal{}, err
+					return "", err
 				}
-				return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+				return "", sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
 			}
 		}
 
 	}
 
-	proposalID, err := keeper.GetProposalID(ctx)
-	if err != nil {
-		return v1.Proposal{}, err
-	}
-
-	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
-
-	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
-	if err != nil {
-		return v1.Proposal{}, err
-	}
-
-	keeper.SetProposal(ctx, proposal)
-	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
-	keeper.SetProposalID(ctx, proposalID+1)
-
-	// called right after a proposal is submitted
-	keeper.Hooks().AfterProposalSubmission(ctx, proposalID)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSubmitProposal,
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
-			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
-		),
-	)
-
-	return proposal, nil
+	return msgsStr, nil
 }
 
 // GetProposal gets a proposal from store by ProposalID.
@@ -143,6 +183,22 @@ func (keeper Keeper) SetProposal(ctx sdk.Context, proposal v1.Proposal) {
 		store.Delete(types.VotingPeriodProposalKey(proposal.Id))
 	}
 
+	if proposer, err := sdk.AccAddressFromBech32(proposal.Proposer); err == nil {
+		store.Set(types.ProposalByProposerKey(proposer, proposal.Id), []byte{1})
+		if proposal.Status == v1.StatusDepositPeriod {
+			store.Set(types.DepositPeriodProposalByProposerKey(proposer, proposal.Id), []byte{1})
+		} else {
+			store.Delete(types.DepositPeriodProposalByProposerKey(proposer, proposal.Id))
+		}
+	}
+	for _, msg := range proposal.Messages {
+		store.Set(types.ProposalByMsgTypeURLKey(msg.TypeUrl, proposal.Id), []byte{1})
+	}
+	if proposal.VotingEndTime != nil {
+		store.Set(types.ProposalByVotingEndTimeKey(*proposal.VotingEndTime, proposal.Id), []byte{1})
+	}
+	keeper.setProposalByTotalDeposit(ctx, proposal)
+
 	store.Set(types.ProposalKey(proposal.Id), bz)
 }
 
@@ -158,12 +214,142 @@ func (keeper Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
 	if proposal.DepositEndTime != nil {
 		keeper.RemoveFromInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
 	}
+	if proposal.ReviewEndTime != nil {
+		keeper.RemoveFromReviewProposalQueue(ctx, proposalID, *proposal.ReviewEndTime)
+	}
 	if proposal.VotingEndTime != nil {
 		keeper.RemoveFromActiveProposalQueue(ctx, proposalID, *proposal.VotingEndTime)
+		keeper.RemoveFromCompletedProposalQueue(ctx, proposalID, *proposal.VotingEndTime)
 		store.Delete(types.VotingPeriodProposalKey(proposalID))
 	}
 
-	store.Delete(types.ProposalKey(proposalID))
+	keeper.deleteProposal(ctx, proposal)
+	keeper.UpdateProposalStatusCount(ctx, proposal.Status, v1.StatusNil)
+}
+
+// deleteProposal deletes a proposal and its index entries from the store.
+func (keeper Keeper) deleteProposal(ctx sdk.Context, proposal v1.Proposal) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	if proposer, err := sdk.AccAddressFromBech32(proposal.Proposer); err == nil {
+		store.Delete(types.ProposalByProposerKey(proposer, proposal.Id))
+		store.Delete(types.DepositPeriodProposalByProposerKey(proposer, proposal.Id))
+	}
+	for _, msg := range proposal.Messages {
+		store.Delete(types.ProposalByMsgTypeURLKey(msg.TypeUrl, proposal.Id))
+	}
+	if proposal.VotingEndTime != nil {
+		store.Delete(types.ProposalByVotingEndTimeKey(*proposal.VotingEndTime, proposal.Id))
+	}
+	keeper.deleteProposalByTotalDeposit(ctx, proposal.Id)
+
+	store.Delete(types.ProposalKey(proposal.Id))
+}
+
+// proposalByTotalDepositKey returns the proposals by total deposit index key
+// of the proposal, indexed by the deposit value of its total deposit, or by
+// its bond denom amount without deposit denom weights.
+func (keeper Keeper) proposalByTotalDepositKey(ctx sdk.Context, proposal v1.Proposal) []byte {
+	totalDeposit := sdk.NewCoins(proposal.TotalDeposit...)
+	params := keeper.GetParams(ctx)
+	if len(params.DepositDenomWeights) == 0 {
+		value := sdk.NewDecFromInt(totalDeposit.AmountOf(keeper.sk.BondDenom(ctx)))
+		return types.ProposalByTotalDepositKey(value, proposal.Id)
+	}
+	return types.ProposalByTotalDepositKey(params.DepositValue(totalDeposit), proposal.Id)
+}
+
+// setProposalByTotalDeposit replaces the total deposit index entry of the
+// proposal with one keyed by its current deposit value.
+func (keeper Keeper) setProposalByTotalDeposit(ctx sdk.Context, proposal v1.Proposal) {
+	keeper.deleteProposalByTotalDeposit(ctx, proposal.Id)
+
+	store := ctx.KVStore(keeper.storeKey)
+	key := keeper.proposalByTotalDepositKey(ctx, proposal)
+	store.Set(key, []byte{1})
+	store.Set(types.TotalDepositIndexEntryKey(proposal.Id), key)
+}
+
+// deleteProposalByTotalDeposit deletes the total deposit index entry of the
+// proposal, whichever deposit denom weights it was keyed with.
+func (keeper Keeper) deleteProposalByTotalDeposit(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	if key := store.Get(types.TotalDepositIndexEntryKey(proposalID)); key != nil {
+		store.Delete(key)
+		store.Delete(types.TotalDepositIndexEntryKey(proposalID))
+	}
+}
+
+// clearProposalsByTotalDeposit deletes the whole total deposit index.
+func (keeper Keeper) clearProposalsByTotalDeposit(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, prefix := range [][]byte{types.ProposalsByTotalDepositKeyPrefix, types.TotalDepositIndexEntriesPrefix} {
+		var keys [][]byte
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	store.Delete(types.TotalDepositReindexKey)
+}
+
+// startTotalDepositReindex schedules the reindexing of every proposal by total
+// deposit, from the first one, as the index keys depend on the deposit denom
+// weights.
+func (keeper Keeper) startTotalDepositReindex(ctx sdk.Context) {
+	ctx.KVStore(keeper.storeKey).Set(types.TotalDepositReindexKey, types.GetProposalIDBytes(0))
+}
+
+// ReindexProposalsByTotalDeposit reindexes by total deposit up to max of the
+// proposals left to reindex after a change of the deposit denom weights, and
+// returns the number of proposals reindexed. Until all of them are, the
+// proposals ordered by total deposit may be ordered by former weights.
+func (keeper Keeper) ReindexProposalsByTotalDeposit(ctx sdk.Context, max uint64) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.TotalDepositReindexKey)
+	if bz == nil || max == 0 {
+		return 0
+	}
+
+	var proposals []v1.Proposal
+	iterator := store.Iterator(types.ProposalKey(types.GetProposalIDFromBytes(bz)), sdk.PrefixEndBytes(types.ProposalsKeyPrefix))
+	for ; iterator.Valid() && uint64(len(proposals)) < max; iterator.Next() {
+		var proposal v1.Proposal
+		if err := keeper.UnmarshalProposal(iterator.Value(), &proposal); err != nil {
+			panic(err)
+		}
+		proposals = append(proposals, proposal)
+	}
+	done := !iterator.Valid()
+	iterator.Close()
+
+	for _, proposal := range proposals {
+		keeper.setProposalByTotalDeposit(ctx, proposal)
+	}
+	if done {
+		store.Delete(types.TotalDepositReindexKey)
+	} else {
+		store.Set(types.TotalDepositReindexKey, types.GetProposalIDBytes(proposals[len(proposals)-1].Id+1))
+	}
+	return uint64(len(proposals))
+}
+
+// CountDepositPeriodProposalsByProposer returns the number of proposals of the
+// proposer in the deposit period, counting up to max.
+func (keeper Keeper) CountDepositPeriodProposalsByProposer(ctx sdk.Context, proposer sdk.AccAddress, max uint64) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DepositPeriodProposalsByProposerKey(proposer))
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid() && count < max; iterator.Next() {
+		count++
+	}
+	return count
 }
 
 // IterateProposals iterates over all the proposals and performs a callback function.
@@ -219,7 +405,7 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, params v1.QueryPropos
 
 		// match voter address (if supplied)
 		if len(params.Voter) > 0 {
-			_, matchVoter = keeper.GetVote(ctx, p.Id, params.Voter)
+			matchVoter = keeper.HasVoted(ctx, p.Id, params.Voter)
 		}
 
 		// match depositor (if supplied)
@@ -262,18 +448,73 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 // ActivateVotingPeriod activates the voting period of a proposal
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
+	fromStatus := proposal.Status
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
 	votingPeriod := keeper.GetParams(ctx).VotingPeriod
+	if proposal.VotingPeriod != nil {
+		votingPeriod = proposal.VotingPeriod
+	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
+	keeper.UpdateProposalStatusCount(ctx, proposal.Status, v1.StatusVotingPeriod)
 	proposal.Status = v1.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
 
-	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+	if fromStatus == v1.StatusReviewPeriod {
+		keeper.RemoveFromReviewProposalQueue(ctx, proposal.Id, *proposal.ReviewEndTime)
+	} else {
+		keeper.RemoveFromInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+	}
 	keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 }
 
+// ActivateReviewPeriod moves a proposal which reached its minimum deposit to
+// the review period, during which it can't receive deposits nor votes. Its
+// voting period is activated by the EndBlocker once the review period ends.
+func (keeper Keeper) ActivateReviewPeriod(ctx sdk.Context, proposal v1.Proposal, reviewPeriod time.Duration) {
+	endTime := ctx.BlockHeader().Time.Add(reviewPeriod)
+	proposal.ReviewEndTime = &endTime
+	keeper.UpdateProposalStatusCount(ctx, proposal.Status, v1.StatusReviewPeriod)
+	proposal.Status = v1.StatusReviewPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	keeper.RemoveFromInactiveProposalQueue(ctx, proposal.Id, *proposal.DepositEndTime)
+	keeper.InsertReviewProposalQueue(ctx, proposal.Id, *proposal.ReviewEndTime)
+}
+
+// ProposalReviewPeriod returns the review period of a proposal, which is the
+// longest one among its messages. The review_period param applies to the
+// messages without a message review period, and to the proposals without
+// messages.
+func (keeper Keeper) ProposalReviewPeriod(ctx sdk.Context, proposal v1.Proposal) time.Duration {
+	params := keeper.GetParams(ctx)
+	var defaultPeriod time.Duration
+	if params.ReviewPeriod != nil {
+		defaultPeriod = *params.ReviewPeriod
+	}
+	if len(proposal.Messages) == 0 {
+		return defaultPeriod
+	}
+
+	messagePeriods := make(map[string]time.Duration, len(params.MessageReviewPeriods))
+	for _, messageReviewPeriod := range params.MessageReviewPeriods {
+		messagePeriods[messageReviewPeriod.MsgTypeUrl] = *messageReviewPeriod.ReviewPeriod
+	}
+
+	var reviewPeriod time.Duration
+	for _, msg := range proposal.Messages {
+		period, ok := messagePeriods[msg.TypeUrl]
+		if !ok {
+			period = defaultPeriod
+		}
+		if period > reviewPeriod {
+			reviewPeriod = period
+		}
+	}
+	return reviewPeriod
+}
+
 // MarshalProposal marshals the proposal and returns binary encoded bytes.
 func (keeper Keeper) MarshalProposal(proposal v1.Proposal) ([]byte, error) {
 	bz, err := keeper.cdc.Marshal(&proposal)
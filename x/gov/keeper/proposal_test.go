@@ -19,7 +19,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.govKeeper.SetProposal(suite.ctx, proposal)
@@ -37,7 +37,7 @@ func (suite *KeeperTestSuite) TestDeleteProposal() {
 		},
 	)
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.govKeeper.SetProposal(suite.ctx, proposal)
@@ -48,7 +48,7 @@ func (suite *KeeperTestSuite) TestDeleteProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -75,7 +75,7 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 func (suite *KeeperTestSuite) TestDeleteProposalInVotingPeriod() {
 	suite.reset()
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 	suite.Require().NoError(err)
 	suite.Require().Nil(proposal.VotingStartTime)
 
@@ -138,11 +138,23 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, "title", "", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+		_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, "title", "", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalVotingPeriodAndQuadratic() {
+	votingPeriod := 3 * time.Hour
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "title", "summary", suite.addrs[0], &votingPeriod, true)
+	suite.Require().NoError(err)
+	suite.Require().Equal(votingPeriod, *proposal.VotingPeriod)
+	suite.Require().True(proposal.Quadratic)
+
+	stored, found := suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(proposal, stored)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []v1.ProposalStatus{v1.StatusDepositPeriod, v1.StatusVotingPeriod}
@@ -209,3 +221,217 @@ func TestMigrateProposalMessages(t *testing.T) {
 	require.Equal(t, "Test", content.GetTitle())
 	require.Equal(t, "description", content.GetDescription())
 }
+
+func TestSubmitProposalParamsRegistry(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	proposer := sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r")
+
+	// burn_vote_veto is not governance-adjustable, and the quorum can't go below 20%
+	govKeeper.SetParamsRegistry(types.NewParamsRegistry().Register(
+		sdk.MsgTypeURL(&v1.MsgUpdateParams{}),
+		func(ctx sdk.Context, msg sdk.Msg) error {
+			params := msg.(*v1.MsgUpdateParams).Params
+			if params.BurnVoteVeto != govKeeper.GetParams(ctx).BurnVoteVeto {
+				return errors.New("burn_vote_veto is not governance-adjustable")
+			}
+			if sdk.MustNewDecFromStr(params.Quorum).LT(sdk.NewDecWithPrec(2, 1)) {
+				return errors.New("quorum below 20%")
+			}
+			return nil
+		},
+	))
+
+	newParams := func(quorum string, burnVoteVeto bool) []sdk.Msg {
+		params := v1.DefaultParams()
+		params.Quorum = quorum
+		params.BurnVoteVeto = burnVoteVeto
+		return []sdk.Msg{&v1.MsgUpdateParams{Authority: govAcct.String(), Params: params}}
+	}
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		expErr string
+	}{
+		{"quorum within bounds", newParams("0.3", v1.DefaultParams().BurnVoteVeto), ""},
+		{"quorum out of bounds", newParams("0.1", v1.DefaultParams().BurnVoteVeto), "quorum below 20%"},
+		{"param not adjustable", newParams("0.3", !v1.DefaultParams().BurnVoteVeto), "burn_vote_veto is not governance-adjustable"},
+		{"message not in registry", TestProposal, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := govKeeper.SubmitProposal(ctx, tc.msgs, "", "title", "summary", proposer, nil, false)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrInvalidParamsUpdate)
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestSubmitProposalMaxDepositPeriodProposalsPerProposer(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	proposer := sdk.AccAddress("proposer____________")
+	otherProposer := sdk.AccAddress("other_proposer______")
+	params := v1.DefaultParams()
+	params.MaxDepositPeriodProposalsPerProposer = 2
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	proposal1, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", proposer, nil, false)
+	require.NoError(t, err)
+	_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", proposer, nil, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, govKeeper.CountDepositPeriodProposalsByProposer(ctx, proposer, 10))
+
+	// the proposer reached the limit, other proposers are not affected
+	_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", proposer, nil, false)
+	require.ErrorIs(t, err, types.ErrTooManyProposals)
+	_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", otherProposer, nil, false)
+	require.NoError(t, err)
+
+	// a proposal leaving the deposit period frees a slot
+	govKeeper.ActivateVotingPeriod(ctx, proposal1)
+	require.EqualValues(t, 1, govKeeper.CountDepositPeriodProposalsByProposer(ctx, proposer, 10))
+	proposal4, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", proposer, nil, false)
+	require.NoError(t, err)
+
+	// so does a deleted proposal
+	govKeeper.DeleteProposal(ctx, proposal4.Id)
+	require.EqualValues(t, 1, govKeeper.CountDepositPeriodProposalsByProposer(ctx, proposer, 10))
+
+	// a zero limit disables the check
+	params.MaxDepositPeriodProposalsPerProposer = 0
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	for i := 0; i < 3; i++ {
+		_, err = govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", proposer, nil, false)
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 4, govKeeper.CountDepositPeriodProposalsByProposer(ctx, proposer, 10))
+}
+
+func TestProposalReviewPeriod(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	proposer := sdk.AccAddress("proposer____________")
+	msgSendTypeURL := sdk.MsgTypeURL(TestProposal[0])
+	legacyTypeURL := sdk.MsgTypeURL(TestProposal[1])
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", proposer, nil, false)
+	require.NoError(t, err)
+	textProposal, err := govKeeper.SubmitProposal(ctx, nil, "metadata", "title", "summary", proposer, nil, false)
+	require.NoError(t, err)
+
+	// disabled by default
+	require.Zero(t, govKeeper.ProposalReviewPeriod(ctx, proposal))
+
+	tests := []struct {
+		name                 string
+		messageReviewPeriods []*v1.MessageReviewPeriod
+		expReviewPeriod      time.Duration
+	}{
+		{
+			name:            "review period param",
+			expReviewPeriod: time.Hour,
+		},
+		{
+			name: "longest message review period",
+			messageReviewPeriods: []*v1.MessageReviewPeriod{
+				{MsgTypeUrl: msgSendTypeURL, ReviewPeriod: durationPtr(3 * time.Hour)},
+				{MsgTypeUrl: legacyTypeURL, ReviewPeriod: durationPtr(30 * time.Minute)},
+			},
+			expReviewPeriod: 3 * time.Hour,
+		},
+		{
+			name: "review period param longer than the message review period",
+			messageReviewPeriods: []*v1.MessageReviewPeriod{
+				{MsgTypeUrl: legacyTypeURL, ReviewPeriod: durationPtr(30 * time.Minute)},
+			},
+			expReviewPeriod: time.Hour,
+		},
+		{
+			name: "message review periods disabling the review",
+			messageReviewPeriods: []*v1.MessageReviewPeriod{
+				{MsgTypeUrl: msgSendTypeURL, ReviewPeriod: durationPtr(0)},
+				{MsgTypeUrl: legacyTypeURL, ReviewPeriod: durationPtr(0)},
+			},
+			expReviewPeriod: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := v1.DefaultParams()
+			params.ReviewPeriod = durationPtr(time.Hour)
+			params.MessageReviewPeriods = tc.messageReviewPeriods
+			require.NoError(t, govKeeper.SetParams(ctx, params))
+
+			require.Equal(t, tc.expReviewPeriod, govKeeper.ProposalReviewPeriod(ctx, proposal))
+			// the review period param applies to the proposals without messages
+			require.Equal(t, time.Hour, govKeeper.ProposalReviewPeriod(ctx, textProposal))
+		})
+	}
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
+
+func TestProposalsByTotalDepositIndex(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	proposer := sdk.AccAddress("proposer____________")
+
+	// returns the proposal IDs of the total deposit index, in its order
+	indexedIDs := func() []uint64 {
+		var ids []uint64
+		iterator := sdk.KVStorePrefixIterator(govKeeper.KVStore(ctx), types.ProposalsByTotalDepositKeyPrefix)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()
+			ids = append(ids, types.GetProposalIDFromBytes(key[len(key)-8:]))
+		}
+		return ids
+	}
+
+	for id, totalDeposit := range map[uint64]sdk.Coins{
+		1: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+		2: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5), sdk.NewInt64Coin("uphoton", 100)),
+	} {
+		proposal, err := v1.NewProposal(TestProposal, id, ctx.BlockTime(), ctx.BlockTime(), "", "title", "summary", proposer)
+		require.NoError(t, err)
+		proposal.TotalDeposit = totalDeposit
+		govKeeper.SetProposal(ctx, proposal)
+	}
+
+	// without deposit denom weights, the bond denom amounts are indexed
+	require.Equal(t, []uint64{2, 1}, indexedIDs())
+
+	// otherwise the deposit values, the proposals being reindexed with the
+	// weights in bounded batches
+	params := govKeeper.GetParams(ctx)
+	params.DepositDenomWeights = []*v1.DepositDenomWeight{{Denom: sdk.DefaultBondDenom, Weight: "1"}, {Denom: "uphoton", Weight: "0.1"}}
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	require.Equal(t, []uint64{2, 1}, indexedIDs())
+	require.Equal(t, uint64(1), govKeeper.ReindexProposalsByTotalDeposit(ctx, 1))
+	require.Equal(t, []uint64{2, 1}, indexedIDs())
+	require.Equal(t, uint64(1), govKeeper.ReindexProposalsByTotalDeposit(ctx, 1))
+	require.Equal(t, []uint64{1, 2}, indexedIDs())
+	require.Equal(t, uint64(0), govKeeper.ReindexProposalsByTotalDeposit(ctx, 1))
+
+	// the entry keyed with former weights is replaced when the proposal is
+	// saved
+	params.DepositDenomWeights[1].Weight = "0.01"
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+	proposal, ok := govKeeper.GetProposal(ctx, 2)
+	require.True(t, ok)
+	govKeeper.SetProposal(ctx, proposal)
+	require.Equal(t, []uint64{2, 1}, indexedIDs())
+	require.Equal(t, uint64(2), govKeeper.ReindexProposalsByTotalDeposit(ctx, 10))
+	require.Equal(t, []uint64{2, 1}, indexedIDs())
+	require.Equal(t, uint64(0), govKeeper.ReindexProposalsByTotalDeposit(ctx, 10))
+
+	// as well as on deletion
+	govKeeper.DeleteProposal(ctx, 2)
+	require.Equal(t, []uint64{1}, indexedIDs())
+}
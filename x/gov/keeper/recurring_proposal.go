@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// ScheduleRecurringProposal schedules a proposal submitted for a vote at each
+// interval, the first one being submitted one interval from now. The messages
+// are validated as on a proposal submission, and the interval can't be shorter
+// than the voting period so that the votes on two submitted proposals don't
+// overlap.
+func (keeper Keeper) ScheduleRecurringProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, interval time.Duration) (v1.RecurringProposal, error) {
+	for _, s := range []string{metadata, title, summary} {
+		if err := keeper.assertMetadataLength(s); err != nil {
+			return v1.RecurringProposal{}, err
+		}
+	}
+
+	if _, err := keeper.validateProposalMsgs(ctx, messages); err != nil {
+		return v1.RecurringProposal{}, err
+	}
+
+	if votingPeriod := keeper.GetParams(ctx).VotingPeriod; interval < *votingPeriod {
+		return v1.RecurringProposal{}, sdkerrors.Wrapf(types.ErrInvalidSchedule, "interval %s is shorter than the voting period %s", interval, votingPeriod)
+	}
+
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return v1.RecurringProposal{}, err
+	}
+
+	id := keeper.GetNextRecurringProposalID(ctx)
+	nextSubmitTime := ctx.BlockTime().Add(interval)
+	recurring := v1.RecurringProposal{
+		Id:             id,
+		Messages:       anys,
+		Metadata:       metadata,
+		Title:          title,
+		Summary:        summary,
+		Interval:       &interval,
+		NextSubmitTime: &nextSubmitTime,
+	}
+	keeper.SetRecurringProposal(ctx, recurring)
+	keeper.InsertRecurringProposalQueue(ctx, id, nextSubmitTime)
+	keeper.SetNextRecurringProposalID(ctx, id+1)
+
+	return recurring, nil
+}
+
+// CancelRecurringProposal removes a recurring proposal, so that no proposal
+// is submitted from it anymore. The proposals already submitted are not
+// affected.
+func (keeper Keeper) CancelRecurringProposal(ctx sdk.Context, recurringProposalID uint64) error {
+	recurring, found := keeper.GetRecurringProposal(ctx, recurringProposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrScheduleNotFound, "%d", recurringProposalID)
+	}
+
+	keeper.RemoveFromRecurringProposalQueue(ctx, recurring.Id, *recurring.NextSubmitTime)
+	keeper.DeleteRecurringProposal(ctx, recurring.Id)
+	return nil
+}
+
+// SubmitRecurringProposal submits the next proposal of a recurring proposal.
+// Its submission having been approved by governance, the proposal is
+// submitted by the governance module account and enters the voting period
+// right away, without deposit nor review period. The recurring proposal is
+// then rescheduled one interval later, even if the submission failed.
+func (keeper Keeper) SubmitRecurringProposal(ctx sdk.Context, recurring v1.RecurringProposal) (v1.Proposal, error) {
+	proposal, err := keeper.submitRecurringProposal(ctx, recurring)
+	if err == nil {
+		recurring.LastProposalId = proposal.Id
+	}
+
+	// the submissions missed while the chain was halted are skipped
+	keeper.RemoveFromRecurringProposalQueue(ctx, recurring.Id, *recurring.NextSubmitTime)
+	nextSubmitTime := recurring.NextSubmitTime.Add(*recurring.Interval)
+	if !nextSubmitTime.After(ctx.BlockTime()) {
+		nextSubmitTime = ctx.BlockTime().Add(*recurring.Interval)
+	}
+	recurring.NextSubmitTime = &nextSubmitTime
+	keeper.SetRecurringProposal(ctx, recurring)
+	keeper.InsertRecurringProposalQueue(ctx, recurring.Id, nextSubmitTime)
+
+	return proposal, err
+}
+
+// submitRecurringProposal submits a proposal from the recurring proposal and
+// activates its voting period. The state is written only if the submission
+// succeeds, as the messages may not be valid anymore.
+func (keeper Keeper) submitRecurringProposal(ctx sdk.Context, recurring v1.RecurringProposal) (v1.Proposal, error) {
+	messages, err := recurring.GetMsgs()
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	proposal, err := keeper.SubmitProposal(cacheCtx, messages, recurring.Metadata, recurring.Title, recurring.Summary, keeper.GetGovernanceAccount(ctx).GetAddress(), nil, false)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
+	keeper.ActivateVotingPeriod(cacheCtx, proposal)
+	writeCache()
+
+	proposal, _ = keeper.GetProposal(ctx, proposal.Id)
+	return proposal, nil
+}
+
+// GetRecurringProposal gets a recurring proposal from store.
+func (keeper Keeper) GetRecurringProposal(ctx sdk.Context, recurringProposalID uint64) (v1.RecurringProposal, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.RecurringProposalKey(recurringProposalID))
+	if bz == nil {
+		return v1.RecurringProposal{}, false
+	}
+
+	var recurring v1.RecurringProposal
+	keeper.cdc.MustUnmarshal(bz, &recurring)
+	return recurring, true
+}
+
+// SetRecurringProposal sets a recurring proposal to store.
+func (keeper Keeper) SetRecurringProposal(ctx sdk.Context, recurring v1.RecurringProposal) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&recurring)
+	store.Set(types.RecurringProposalKey(recurring.Id), bz)
+}
+
+// DeleteRecurringProposal deletes a recurring proposal from store.
+func (keeper Keeper) DeleteRecurringProposal(ctx sdk.Context, recurringProposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.RecurringProposalKey(recurringProposalID))
+}
+
+// IterateRecurringProposals iterates over all the recurring proposals and
+// performs a callback function.
+func (keeper Keeper) IterateRecurringProposals(ctx sdk.Context, cb func(recurring v1.RecurringProposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.RecurringProposalsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var recurring v1.RecurringProposal
+		keeper.cdc.MustUnmarshal(iterator.Value(), &recurring)
+
+		if cb(recurring) {
+			break
+		}
+	}
+}
+
+// GetNextRecurringProposalID gets the id of the next recurring proposal.
+func (keeper Keeper) GetNextRecurringProposalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.RecurringProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+	return types.GetProposalIDFromBytes(bz)
+}
+
+// SetNextRecurringProposalID sets the id of the next recurring proposal.
+func (keeper Keeper) SetNextRecurringProposalID(ctx sdk.Context, recurringProposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.RecurringProposalIDKey, types.GetProposalIDBytes(recurringProposalID))
+}
+
+// InsertRecurringProposalQueue inserts a recurring proposal into the recurring
+// proposal queue at nextSubmitTime
+func (keeper Keeper) InsertRecurringProposalQueue(ctx sdk.Context, recurringProposalID uint64, nextSubmitTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.RecurringProposalQueueKey(recurringProposalID, nextSubmitTime), types.GetProposalIDBytes(recurringProposalID))
+}
+
+// RemoveFromRecurringProposalQueue removes a recurring proposal from the
+// recurring proposal queue
+func (keeper Keeper) RemoveFromRecurringProposalQueue(ctx sdk.Context, recurringProposalID uint64, nextSubmitTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.RecurringProposalQueueKey(recurringProposalID, nextSubmitTime))
+}
+
+// IterateRecurringProposalsQueue iterates over the recurring proposals in the
+// recurring proposal queue to submit by endTime and performs a callback
+// function
+func (keeper Keeper) IterateRecurringProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(recurring v1.RecurringProposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.RecurringProposalQueuePrefix, sdk.PrefixEndBytes(types.RecurringProposalByTimeKey(endTime)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		recurringProposalID, _ := types.SplitRecurringProposalQueueKey(iterator.Key())
+		recurring, found := keeper.GetRecurringProposal(ctx, recurringProposalID)
+		if !found {
+			panic(fmt.Sprintf("recurring proposal %d does not exist", recurringProposalID))
+		}
+
+		if cb(recurring) {
+			break
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestScheduleRecurringProposal(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	votingPeriod := *v1.DefaultParams().VotingPeriod
+	addr := simtestutil.CreateRandomAccounts(1)[0]
+
+	// the interval can't be shorter than the voting period
+	_, err := govKeeper.ScheduleRecurringProposal(ctx, TestProposal, "", "title", "summary", votingPeriod-time.Second)
+	require.ErrorIs(t, err, types.ErrInvalidSchedule)
+
+	// the messages are validated as on submission
+	_, err = govKeeper.ScheduleRecurringProposal(ctx, []sdk.Msg{banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))}, "", "title", "summary", votingPeriod)
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	recurring, err := govKeeper.ScheduleRecurringProposal(ctx, TestProposal, "", "title", "summary", votingPeriod)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), recurring.Id)
+	require.Equal(t, ctx.BlockTime().Add(votingPeriod), *recurring.NextSubmitTime)
+	recurring, err = govKeeper.ScheduleRecurringProposal(ctx, TestProposal, "", "title", "summary", 2*votingPeriod)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), recurring.Id)
+
+	var ids []uint64
+	govKeeper.IterateRecurringProposalsQueue(ctx, ctx.BlockTime().Add(votingPeriod), func(recurring v1.RecurringProposal) bool {
+		ids = append(ids, recurring.Id)
+		return false
+	})
+	require.Equal(t, []uint64{1}, ids)
+
+	require.NoError(t, govKeeper.CancelRecurringProposal(ctx, 1))
+	_, found := govKeeper.GetRecurringProposal(ctx, 1)
+	require.False(t, found)
+	require.ErrorIs(t, govKeeper.CancelRecurringProposal(ctx, 1), types.ErrScheduleNotFound)
+
+	ids = nil
+	govKeeper.IterateRecurringProposalsQueue(ctx, ctx.BlockTime().Add(2*votingPeriod), func(recurring v1.RecurringProposal) bool {
+		ids = append(ids, recurring.Id)
+		return false
+	})
+	require.Equal(t, []uint64{2}, ids)
+}
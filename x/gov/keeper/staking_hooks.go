@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingHooks shrinks the delegation shares locked by votes when they are
+// reduced below the locked ones, like when a redelegation is slashed. The
+// hooks never fail, as the staking module panics on the errors of the hooks
+// called while slashing. The undelegations and redelegations of the locked
+// shares are rejected by the ante handler, see Keeper.CheckVoteLocks.
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hooks of the gov keeper.
+func (keeper Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{keeper}
+}
+
+// AfterDelegationModified shrinks the locked shares to the delegation shares.
+func (h StakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	delegation := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if delegation == nil {
+		return nil
+	}
+	h.k.shrinkVoteLock(ctx, delAddr, valAddr, delegation.GetShares())
+	return nil
+}
+
+// BeforeDelegationRemoved unlocks the shares of a removed delegation.
+func (h StakingHooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.shrinkVoteLock(ctx, delAddr, valAddr, math.LegacyZeroDec())
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
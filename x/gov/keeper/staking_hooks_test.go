@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/atomone/app/helpers"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestSlashRedelegationIntoVoteLockedShares(t *testing.T) {
+	app := helpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now().UTC()})
+	tokens := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	addrs := simtestutil.AddTestAddrsIncremental(app.BankKeeper, app.StakingKeeper, ctx, 3, tokens.MulRaw(2))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:2])
+	pubKeys := simtestutil.CreateTestPubKeys(2)
+	delegator := addrs[2]
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	for i, valAddr := range valAddrs {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			valAddr, pubKeys[i], sdk.NewCoin(sdk.DefaultBondDenom, tokens),
+			stakingtypes.Description{Moniker: "validator"},
+			stakingtypes.NewCommissionRates(sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyZeroDec()),
+			sdkmath.OneInt(),
+		)
+		require.NoError(t, err)
+		_, err = stakingMsgSvr.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+	}
+	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	// the delegator redelegates its stake, then locks it with a vote
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, tokens)
+	_, err = stakingMsgSvr.Delegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgDelegate(delegator, valAddrs[0], coin))
+	require.NoError(t, err)
+	_, err = stakingMsgSvr.BeginRedelegate(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgBeginRedelegate(delegator, valAddrs[0], valAddrs[1], coin))
+	require.NoError(t, err)
+
+	params := app.GovKeeper.GetParams(ctx)
+	params.MaxVoteLockPeriods = 1
+	require.NoError(t, app.GovKeeper.SetParams(ctx, params))
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delegator, nil, false)
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	require.NoError(t, app.GovKeeper.AddLockedVote(ctx, proposal.Id, delegator, v1.NewNonSplitVoteOption(v1.OptionYes), "", 1))
+
+	// the locked shares can't be redelegated back
+	err = app.GovKeeper.CheckVoteLocks(ctx, []sdk.Msg{stakingtypes.NewMsgBeginRedelegate(delegator, valAddrs[1], valAddrs[0], coin)})
+	require.Error(t, err)
+
+	// slashing the source validator for an infraction before the redelegation
+	// unbonds half of the locked shares
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NotPanics(t, func() {
+		app.StakingKeeper.Slash(ctx, sdk.ConsAddress(pubKeys[0].Address()), ctx.BlockHeight()-1, 100, sdkmath.LegacyNewDecWithPrec(5, 1))
+	})
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegator, valAddrs[1])
+	require.True(t, found)
+	require.True(t, delegation.Shares.LT(sdkmath.LegacyNewDecFromInt(tokens)))
+	lock, found := app.GovKeeper.GetVoteLock(ctx, delegator)
+	require.True(t, found)
+	require.Equal(t, []*v1.LockedShares{{ValidatorAddress: valAddrs[1].String(), Shares: delegation.Shares.String()}}, lock.Shares)
+}
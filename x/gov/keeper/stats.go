@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// DefaultTurnoutWindow is the default number of most recently tallied
+// proposals the average turnout is computed over.
+const DefaultTurnoutWindow = 10
+
+// MaxTurnoutWindow is the maximum number of most recently tallied proposals
+// the average turnout can be computed over. Only the turnouts of these
+// proposals are kept in store, the older ones being pruned.
+const MaxTurnoutWindow = 100
+
+// GetProposalStatusCount returns the number of proposals in the given status.
+func (keeper Keeper) GetProposalStatusCount(ctx sdk.Context, status v1.ProposalStatus) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ProposalStatusCountKey(int32(status)))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setProposalStatusCount sets the number of proposals in the given status.
+func (keeper Keeper) setProposalStatusCount(ctx sdk.Context, status v1.ProposalStatus, count uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ProposalStatusCountKey(int32(status)), sdk.Uint64ToBigEndian(count))
+}
+
+// UpdateProposalStatusCount moves a proposal from the from status count to
+// the to status count. An unspecified status is not counted, so it can be used
+// to only increment or only decrement a count.
+func (keeper Keeper) UpdateProposalStatusCount(ctx sdk.Context, from, to v1.ProposalStatus) {
+	if from != v1.StatusNil {
+		count := keeper.GetProposalStatusCount(ctx, from)
+		if count > 0 {
+			keeper.setProposalStatusCount(ctx, from, count-1)
+		}
+	}
+	if to != v1.StatusNil {
+		keeper.setProposalStatusCount(ctx, to, keeper.GetProposalStatusCount(ctx, to)+1)
+	}
+}
+
+// GetProposalStatusCounts returns the number of proposals in each status.
+func (keeper Keeper) GetProposalStatusCounts(ctx sdk.Context) []*v1.ProposalStatusCount {
+	statuses := []v1.ProposalStatus{
+		v1.StatusDepositPeriod,
+		v1.StatusVotingPeriod,
+		v1.StatusPassed,
+		v1.StatusRejected,
+		v1.StatusFailed,
+		v1.StatusQueued,
+		v1.StatusReviewPeriod,
+	}
+
+	counts := make([]*v1.ProposalStatusCount, 0, len(statuses))
+	for _, status := range statuses {
+		counts = append(counts, &v1.ProposalStatusCount{
+			Status: status,
+			Count:  keeper.GetProposalStatusCount(ctx, status),
+		})
+	}
+	return counts
+}
+
+// SetProposalTurnout sets the turnout of a tallied proposal.
+func (keeper Keeper) SetProposalTurnout(ctx sdk.Context, turnout v1.ProposalTurnout) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&turnout)
+	store.Set(types.ProposalTurnoutKey(turnout.ProposalId), bz)
+}
+
+// RecordProposalTurnout computes the turnout of a proposal from its tally
+// results, i.e. the fraction of the bonded tokens that took part in the vote,
+// and stores it. The turnouts past the MaxTurnoutWindow most recent ones are
+// pruned.
+func (keeper Keeper) RecordProposalTurnout(ctx sdk.Context, proposalID uint64, tallyResults v1.TallyResult) {
+	turnout := math.LegacyZeroDec()
+
+	totalBondedTokens := keeper.sk.TotalBondedTokens(ctx)
+	if !totalBondedTokens.IsZero() {
+		totalVotingPower := math.ZeroInt()
+		for _, count := range []string{
+			tallyResults.YesCount,
+			tallyResults.AbstainCount,
+			tallyResults.NoCount,
+			tallyResults.NoWithVetoCount,
+		} {
+			power, ok := math.NewIntFromString(count)
+			if ok {
+				totalVotingPower = totalVotingPower.Add(power)
+			}
+		}
+		turnout = sdk.NewDecFromInt(totalVotingPower).QuoInt(totalBondedTokens)
+	}
+
+	keeper.SetProposalTurnout(ctx, v1.ProposalTurnout{
+		ProposalId: proposalID,
+		Turnout:    turnout.String(),
+	})
+	keeper.pruneProposalTurnouts(ctx)
+}
+
+// pruneProposalTurnouts deletes the turnouts past the MaxTurnoutWindow most
+// recently tallied proposals.
+func (keeper Keeper) pruneProposalTurnouts(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.ProposalTurnoutKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for n := 0; iterator.Valid(); iterator.Next() {
+		n++
+		if n > MaxTurnoutWindow {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateProposalTurnouts iterates over all the stored proposal turnouts and
+// performs a callback function.
+func (keeper Keeper) IterateProposalTurnouts(ctx sdk.Context, cb func(turnout v1.ProposalTurnout) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposalTurnoutKeyPrefix)
+	keeper.iterateProposalTurnouts(iterator, cb)
+}
+
+// GetAverageTurnout returns the average turnout of the lastN most recently
+// tallied proposals.
+func (keeper Keeper) GetAverageTurnout(ctx sdk.Context, lastN uint64) sdk.Dec {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.ProposalTurnoutKeyPrefix)
+
+	sum := math.LegacyZeroDec()
+	var n int64
+	keeper.iterateProposalTurnouts(iterator, func(turnout v1.ProposalTurnout) bool {
+		sum = sum.Add(sdk.MustNewDecFromStr(turnout.Turnout))
+		n++
+		return uint64(n) >= lastN
+	})
+
+	if n == 0 {
+		return math.LegacyZeroDec()
+	}
+	return sum.QuoInt64(n)
+}
+
+func (keeper Keeper) iterateProposalTurnouts(iterator storetypes.Iterator, cb func(turnout v1.ProposalTurnout) (stop bool)) {
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var turnout v1.ProposalTurnout
+		keeper.cdc.MustUnmarshal(iterator.Value(), &turnout)
+
+		if cb(turnout) {
+			break
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/keeper"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestProposalStatusCount() {
+	suite.reset()
+	proposer := sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r")
+
+	proposal1, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", proposer, nil, false)
+	suite.Require().NoError(err)
+	proposal2, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", proposer, nil, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), suite.govKeeper.GetProposalStatusCount(suite.ctx, v1.StatusDepositPeriod))
+
+	suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal1)
+	suite.Require().Equal(uint64(1), suite.govKeeper.GetProposalStatusCount(suite.ctx, v1.StatusDepositPeriod))
+	suite.Require().Equal(uint64(1), suite.govKeeper.GetProposalStatusCount(suite.ctx, v1.StatusVotingPeriod))
+
+	suite.govKeeper.UpdateProposalStatusCount(suite.ctx, v1.StatusVotingPeriod, v1.StatusPassed)
+	suite.govKeeper.DeleteProposal(suite.ctx, proposal2.Id)
+
+	suite.Require().Equal([]*v1.ProposalStatusCount{
+		{Status: v1.StatusDepositPeriod, Count: 0},
+		{Status: v1.StatusVotingPeriod, Count: 0},
+		{Status: v1.StatusPassed, Count: 1},
+		{Status: v1.StatusRejected, Count: 0},
+		{Status: v1.StatusFailed, Count: 0},
+		{Status: v1.StatusQueued, Count: 0},
+		{Status: v1.StatusReviewPeriod, Count: 0},
+	}, suite.govKeeper.GetProposalStatusCounts(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestAverageTurnout() {
+	suite.reset()
+	suite.Require().True(suite.govKeeper.GetAverageTurnout(suite.ctx, 10).IsZero())
+
+	// total bonded tokens are mocked to 10000000
+	tally1 := v1.NewTallyResult(math.NewInt(500000), math.NewInt(500000), math.ZeroInt(), math.ZeroInt())
+	suite.govKeeper.RecordProposalTurnout(suite.ctx, 1, tally1)
+	tally2 := v1.NewTallyResult(math.NewInt(1000000), math.NewInt(1000000), math.NewInt(500000), math.NewInt(500000))
+	suite.govKeeper.RecordProposalTurnout(suite.ctx, 2, tally2)
+
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.2"), suite.govKeeper.GetAverageTurnout(suite.ctx, 10))
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.3"), suite.govKeeper.GetAverageTurnout(suite.ctx, 1))
+}
+
+func (suite *KeeperTestSuite) TestProposalTurnoutsPruning() {
+	suite.reset()
+
+	tally := v1.NewTallyResult(math.NewInt(1000000), math.ZeroInt(), math.ZeroInt(), math.ZeroInt())
+	for id := uint64(1); id <= keeper.MaxTurnoutWindow+5; id++ {
+		suite.govKeeper.RecordProposalTurnout(suite.ctx, id, tally)
+	}
+
+	var ids []uint64
+	suite.govKeeper.IterateProposalTurnouts(suite.ctx, func(turnout v1.ProposalTurnout) bool {
+		ids = append(ids, turnout.ProposalId)
+		return false
+	})
+	suite.Require().Len(ids, keeper.MaxTurnoutWindow)
+	suite.Require().Equal(uint64(6), ids[0])
+}
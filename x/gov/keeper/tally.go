@@ -1,19 +1,82 @@
 package keeper
 
 import (
+	"sort"
+	"time"
+
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
+// GetTallyResult returns the tally result of a proposal: the current tally if
+// the proposal is in voting period, its final tally once completed or archived.
+// The current tally is computed on a cached context, so the votes are left in
+// the store.
+func (keeper Keeper) GetTallyResult(ctx sdk.Context, proposalID uint64) (v1.TallyResult, bool) {
+	tallyResult, _, found := keeper.getTallyResults(ctx, proposalID)
+	return tallyResult, found
+}
+
+// GetQuadraticTallyResult returns the quadratic tally result of a quadratic
+// proposal, like GetTallyResult. It returns false if the proposal doesn't
+// exist or isn't quadratic.
+func (keeper Keeper) GetQuadraticTallyResult(ctx sdk.Context, proposalID uint64) (v1.TallyResult, bool) {
+	_, quadraticResult, found := keeper.getTallyResults(ctx, proposalID)
+	if !found || quadraticResult == nil {
+		return v1.TallyResult{}, false
+	}
+	return *quadraticResult, true
+}
+
+// getTallyResults returns the tally result of a proposal, and its quadratic
+// tally result for the quadratic proposals.
+func (keeper Keeper) getTallyResults(ctx sdk.Context, proposalID uint64) (v1.TallyResult, *v1.TallyResult, bool) {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		// the final tally of a pruned proposal is kept in its archived summary
+		archived, found := keeper.GetArchivedProposal(ctx, proposalID)
+		if !found || archived.FinalTallyResult == nil {
+			return v1.TallyResult{}, nil, false
+		}
+		return *archived.FinalTallyResult, nil, true
+	}
+
+	switch {
+	case proposal.Status == v1.StatusDepositPeriod || proposal.Status == v1.StatusReviewPeriod:
+		if proposal.Quadratic {
+			quadraticResult := v1.EmptyTallyResult()
+			return v1.EmptyTallyResult(), &quadraticResult, true
+		}
+		return v1.EmptyTallyResult(), nil, true
+
+	case proposal.Status == v1.StatusPassed || proposal.Status == v1.StatusRejected || proposal.Status == v1.StatusFailed ||
+		proposal.Status == v1.StatusQueued:
+		return *proposal.FinalTallyResult, proposal.FinalQuadraticTallyResult, true
+
+	default:
+		// proposal is in voting period
+		cacheCtx, _ := ctx.CacheContext()
+		_, _, _, tallyResult, quadraticResult := keeper.Tally(cacheCtx, proposal)
+		return tallyResult, quadraticResult, true
+	}
+}
+
 // TODO: Break into several smaller functions for clarity
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
-func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
+// voters. vetoed is true if the proposal is rejected because of the NoWithVeto votes.
+// quadraticResults is only set for the quadratic proposals, whose outcome is decided by the square
+// root of the voting power of the voters, while their quorum is still computed from the voting power.
+func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, vetoed bool, tallyResults v1.TallyResult, quadraticResults *v1.TallyResult) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, types.MetricKeyTally)
+
 	results := make(map[v1.VoteOption]sdk.Dec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
 	results[v1.OptionAbstain] = math.LegacyZeroDec()
@@ -21,39 +84,103 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
 
 	totalVotingPower := math.LegacyZeroDec()
+	totalBoostPower := math.LegacyZeroDec()
 	currValidators := make(map[string]stakingtypes.ValidatorI)
+	params := keeper.GetParams(ctx)
 
-	// fetch all the bonded validators, insert them into currValidators
+	quadratic := make(map[v1.VoteOption]sdk.Dec)
+	quadratic[v1.OptionYes] = math.LegacyZeroDec()
+	quadratic[v1.OptionAbstain] = math.LegacyZeroDec()
+	quadratic[v1.OptionNo] = math.LegacyZeroDec()
+	quadratic[v1.OptionNoWithVeto] = math.LegacyZeroDec()
+	totalQuadraticPower := math.LegacyZeroDec()
+	quadraticCap := math.LegacyZeroDec()
+	if params.QuadraticVotingPowerCap != "" {
+		quadraticCap = math.LegacyMustNewDecFromStr(params.QuadraticVotingPowerCap)
+	}
+
+	// fetch all the bonded validators, insert them into currValidators. The
+	// validators jailed in this block are still bonded until the staking
+	// EndBlocker, their stake is excluded from the tally and the quorum if
+	// ExcludeJailedValidatorsStake is set.
+	jailedBondedTokens := math.ZeroInt()
 	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		if params.ExcludeJailedValidatorsStake && validator.IsJailed() {
+			jailedBondedTokens = jailedBondedTokens.Add(validator.GetBondedTokens())
+			return false
+		}
 		currValidators[validator.GetOperator().String()] = validator
 		return false
 	})
 
+	// unless it is counted as the stake of a non-voter, the stake of the module
+	// accounts is accounted by the ModuleAccountStakeRule, superseding their
+	// votes
+	var moduleAccounts []sdk.AccAddress
+	if params.ModuleAccountStakeRule != v1.ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_COUNT {
+		moduleAccounts = keeper.moduleAccountAddresses()
+	}
+	isModuleAccount := make(map[string]bool, len(moduleAccounts))
+	for _, addr := range moduleAccounts {
+		isModuleAccount[addr.String()] = true
+	}
+
+	validator := func(valAddr string) (stakingtypes.ValidatorI, bool) {
+		val, ok := currValidators[valAddr]
+		return val, ok
+	}
+
+	// the unvested stake deducted from the voting power of the voters is
+	// excluded from the quorum too
+	unvestedPower := math.LegacyZeroDec()
 	keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
-		// iterate over all delegations from voter
-		keeper.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr().String()
-
-			if val, ok := currValidators[valAddrStr]; ok {
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares())
-
-				for _, option := range vote.Options {
-					weight, _ := sdk.NewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
-			}
-
+		if isModuleAccount[vote.Voter] {
+			keeper.deleteVote(ctx, vote.ProposalId, voter)
 			return false
-		})
+		}
+
+		votingPower, boost, unvested := keeper.voterVotingPower(ctx, params, voter, vote.LockPeriods, validator)
+		// the boost of the locked stake only counts toward the outcome
+		voterPower := votingPower.Add(boost)
+		for _, option := range vote.Options {
+			weight, _ := sdk.NewDecFromStr(option.Weight)
+			results[option.Option] = results[option.Option].Add(voterPower.Mul(weight))
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+		totalBoostPower = totalBoostPower.Add(boost)
+		unvestedPower = unvestedPower.Add(unvested)
+
+		if proposal.Quadratic {
+			power := quadraticVotingPower(voterPower, quadraticCap)
+			for _, option := range vote.Options {
+				weight, _ := sdk.NewDecFromStr(option.Weight)
+				quadratic[option.Option] = quadratic[option.Option].Add(power.Mul(weight))
+			}
+			totalQuadraticPower = totalQuadraticPower.Add(power)
+		}
 
 		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
+	excludedPower := math.LegacyZeroDec()
+	for _, addr := range moduleAccounts {
+		power := keeper.delegatorVotingPower(ctx, addr, validator)
+		switch params.ModuleAccountStakeRule {
+		case v1.ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_ABSTAIN:
+			results[v1.OptionAbstain] = results[v1.OptionAbstain].Add(power)
+			totalVotingPower = totalVotingPower.Add(power)
+			if proposal.Quadratic {
+				quadraticPower := quadraticVotingPower(power, quadraticCap)
+				quadratic[v1.OptionAbstain] = quadratic[v1.OptionAbstain].Add(quadraticPower)
+				totalQuadraticPower = totalQuadraticPower.Add(quadraticPower)
+			}
+		case v1.ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_EXCLUDE:
+			excludedPower = excludedPower.Add(power)
+		}
+	}
+
 	/* DISABLED on AtomOne - Voting can only be done with your own stake
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
@@ -73,40 +200,134 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	}
 	*/
 
-	params := keeper.GetParams(ctx)
 	tallyResults = v1.NewTallyResultFromMap(results)
 
+	// the outcome of the quadratic proposals is decided by the quadratic
+	// voting power of the voters
+	decisionResults, totalDecisionPower := results, totalVotingPower.Add(totalBoostPower)
+	if proposal.Quadratic {
+		quadraticTallyResults := v1.NewTallyResultFromMap(quadratic)
+		quadraticResults = &quadraticTallyResults
+		decisionResults, totalDecisionPower = quadratic, totalQuadraticPower
+	}
+
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
-	totalBondedTokens := keeper.sk.TotalBondedTokens(ctx)
-	if totalBondedTokens.IsZero() {
-		return false, false, tallyResults
+	totalBondedTokens := keeper.sk.TotalBondedTokens(ctx).Sub(jailedBondedTokens)
+	totalBonded := sdk.NewDecFromInt(totalBondedTokens).Sub(excludedPower).Sub(unvestedPower)
+	if !totalBonded.IsPositive() {
+		return false, false, false, tallyResults, quadraticResults
 	}
 
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(totalBondedTokens))
+	percentVoting := totalVotingPower.Quo(totalBonded)
 	quorum, _ := sdk.NewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
-		return false, params.BurnVoteQuorum, tallyResults
+		return false, params.BurnVoteQuorum, false, tallyResults, quadraticResults
 	}
 
 	// If no one votes (everyone abstains), proposal fails
-	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
-		return false, false, tallyResults
+	if totalDecisionPower.Sub(decisionResults[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
+		return false, false, false, tallyResults, quadraticResults
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
 	vetoThreshold, _ := sdk.NewDecFromStr(params.VetoThreshold)
-	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
-		return false, params.BurnVoteVeto, tallyResults
+	if decisionResults[v1.OptionNoWithVeto].Quo(totalDecisionPower).GT(vetoThreshold) {
+		return false, params.BurnVoteVeto, true, tallyResults, quadraticResults
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	threshold, _ := sdk.NewDecFromStr(params.Threshold)
-	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
-		return true, false, tallyResults
+	if decisionResults[v1.OptionYes].Quo(totalDecisionPower.Sub(decisionResults[v1.OptionAbstain])).GT(threshold) {
+		return true, false, false, tallyResults, quadraticResults
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	return false, false, false, tallyResults, quadraticResults
+}
+
+// voterVotingPower returns the voting power the tally counts for a vote of
+// voter locking its stake for lockPeriods, on the validators returned by
+// validator: the voting power of its delegations, minus its unvested stake if
+// ExcludeUnvestedVotingPower is set, and the boost of its locked shares, which
+// is their voting power multiplied by lockPeriods. unvested is the unvested
+// stake deducted from the voting power.
+func (keeper Keeper) voterVotingPower(
+	ctx sdk.Context, params v1.Params, voter sdk.AccAddress, lockPeriods uint32,
+	validator func(valAddr string) (stakingtypes.ValidatorI, bool),
+) (votingPower, boost, unvested sdk.Dec) {
+	votingPower = keeper.delegatorVotingPower(ctx, voter, validator)
+
+	// the unvested stake is deducted from the voting power of the
+	// delegations, as the vesting coins are delegated first
+	unvested = math.LegacyZeroDec()
+	if params.ExcludeUnvestedVotingPower {
+		unvested = math.LegacyMinDec(votingPower, keeper.unvestedStake(ctx, voter))
+		votingPower = votingPower.Sub(unvested)
+	}
+
+	boost = math.LegacyZeroDec()
+	if lockPeriods > 0 {
+		lockedPower := math.LegacyMinDec(votingPower, keeper.lockedVotingPower(ctx, voter, validator))
+		boost = lockedPower.MulInt64(int64(lockPeriods))
+	}
+	return votingPower, boost, unvested
+}
+
+// unvestedStake returns the amount of bond denom coins of a vesting account
+// that didn't vest yet at the block time, or zero for the other accounts.
+func (keeper Keeper) unvestedStake(ctx sdk.Context, addr sdk.AccAddress) sdk.Dec {
+	vestingAcc, ok := keeper.authKeeper.GetAccount(ctx, addr).(vestexported.VestingAccount)
+	if !ok {
+		return math.LegacyZeroDec()
+	}
+	vesting := vestingAcc.GetVestingCoins(ctx.BlockTime()).AmountOf(keeper.sk.BondDenom(ctx))
+	return sdk.NewDecFromInt(vesting)
+}
+
+// moduleAccountAddresses returns the addresses of the module accounts, sorted
+// by module name.
+func (keeper Keeper) moduleAccountAddresses() []sdk.AccAddress {
+	permissions := keeper.authKeeper.GetModulePermissions()
+	names := make([]string, 0, len(permissions))
+	for name := range permissions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	addrs := make([]sdk.AccAddress, len(names))
+	for i, name := range names {
+		addrs[i] = permissions[name].GetAddress()
+	}
+	return addrs
+}
+
+// delegatorVotingPower returns the voting power of the delegations of addr to
+// the validators counted in the tally, returned by validator.
+func (keeper Keeper) delegatorVotingPower(ctx sdk.Context, addr sdk.AccAddress, validator func(valAddr string) (stakingtypes.ValidatorI, bool)) sdk.Dec {
+	votingPower := math.LegacyZeroDec()
+	keeper.sk.IterateDelegations(ctx, addr, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		// delegation shares * bonded / total shares
+		if val, ok := validator(delegation.GetValidatorAddr().String()); ok {
+			votingPower = votingPower.Add(delegation.GetShares().MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares()))
+		}
+		return false
+	})
+	return votingPower
+}
+
+// quadraticVotingPower returns the square root of a voting power, capped to
+// quadraticCap when positive.
+func quadraticVotingPower(votingPower, quadraticCap sdk.Dec) sdk.Dec {
+	power, err := votingPower.ApproxSqrt()
+	if err != nil {
+		// the approximation doesn't converge, which can't happen for the
+		// voting power of a voter
+		panic(err)
+	}
+	if quadraticCap.IsPositive() {
+		power = math.LegacyMinDec(power, quadraticCap)
+	}
+	return power
 }
@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"context"
+	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +14,8 @@ import (
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/atomone-hub/atomone/x/gov/keeper"
@@ -39,6 +43,7 @@ type tallyFixture struct {
 // - initiates the validators with a self delegation of 1:
 //   - setup IterateBondedValidatorsByPower call
 //   - setup IterateDelegations call for validators
+//   - setup Validator call
 func newTallyFixture(t *testing.T, ctx sdk.Context, proposal v1.Proposal,
 	valAddrs []sdk.ValAddress, delAddrs []sdk.AccAddress, govKeeper *keeper.Keeper,
 	mocks mocks,
@@ -87,6 +92,17 @@ func newTallyFixture(t *testing.T, ctx sdk.Context, proposal v1.Proposal,
 				}
 				return nil
 			}).AnyTimes()
+	mocks.stakingKeeper.EXPECT().
+		Validator(ctx, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, valAddr sdk.ValAddress) stakingtypes.ValidatorI {
+				for i := 0; i < len(s.validators); i++ {
+					if s.validators[i].OperatorAddress == valAddr.String() {
+						return s.validators[i]
+					}
+				}
+				return nil
+			}).AnyTimes()
 	return s
 }
 
@@ -123,6 +139,7 @@ func TestTally(t *testing.T) {
 		setup         func(*tallyFixture)
 		expectedPass  bool
 		expectedBurn  bool
+		expectedVeto  bool
 		expectedTally v1.TallyResult
 		expectedError string
 	}{
@@ -282,6 +299,7 @@ func TestTally(t *testing.T) {
 			},
 			expectedPass: false,
 			expectedBurn: true,
+			expectedVeto: true,
 			expectedTally: v1.TallyResult{
 				YesCount:        "4",
 				AbstainCount:    "0",
@@ -363,7 +381,7 @@ func TestTally(t *testing.T) {
 				delAddrs      = addrs[numVals:]
 			)
 			// Submit and activate a proposal
-			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0])
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], nil, false)
 			require.NoError(t, err)
 			govKeeper.ActivateVotingPeriod(ctx, proposal)
 			// Create the test fixture
@@ -372,12 +390,618 @@ func TestTally(t *testing.T) {
 				tt.setup(s)
 			}
 
-			pass, burn, tally := govKeeper.Tally(ctx, proposal)
+			pass, burn, veto, tally, _ := govKeeper.Tally(ctx, proposal)
 
 			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
 			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
+			assert.Equal(t, tt.expectedVeto, veto, "wrong veto")
 			assert.Equal(t, tt.expectedTally, tally)
 			assert.Empty(t, govKeeper.GetVotes(ctx, proposal.Id), "votes not be removed after tally")
 		})
 	}
 }
+
+func TestTallyVestingAccounts(t *testing.T) {
+	start := time.Unix(1_000_000, 0).UTC()
+	end := start.Add(100 * time.Second)
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	tests := []struct {
+		name             string
+		excludeUnvested  bool
+		vestingAccount   func(*authtypes.BaseAccount) authtypes.AccountI
+		delegation       int64
+		blockTime        time.Time
+		expectedYesCount string
+	}{
+		{
+			name: "delayed vesting, unvested stake counted",
+			vestingAccount: func(acc *authtypes.BaseAccount) authtypes.AccountI {
+				return vestingtypes.NewDelayedVestingAccount(acc, vestingCoins, end.Unix())
+			},
+			delegation:       10,
+			blockTime:        start,
+			expectedYesCount: "10",
+		},
+		{
+			name:            "delayed vesting, before the cliff",
+			excludeUnvested: true,
+			vestingAccount: func(acc *authtypes.BaseAccount) authtypes.AccountI {
+				return vestingtypes.NewDelayedVestingAccount(acc, vestingCoins, end.Unix())
+			},
+			delegation:       10,
+			blockTime:        end.Add(-time.Second),
+			expectedYesCount: "0",
+		},
+		{
+			name:            "delayed vesting, at the cliff",
+			excludeUnvested: true,
+			vestingAccount: func(acc *authtypes.BaseAccount) authtypes.AccountI {
+				return vestingtypes.NewDelayedVestingAccount(acc, vestingCoins, end.Unix())
+			},
+			delegation:       10,
+			blockTime:        end,
+			expectedYesCount: "10",
+		},
+		{
+			name:            "delayed vesting, delegation above the vesting coins",
+			excludeUnvested: true,
+			vestingAccount: func(acc *authtypes.BaseAccount) authtypes.AccountI {
+				return vestingtypes.NewDelayedVestingAccount(acc, vestingCoins, end.Unix())
+			},
+			delegation:       15,
+			blockTime:        start,
+			expectedYesCount: "5",
+		},
+		{
+			name:            "continuous vesting, before the start",
+			excludeUnvested: true,
+			vestingAccount: func(acc *authtypes.BaseAccount) authtypes.AccountI {
+				return vestingtypes.NewContinuousVestingAccount(acc, vestingCoins, start.Unix(), end.Unix())
+			},
+			delegation:       10,
+			blockTime:        start.Add(-time.Second),
+			expectedYesCount: "0",
+		},
+		{
+			name:            "continuous vesting, halfway",
+			excludeUnvested: true,
+			vestingAccount: func(acc *authtypes.BaseAccount) authtypes.AccountI {
+				return vestingtypes.NewContinuousVestingAccount(acc, vestingCoins, start.Unix(), end.Unix())
+			},
+			delegation:       10,
+			blockTime:        start.Add(50 * time.Second),
+			expectedYesCount: "5",
+		},
+		{
+			name:            "continuous vesting, at the end",
+			excludeUnvested: true,
+			vestingAccount: func(acc *authtypes.BaseAccount) authtypes.AccountI {
+				return vestingtypes.NewContinuousVestingAccount(acc, vestingCoins, start.Unix(), end.Unix())
+			},
+			delegation:       10,
+			blockTime:        end,
+			expectedYesCount: "10",
+		},
+		{
+			name:            "base account",
+			excludeUnvested: true,
+			vestingAccount: func(acc *authtypes.BaseAccount) authtypes.AccountI {
+				return acc
+			},
+			delegation:       10,
+			blockTime:        start,
+			expectedYesCount: "10",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			ctx = ctx.WithBlockTime(tt.blockTime)
+			params := v1.DefaultParams()
+			params.ExcludeUnvestedVotingPower = tt.excludeUnvested
+			require.NoError(t, govKeeper.SetParams(ctx, params))
+
+			addrs := simtestutil.CreateRandomAccounts(2)
+			valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:1])
+			delAddr := addrs[1]
+			account := tt.vestingAccount(authtypes.NewBaseAccountWithAddress(delAddr))
+			mocks.acctKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(account).AnyTimes()
+			mocks.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("stake").AnyTimes()
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddr, nil, false)
+			require.NoError(t, err)
+			govKeeper.ActivateVotingPeriod(ctx, proposal)
+			s := newTallyFixture(t, ctx, proposal, valAddrs, []sdk.AccAddress{delAddr}, govKeeper, mocks)
+			s.delegate(delAddr, valAddrs[0], tt.delegation)
+			s.vote(delAddr, v1.OptionYes)
+
+			_, _, _, tally, _ := govKeeper.Tally(ctx, proposal)
+
+			assert.Equal(t, tt.expectedYesCount, tally.YesCount)
+		})
+	}
+}
+
+func TestTallyVestingAccountsQuorum(t *testing.T) {
+	start := time.Unix(1_000_000, 0).UTC()
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	ctx = ctx.WithBlockTime(start)
+	params := v1.DefaultParams()
+	params.ExcludeUnvestedVotingPower = true
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	addrs := simtestutil.CreateRandomAccounts(3)
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:1])
+	voter, nonVoter := addrs[1], addrs[2]
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	account := vestingtypes.NewDelayedVestingAccount(authtypes.NewBaseAccountWithAddress(voter), vestingCoins, start.Add(time.Hour).Unix())
+	mocks.acctKeeper.EXPECT().GetAccount(gomock.Any(), voter).Return(account).AnyTimes()
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", voter, nil, false)
+	require.NoError(t, err)
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	s := newTallyFixture(t, ctx, proposal, valAddrs, []sdk.AccAddress{voter, nonVoter}, govKeeper, mocks)
+	s.delegate(voter, valAddrs[0], 20)
+	s.delegate(nonVoter, valAddrs[0], 14)
+	s.vote(voter, v1.OptionYes)
+
+	// the 10 unvested stake of the voter is excluded from its voting power and
+	// from the 35 bonded, so that the 10 voting power reaches the quorum
+	pass, _, _, tally, _ := govKeeper.Tally(ctx, proposal)
+
+	assert.Equal(t, "10", tally.YesCount)
+	assert.True(t, pass)
+}
+
+func TestTallyJailedValidators(t *testing.T) {
+	tests := []struct {
+		name          string
+		excludeJailed bool
+		// delegation of the first delegator to the jailed validator, and of the
+		// second one to a bonded validator
+		jailedDelegation int64
+		bondedDelegation int64
+		// whether the first delegator votes Yes against the second one, or
+		// only the second one votes Yes
+		jailedVotes      bool
+		expectedPass     bool
+		expectedYesCount string
+	}{
+		{
+			name:             "jailed stake counted",
+			jailedDelegation: 10,
+			bondedDelegation: 5,
+			jailedVotes:      true,
+			expectedPass:     true,
+			expectedYesCount: "10",
+		},
+		{
+			name:             "jailed stake excluded from the tally",
+			excludeJailed:    true,
+			jailedDelegation: 10,
+			bondedDelegation: 5,
+			jailedVotes:      true,
+			expectedPass:     false,
+			expectedYesCount: "0",
+		},
+		{
+			name:             "jailed stake counted toward the quorum",
+			jailedDelegation: 100,
+			bondedDelegation: 10,
+			expectedPass:     false,
+			expectedYesCount: "10",
+		},
+		{
+			name:             "jailed stake excluded from the quorum",
+			excludeJailed:    true,
+			jailedDelegation: 100,
+			bondedDelegation: 10,
+			expectedPass:     true,
+			expectedYesCount: "10",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			params.ExcludeJailedValidatorsStake = tt.excludeJailed
+			require.NoError(t, govKeeper.SetParams(ctx, params))
+
+			addrs := simtestutil.CreateRandomAccounts(5)
+			valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:3])
+			delAddrs := addrs[3:]
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], nil, false)
+			require.NoError(t, err)
+			govKeeper.ActivateVotingPeriod(ctx, proposal)
+			s := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
+			// the first validator is jailed but not yet unbonded
+			s.validators[0].Jailed = true
+			s.delegate(delAddrs[0], valAddrs[0], tt.jailedDelegation)
+			s.delegate(delAddrs[1], valAddrs[1], tt.bondedDelegation)
+			if tt.jailedVotes {
+				s.vote(delAddrs[0], v1.OptionYes)
+				s.vote(delAddrs[1], v1.OptionNo)
+			} else {
+				s.vote(delAddrs[1], v1.OptionYes)
+			}
+
+			pass, _, _, tally, _ := govKeeper.Tally(ctx, proposal)
+
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedYesCount, tally.YesCount)
+		})
+	}
+}
+
+func TestTallyModuleAccountStake(t *testing.T) {
+	tests := []struct {
+		name string
+		rule v1.ModuleAccountStakeRule
+		// whether the module account votes No, besides the Yes of a delegator
+		moduleVotes          bool
+		expectedPass         bool
+		expectedYesCount     string
+		expectedAbstainCount string
+		expectedNoCount      string
+	}{
+		{
+			name:                 "counted as non-voting stake",
+			rule:                 v1.ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_COUNT,
+			expectedPass:         false,
+			expectedYesCount:     "10",
+			expectedAbstainCount: "0",
+			expectedNoCount:      "0",
+		},
+		{
+			name:                 "counted with its vote",
+			rule:                 v1.ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_COUNT,
+			moduleVotes:          true,
+			expectedPass:         false,
+			expectedYesCount:     "10",
+			expectedAbstainCount: "0",
+			expectedNoCount:      "100",
+		},
+		{
+			name:                 "force abstained",
+			rule:                 v1.ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_ABSTAIN,
+			expectedPass:         true,
+			expectedYesCount:     "10",
+			expectedAbstainCount: "100",
+			expectedNoCount:      "0",
+		},
+		{
+			name:                 "force abstained despite its vote",
+			rule:                 v1.ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_ABSTAIN,
+			moduleVotes:          true,
+			expectedPass:         true,
+			expectedYesCount:     "10",
+			expectedAbstainCount: "100",
+			expectedNoCount:      "0",
+		},
+		{
+			name:                 "excluded from the quorum",
+			rule:                 v1.ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_EXCLUDE,
+			moduleVotes:          true,
+			expectedPass:         true,
+			expectedYesCount:     "10",
+			expectedAbstainCount: "0",
+			expectedNoCount:      "0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			params.ModuleAccountStakeRule = tt.rule
+			require.NoError(t, govKeeper.SetParams(ctx, params))
+
+			moduleAccount := authtypes.NewPermissionsForAddress("strategic", nil)
+			mocks.acctKeeper.EXPECT().GetModulePermissions().
+				Return(map[string]authtypes.PermissionsForAddress{"strategic": moduleAccount}).AnyTimes()
+
+			addrs := simtestutil.CreateRandomAccounts(3)
+			valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs[:2])
+			delAddrs := []sdk.AccAddress{addrs[2], moduleAccount.GetAddress()}
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], nil, false)
+			require.NoError(t, err)
+			govKeeper.ActivateVotingPeriod(ctx, proposal)
+			s := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
+			s.delegate(delAddrs[0], valAddrs[0], 10)
+			s.delegate(delAddrs[1], valAddrs[1], 100)
+			s.vote(delAddrs[0], v1.OptionYes)
+			if tt.moduleVotes {
+				s.vote(delAddrs[1], v1.OptionNo)
+			}
+
+			pass, _, _, tally, _ := govKeeper.Tally(ctx, proposal)
+
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedYesCount, tally.YesCount)
+			assert.Equal(t, tt.expectedAbstainCount, tally.AbstainCount)
+			assert.Equal(t, tt.expectedNoCount, tally.NoCount)
+			// the vote of the module account is removed in any case
+			_, found := govKeeper.GetVote(ctx, proposal.Id, delAddrs[1])
+			assert.False(t, found)
+		})
+	}
+}
+
+func TestTallyQuadratic(t *testing.T) {
+	tests := []struct {
+		name                   string
+		quadratic              bool
+		quadraticCap           string
+		expectedPass           bool
+		expectedTally          v1.TallyResult
+		expectedQuadraticTally *v1.TallyResult
+	}{
+		{
+			name:         "stake tally: prop fails",
+			expectedPass: false,
+			expectedTally: v1.TallyResult{
+				YesCount:        "36",
+				AbstainCount:    "0",
+				NoCount:         "64",
+				NoWithVetoCount: "0",
+			},
+		},
+		{
+			name:         "quadratic tally: prop passes",
+			quadratic:    true,
+			expectedPass: true,
+			expectedTally: v1.TallyResult{
+				YesCount:        "36",
+				AbstainCount:    "0",
+				NoCount:         "64",
+				NoWithVetoCount: "0",
+			},
+			expectedQuadraticTally: &v1.TallyResult{
+				YesCount:        "12",
+				AbstainCount:    "0",
+				NoCount:         "8",
+				NoWithVetoCount: "0",
+			},
+		},
+		{
+			name:         "capped quadratic tally: prop passes",
+			quadratic:    true,
+			quadraticCap: "2",
+			expectedPass: true,
+			expectedTally: v1.TallyResult{
+				YesCount:        "36",
+				AbstainCount:    "0",
+				NoCount:         "64",
+				NoWithVetoCount: "0",
+			},
+			expectedQuadraticTally: &v1.TallyResult{
+				YesCount:        "8",
+				AbstainCount:    "0",
+				NoCount:         "2",
+				NoWithVetoCount: "0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			params.QuadraticVotingEnabled = true
+			params.QuadraticVotingPowerCap = tt.quadraticCap
+			require.NoError(t, govKeeper.SetParams(ctx, params))
+			var (
+				addrs    = simtestutil.CreateRandomAccounts(6)
+				valAddrs = simtestutil.ConvertAddrsToValAddrs(addrs[:1])
+				delAddrs = addrs[1:]
+			)
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], nil, false)
+			require.NoError(t, err)
+			proposal.Quadratic = tt.quadratic
+			govKeeper.ActivateVotingPeriod(ctx, proposal)
+			s := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
+			// a whale votes no, against four smaller delegators voting yes
+			s.delegate(delAddrs[0], valAddrs[0], 64)
+			s.vote(delAddrs[0], v1.OptionNo)
+			for _, delAddr := range delAddrs[1:] {
+				s.delegate(delAddr, valAddrs[0], 9)
+				s.vote(delAddr, v1.OptionYes)
+			}
+
+			pass, _, _, tally, quadraticTally := govKeeper.Tally(ctx, proposal)
+
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedTally, tally)
+			assert.Equal(t, tt.expectedQuadraticTally, quadraticTally)
+		})
+	}
+}
+
+func TestTallyLockedVotes(t *testing.T) {
+	tests := []struct {
+		name          string
+		lockPeriods   uint32
+		expectedPass  bool
+		expectedTally v1.TallyResult
+	}{
+		{
+			name:         "no lock: prop fails",
+			expectedPass: false,
+			expectedTally: v1.TallyResult{
+				YesCount:        "40",
+				AbstainCount:    "0",
+				NoCount:         "60",
+				NoWithVetoCount: "0",
+			},
+		},
+		{
+			name:         "locked for one period: prop passes",
+			lockPeriods:  1,
+			expectedPass: true,
+			expectedTally: v1.TallyResult{
+				YesCount:        "80",
+				AbstainCount:    "0",
+				NoCount:         "60",
+				NoWithVetoCount: "0",
+			},
+		},
+		{
+			name:         "locked for two periods: prop passes",
+			lockPeriods:  2,
+			expectedPass: true,
+			expectedTally: v1.TallyResult{
+				YesCount:        "120",
+				AbstainCount:    "0",
+				NoCount:         "60",
+				NoWithVetoCount: "0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			mocks.stakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(21 * 24 * time.Hour).AnyTimes()
+			params := v1.DefaultParams()
+			params.MaxVoteLockPeriods = 2
+			require.NoError(t, govKeeper.SetParams(ctx, params))
+			var (
+				addrs    = simtestutil.CreateRandomAccounts(3)
+				valAddrs = simtestutil.ConvertAddrsToValAddrs(addrs[:1])
+				delAddrs = addrs[1:]
+			)
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], nil, false)
+			require.NoError(t, err)
+			govKeeper.ActivateVotingPeriod(ctx, proposal)
+			s := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
+			s.delegate(delAddrs[0], valAddrs[0], 60)
+			s.vote(delAddrs[0], v1.OptionNo)
+			s.delegate(delAddrs[1], valAddrs[0], 40)
+			err = govKeeper.AddLockedVote(ctx, proposal.Id, delAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), "", tt.lockPeriods)
+			require.NoError(t, err)
+
+			pass, _, _, tally, _ := govKeeper.Tally(ctx, proposal)
+
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedTally, tally)
+		})
+	}
+}
+
+func TestGetTallyResult(t *testing.T) {
+	govKeeper, _, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.CreateRandomAccounts(1)
+
+	_, found := govKeeper.GetTallyResult(ctx, 1)
+	require.False(t, found)
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], nil, false)
+	require.NoError(t, err)
+	tally, found := govKeeper.GetTallyResult(ctx, proposal.Id)
+	require.True(t, found)
+	require.Equal(t, v1.EmptyTallyResult(), tally)
+
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	govKeeper.SetVote(ctx, v1.NewVote(proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	// the current tally leaves the votes in store
+	tally, found = govKeeper.GetTallyResult(ctx, proposal.Id)
+	require.True(t, found)
+	require.Equal(t, v1.EmptyTallyResult(), tally)
+	require.True(t, govKeeper.HasVoted(ctx, proposal.Id, addrs[0]))
+}
+
+// TestTallyDeterminism runs Tally on random delegations and votes, inserted in
+// a different order on two separate keepers, and checks that both results
+// match a naive reference tally.
+func TestTallyDeterminism(t *testing.T) {
+	options := []v1.VoteOption{v1.OptionYes, v1.OptionAbstain, v1.OptionNo, v1.OptionNoWithVeto}
+
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		var (
+			numVals       = 1 + r.Intn(5)
+			numDelegators = 1 + r.Intn(10)
+			addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+			valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+			delAddrs      = addrs[numVals:]
+		)
+
+		type delegation struct {
+			delegator sdk.AccAddress
+			validator sdk.ValAddress
+			amount    int64
+		}
+		var delegations []delegation
+		for _, del := range delAddrs {
+			for i := r.Intn(3); i >= 0; i-- {
+				delegations = append(delegations, delegation{del, valAddrs[r.Intn(numVals)], 1 + r.Int63n(1000)})
+			}
+		}
+
+		// validators vote with their self delegation of 1
+		votes := make(map[string]v1.WeightedVoteOptions)
+		for _, voter := range append(addrs[:numVals], delAddrs...) {
+			switch r.Intn(3) {
+			case 0:
+				// no vote
+			case 1:
+				votes[voter.String()] = v1.NewNonSplitVoteOption(options[r.Intn(len(options))])
+			default:
+				perm := r.Perm(len(options))
+				votes[voter.String()] = v1.WeightedVoteOptions{
+					v1.NewWeightedVoteOption(options[perm[0]], sdk.NewDecWithPrec(25, 2)),
+					v1.NewWeightedVoteOption(options[perm[1]], sdk.NewDecWithPrec(75, 2)),
+				}
+			}
+		}
+
+		// naive reference tally
+		results := map[v1.VoteOption]sdk.Dec{
+			v1.OptionYes:        sdk.ZeroDec(),
+			v1.OptionAbstain:    sdk.ZeroDec(),
+			v1.OptionNo:         sdk.ZeroDec(),
+			v1.OptionNoWithVeto: sdk.ZeroDec(),
+		}
+		addPower := func(voter sdk.AccAddress, power int64) {
+			for _, option := range votes[voter.String()] {
+				weight := sdk.MustNewDecFromStr(option.Weight)
+				results[option.Option] = results[option.Option].Add(weight.MulInt64(power))
+			}
+		}
+		for _, val := range valAddrs {
+			addPower(sdk.AccAddress(val), 1)
+		}
+		for _, d := range delegations {
+			addPower(d.delegator, d.amount)
+		}
+		expectedTally := v1.NewTallyResultFromMap(results)
+
+		tally := func(perm []int) v1.TallyResult {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], nil, false)
+			require.NoError(t, err)
+			govKeeper.ActivateVotingPeriod(ctx, proposal)
+
+			s := newTallyFixture(t, ctx, proposal, valAddrs, delAddrs, govKeeper, mocks)
+			for _, i := range perm {
+				d := delegations[i]
+				s.delegate(d.delegator, d.validator, d.amount)
+			}
+			for _, voter := range r.Perm(len(addrs)) {
+				if options, ok := votes[addrs[voter].String()]; ok {
+					err := govKeeper.AddVote(ctx, proposal.Id, addrs[voter], options, "")
+					require.NoError(t, err)
+				}
+			}
+
+			_, _, _, tallyResult, _ := govKeeper.Tally(ctx, proposal)
+			return tallyResult
+		}
+
+		first := tally(r.Perm(len(delegations)))
+		second := tally(r.Perm(len(delegations)))
+		require.Equal(t, expectedTally, first, "seed %d", seed)
+		require.Equal(t, first, second, "seed %d", seed)
+	}
+}
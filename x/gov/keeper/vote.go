@@ -4,8 +4,11 @@ import (
 	"fmt"
 
 	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
@@ -13,12 +16,29 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string) error {
+	return keeper.AddLockedVote(ctx, proposalID, voterAddr, options, metadata, 0)
+}
+
+// AddLockedVote adds a vote on a specific proposal, locking the delegation
+// shares of the voter for lockPeriods unbonding periods to boost its voting
+// power.
+func (keeper Keeper) AddLockedVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string, lockPeriods uint32) error {
 	// Check if proposal is in voting period.
 	store := ctx.KVStore(keeper.storeKey)
 	if !store.Has(types.VotingPeriodProposalKey(proposalID)) {
 		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
+	// the voting period may have ended without the proposal being tallied yet,
+	// when the EndBlocker left it over for the next blocks
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if proposal.VotingEndTime != nil && ctx.BlockTime().After(*proposal.VotingEndTime) {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d: voting period ended", proposalID)
+	}
+
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return err
@@ -30,8 +50,14 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		}
 	}
 
+	if err := keeper.lockVote(ctx, voterAddr, lockPeriods); err != nil {
+		return err
+	}
+
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
+	vote.LockPeriods = lockPeriods
 	keeper.SetVote(ctx, vote)
+	telemetry.IncrCounter(1, types.ModuleName, types.MetricKeyVotes)
 
 	// called after a vote on a proposal is cast
 	keeper.Hooks().AfterProposalVote(ctx, proposalID, voterAddr)
@@ -42,12 +68,44 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 			sdk.NewAttribute(types.AttributeKeyVoter, voterAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyVotingPower, keeper.GetVotingPower(ctx, voterAddr, lockPeriods).String()),
 		),
 	)
 
 	return nil
 }
 
+// GetVotingPower returns the current voting power of a vote of an address
+// locking its stake for lockPeriods, as counted by Tally: the voting power of
+// its delegations to the bonded validators counted in the tally, minus its
+// unvested stake if excluded, plus the boost of its locked shares. The votes
+// of the module accounts have no voting power, unless their stake is counted
+// by the ModuleAccountStakeRule.
+func (keeper Keeper) GetVotingPower(ctx sdk.Context, voterAddr sdk.AccAddress, lockPeriods uint32) sdk.Dec {
+	params := keeper.GetParams(ctx)
+	if params.ModuleAccountStakeRule != v1.ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_COUNT {
+		for _, addr := range keeper.moduleAccountAddresses() {
+			if addr.Equals(voterAddr) {
+				return math.LegacyZeroDec()
+			}
+		}
+	}
+
+	validator := func(valAddr string) (stakingtypes.ValidatorI, bool) {
+		addr, err := sdk.ValAddressFromBech32(valAddr)
+		if err != nil {
+			return nil, false
+		}
+		val := keeper.sk.Validator(ctx, addr)
+		if val == nil || !val.IsBonded() || (params.ExcludeJailedValidatorsStake && val.IsJailed()) {
+			return nil, false
+		}
+		return val, true
+	}
+	votingPower, boost, _ := keeper.voterVotingPower(ctx, params, voterAddr, lockPeriods, validator)
+	return votingPower.Add(boost)
+}
+
 // GetAllVotes returns all the votes from the store
 func (keeper Keeper) GetAllVotes(ctx sdk.Context) (votes v1.Votes) {
 	keeper.IterateAllVotes(ctx, func(vote v1.Vote) bool {
@@ -86,6 +144,31 @@ func (keeper Keeper) SetVote(ctx sdk.Context, vote v1.Vote) {
 	addr := sdk.MustAccAddressFromBech32(vote.Voter)
 
 	store.Set(types.VoteKey(vote.ProposalId, addr), bz)
+	store.Set(types.VoteByVoterKey(addr, vote.ProposalId), []byte{1})
+}
+
+// HasVoted returns true if the address has a vote on the proposal in store.
+func (keeper Keeper) HasVoted(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Has(types.VoteKey(proposalID, voterAddr))
+}
+
+// IterateVotesByVoter iterates over the IDs of the proposals the address has a
+// vote on in store, using the votes by voter index, and performs a callback
+// function.
+func (keeper Keeper) IterateVotesByVoter(ctx sdk.Context, voterAddr sdk.AccAddress, cb func(proposalID uint64) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := types.VotesByVoterKey(voterAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID := types.GetProposalIDFromBytes(iterator.Key()[len(prefix):])
+
+		if cb(proposalID) {
+			break
+		}
+	}
 }
 
 // IterateAllVotes iterates over all the stored votes and performs a callback function
@@ -124,4 +207,5 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
 	store := ctx.KVStore(keeper.storeKey)
 	store.Delete(types.VoteKey(proposalID, voterAddr))
+	store.Delete(types.VoteByVoterKey(voterAddr, proposalID))
 }
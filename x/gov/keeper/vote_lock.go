@@ -0,0 +1,312 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// GetVoteLock gets the vote lock of a voter from store.
+func (keeper Keeper) GetVoteLock(ctx sdk.Context, voterAddr sdk.AccAddress) (v1.VoteLock, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.VoteLockKey(voterAddr))
+	if bz == nil {
+		return v1.VoteLock{}, false
+	}
+
+	var lock v1.VoteLock
+	keeper.cdc.MustUnmarshal(bz, &lock)
+	return lock, true
+}
+
+// SetVoteLock sets a vote lock to store.
+func (keeper Keeper) SetVoteLock(ctx sdk.Context, lock v1.VoteLock) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&lock)
+	store.Set(types.VoteLockKey(sdk.MustAccAddressFromBech32(lock.Voter)), bz)
+}
+
+// RemoveVoteLock removes the vote lock of a voter from store.
+func (keeper Keeper) RemoveVoteLock(ctx sdk.Context, voterAddr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.VoteLockKey(voterAddr))
+}
+
+// IterateVoteLocks iterates over all the vote locks and performs a callback
+// function.
+func (keeper Keeper) IterateVoteLocks(ctx sdk.Context, cb func(lock v1.VoteLock) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteLocksKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var lock v1.VoteLock
+		keeper.cdc.MustUnmarshal(iterator.Value(), &lock)
+
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// InsertVoteLockQueue inserts a voter into the vote lock queue at endTime
+func (keeper Keeper) InsertVoteLockQueue(ctx sdk.Context, voterAddr sdk.AccAddress, endTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.VoteLockQueueKey(voterAddr, endTime), voterAddr)
+}
+
+// RemoveFromVoteLockQueue removes a voter from the vote lock queue
+func (keeper Keeper) RemoveFromVoteLockQueue(ctx sdk.Context, voterAddr sdk.AccAddress, endTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.VoteLockQueueKey(voterAddr, endTime))
+}
+
+// IterateVoteLocksQueue iterates over the vote locks in the vote lock queue
+// that end by endTime and performs a callback function
+func (keeper Keeper) IterateVoteLocksQueue(ctx sdk.Context, endTime time.Time, cb func(lock v1.VoteLock) (stop bool)) {
+	iterator := keeper.VoteLockQueueIterator(ctx, endTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		voterAddr, _ := types.SplitVoteLockQueueKey(iterator.Key())
+		lock, found := keeper.GetVoteLock(ctx, voterAddr)
+		if !found {
+			panic(fmt.Sprintf("vote lock of %s does not exist", voterAddr))
+		}
+
+		if cb(lock) {
+			break
+		}
+	}
+}
+
+// VoteLockQueueIterator returns an sdk.Iterator for all the vote locks in the
+// vote lock queue that end by endTime
+func (keeper Keeper) VoteLockQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.VoteLockQueuePrefix, sdk.PrefixEndBytes(types.VoteLockByTimeKey(endTime)))
+}
+
+// lockVote locks the delegation shares of a voter for lockPeriods unbonding
+// periods. An existing lock of the voter is extended: it keeps the highest
+// shares of each validator and the latest end time.
+func (keeper Keeper) lockVote(ctx sdk.Context, voterAddr sdk.AccAddress, lockPeriods uint32) error {
+	if lockPeriods == 0 {
+		return nil
+	}
+
+	maxLockPeriods := keeper.GetParams(ctx).MaxVoteLockPeriods
+	if lockPeriods > maxLockPeriods {
+		return sdkerrors.Wrapf(types.ErrInvalidVote, "lock periods %d exceed the max vote lock periods %d", lockPeriods, maxLockPeriods)
+	}
+
+	endTime := ctx.BlockTime().Add(time.Duration(lockPeriods) * keeper.sk.UnbondingTime(ctx))
+	shares := make(map[string]sdk.Dec)
+
+	lock, found := keeper.GetVoteLock(ctx, voterAddr)
+	if found {
+		keeper.RemoveFromVoteLockQueue(ctx, voterAddr, *lock.EndTime)
+		// the shares of a lock that ended but wasn't removed yet are unlocked
+		if ctx.BlockTime().Before(*lock.EndTime) {
+			if lock.EndTime.After(endTime) {
+				endTime = *lock.EndTime
+			}
+			for _, locked := range lock.Shares {
+				shares[locked.ValidatorAddress] = math.LegacyMustNewDecFromStr(locked.Shares)
+			}
+		}
+	}
+
+	keeper.sk.IterateDelegations(ctx, voterAddr, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		valAddrStr := delegation.GetValidatorAddr().String()
+		if locked, ok := shares[valAddrStr]; !ok || delegation.GetShares().GT(locked) {
+			shares[valAddrStr] = delegation.GetShares()
+		}
+		return false
+	})
+	if len(shares) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidVote, "no delegation to lock")
+	}
+
+	valAddrs := make([]string, 0, len(shares))
+	for valAddr := range shares {
+		valAddrs = append(valAddrs, valAddr)
+	}
+	sort.Strings(valAddrs)
+
+	lock = v1.VoteLock{
+		Voter:   voterAddr.String(),
+		EndTime: &endTime,
+		Shares:  make([]*v1.LockedShares, len(valAddrs)),
+	}
+	for i, valAddr := range valAddrs {
+		lock.Shares[i] = &v1.LockedShares{ValidatorAddress: valAddr, Shares: shares[valAddr].String()}
+	}
+	keeper.SetVoteLock(ctx, lock)
+	keeper.InsertVoteLockQueue(ctx, voterAddr, endTime)
+
+	return nil
+}
+
+// lockedVotingPower returns the voting power of the shares locked by a voter
+// on the validators counted in the tally, returned by validator, or zero if
+// the voter has no lock in force.
+func (keeper Keeper) lockedVotingPower(ctx sdk.Context, voterAddr sdk.AccAddress, validator func(valAddr string) (stakingtypes.ValidatorI, bool)) sdk.Dec {
+	votingPower := math.LegacyZeroDec()
+
+	lock, found := keeper.GetVoteLock(ctx, voterAddr)
+	if !found || !ctx.BlockTime().Before(*lock.EndTime) {
+		return votingPower
+	}
+
+	for _, locked := range lock.Shares {
+		val, ok := validator(locked.ValidatorAddress)
+		if !ok {
+			continue
+		}
+
+		// locked shares * bonded / total shares
+		votingPower = votingPower.Add(math.LegacyMustNewDecFromStr(locked.Shares).MulInt(val.GetBondedTokens()).Quo(val.GetDelegatorShares()))
+	}
+
+	return votingPower
+}
+
+// CheckVoteLocks returns an error if the undelegations and redelegations of
+// msgs would reduce the delegation shares of a voter on a validator below the
+// shares locked by its votes. The unbonding delegations canceled by msgs are
+// counted as delegated back. The locks are checked by the ante handler rather
+// than by the staking hooks, as the involuntary reductions of the shares, like
+// the slashing of a redelegation, must not fail.
+func (keeper Keeper) CheckVoteLocks(ctx sdk.Context, msgs []sdk.Msg) error {
+	type delegation struct {
+		delAddr string
+		valAddr string
+	}
+	var delegations []delegation
+	changes := make(map[delegation]sdk.Dec)
+	addChange := func(delAddrStr, valAddrStr string, amount math.Int, undelegated bool) {
+		delAddr, err := sdk.AccAddressFromBech32(delAddrStr)
+		if err != nil {
+			return
+		}
+		if lock, found := keeper.GetVoteLock(ctx, delAddr); !found || !ctx.BlockTime().Before(*lock.EndTime) {
+			return
+		}
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		if err != nil {
+			return
+		}
+		validator := keeper.sk.Validator(ctx, valAddr)
+		if validator == nil {
+			return
+		}
+		// the invalid amounts are rejected by the staking msg server
+		shares, err := validator.SharesFromTokens(amount)
+		if err != nil {
+			return
+		}
+		if undelegated {
+			shares = shares.Neg()
+		}
+
+		d := delegation{delAddr: delAddrStr, valAddr: valAddrStr}
+		if change, ok := changes[d]; ok {
+			changes[d] = change.Add(shares)
+			return
+		}
+		delegations = append(delegations, d)
+		changes[d] = shares
+	}
+
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgUndelegate:
+			addChange(msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount.Amount, true)
+		case *stakingtypes.MsgBeginRedelegate:
+			addChange(msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.Amount.Amount, true)
+		case *stakingtypes.MsgCancelUnbondingDelegation:
+			addChange(msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount.Amount, false)
+		}
+	}
+
+	for _, d := range delegations {
+		delAddr := sdk.MustAccAddressFromBech32(d.delAddr)
+		valAddr, _ := sdk.ValAddressFromBech32(d.valAddr)
+		shares := math.LegacyZeroDec()
+		if delegation := keeper.sk.Delegation(ctx, delAddr, valAddr); delegation != nil {
+			shares = delegation.GetShares()
+		}
+		if err := keeper.assertSharesUnlocked(ctx, delAddr, valAddr, shares.Add(changes[d])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// shrinkVoteLock lowers the shares locked by a voter on a validator to its
+// delegation shares, when they were reduced below the locked ones.
+func (keeper Keeper) shrinkVoteLock(ctx sdk.Context, voterAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) {
+	lock, found := keeper.GetVoteLock(ctx, voterAddr)
+	if !found || !ctx.BlockTime().Before(*lock.EndTime) {
+		return
+	}
+
+	lockedShares := make([]*v1.LockedShares, 0, len(lock.Shares))
+	shrunk := false
+	for _, locked := range lock.Shares {
+		if locked.ValidatorAddress == valAddr.String() && shares.LT(math.LegacyMustNewDecFromStr(locked.Shares)) {
+			shrunk = true
+			if !shares.IsPositive() {
+				continue
+			}
+			locked = &v1.LockedShares{ValidatorAddress: locked.ValidatorAddress, Shares: shares.String()}
+		}
+		lockedShares = append(lockedShares, locked)
+	}
+	if !shrunk {
+		return
+	}
+
+	if len(lockedShares) == 0 {
+		keeper.RemoveVoteLock(ctx, voterAddr)
+		keeper.RemoveFromVoteLockQueue(ctx, voterAddr, *lock.EndTime)
+		return
+	}
+	lock.Shares = lockedShares
+	keeper.SetVoteLock(ctx, lock)
+}
+
+// assertSharesUnlocked returns an error if the delegation shares of a voter
+// on a validator would drop below the shares locked by its votes.
+func (keeper Keeper) assertSharesUnlocked(ctx sdk.Context, voterAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	lock, found := keeper.GetVoteLock(ctx, voterAddr)
+	if !found || !ctx.BlockTime().Before(*lock.EndTime) {
+		return nil
+	}
+
+	for _, locked := range lock.Shares {
+		if locked.ValidatorAddress != valAddr.String() {
+			continue
+		}
+
+		lockedShares := math.LegacyMustNewDecFromStr(locked.Shares)
+		if shares.LT(lockedShares) {
+			return sdkerrors.Wrapf(types.ErrVoteLocked, "%s shares of %s locked until %s", lockedShares, valAddr, lock.EndTime)
+		}
+	}
+
+	return nil
+}
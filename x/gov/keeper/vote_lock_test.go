@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestVoteLock(t *testing.T) {
+	const unbondingTime = 21 * 24 * time.Hour
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	mocks.stakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime).AnyTimes()
+	params := v1.DefaultParams()
+	params.MaxVoteLockPeriods = 2
+	require.NoError(t, govKeeper.SetParams(ctx, params))
+
+	var (
+		addrs    = simtestutil.CreateRandomAccounts(3)
+		valAddrs = simtestutil.ConvertAddrsToValAddrs(addrs[:2])
+		voter    = addrs[2]
+	)
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", voter, nil, false)
+	require.NoError(t, err)
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+	s := newTallyFixture(t, ctx, proposal, valAddrs, []sdk.AccAddress{voter}, govKeeper, mocks)
+	s.delegate(voter, valAddrs[0], 10)
+	s.delegate(voter, valAddrs[1], 5)
+
+	// lock periods above the max are rejected
+	err = govKeeper.AddLockedVote(ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(v1.OptionYes), "", 3)
+	require.ErrorIs(t, err, types.ErrInvalidVote)
+	_, found := govKeeper.GetVoteLock(ctx, voter)
+	require.False(t, found)
+
+	// the delegation shares are locked for a single unbonding period
+	err = govKeeper.AddLockedVote(ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(v1.OptionYes), "", 1)
+	require.NoError(t, err)
+	vote, found := govKeeper.GetVote(ctx, proposal.Id, voter)
+	require.True(t, found)
+	require.EqualValues(t, 1, vote.LockPeriods)
+	lock, found := govKeeper.GetVoteLock(ctx, voter)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(unbondingTime), *lock.EndTime)
+	require.Len(t, lock.Shares, 2)
+	for _, locked := range lock.Shares {
+		shares := sdkmath.LegacyMustNewDecFromStr(locked.Shares)
+		switch locked.ValidatorAddress {
+		case valAddrs[0].String():
+			require.Equal(t, sdkmath.LegacyNewDec(10), shares)
+		case valAddrs[1].String():
+			require.Equal(t, sdkmath.LegacyNewDec(5), shares)
+		default:
+			t.Fatalf("unexpected locked validator %s", locked.ValidatorAddress)
+		}
+	}
+
+	// voting again with more lock periods extends the lock
+	err = govKeeper.AddLockedVote(ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(v1.OptionNo), "", 2)
+	require.NoError(t, err)
+	lock, found = govKeeper.GetVoteLock(ctx, voter)
+	require.True(t, found)
+	endTime := ctx.BlockTime().Add(2 * unbondingTime)
+	require.Equal(t, endTime, *lock.EndTime)
+	// the voting power of the vote includes the boost of the locked shares
+	require.Equal(t, sdkmath.LegacyNewDec(45), govKeeper.GetVotingPower(ctx, voter, 2))
+	var queued []v1.VoteLock
+	govKeeper.IterateVoteLocksQueue(ctx, endTime.Add(-time.Second), func(lock v1.VoteLock) bool {
+		queued = append(queued, lock)
+		return false
+	})
+	require.Empty(t, queued)
+	govKeeper.IterateVoteLocksQueue(ctx, endTime, func(lock v1.VoteLock) bool {
+		queued = append(queued, lock)
+		return false
+	})
+	require.Equal(t, []v1.VoteLock{lock}, queued)
+
+	// the locked shares can't be undelegated nor redelegated until the lock
+	// ends
+	delegationShares := map[string]sdk.Dec{
+		valAddrs[0].String(): sdkmath.LegacyNewDec(10),
+		valAddrs[1].String(): sdkmath.LegacyNewDec(5),
+	}
+	mocks.stakingKeeper.EXPECT().Delegation(gomock.Any(), voter, gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) stakingtypes.DelegationI {
+			return stakingtypes.Delegation{Shares: delegationShares[valAddr.String()]}
+		}).AnyTimes()
+	coin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)
+	}
+	err = govKeeper.CheckVoteLocks(ctx, []sdk.Msg{stakingtypes.NewMsgUndelegate(voter, valAddrs[0], coin(1))})
+	require.ErrorIs(t, err, types.ErrVoteLocked)
+	err = govKeeper.CheckVoteLocks(ctx, []sdk.Msg{stakingtypes.NewMsgBeginRedelegate(voter, valAddrs[1], valAddrs[0], coin(5))})
+	require.ErrorIs(t, err, types.ErrVoteLocked)
+	err = govKeeper.CheckVoteLocks(ctx.WithBlockTime(endTime), []sdk.Msg{stakingtypes.NewMsgUndelegate(voter, valAddrs[0], coin(10))})
+	require.NoError(t, err)
+
+	// the undelegations are summed, and the canceled unbonding delegations
+	// are delegated back
+	err = govKeeper.CheckVoteLocks(ctx, []sdk.Msg{
+		stakingtypes.NewMsgCancelUnbondingDelegation(voter, valAddrs[0], 1, coin(3)),
+		stakingtypes.NewMsgUndelegate(voter, valAddrs[0], coin(2)),
+	})
+	require.NoError(t, err)
+	err = govKeeper.CheckVoteLocks(ctx, []sdk.Msg{
+		stakingtypes.NewMsgCancelUnbondingDelegation(voter, valAddrs[0], 1, coin(3)),
+		stakingtypes.NewMsgUndelegate(voter, valAddrs[0], coin(2)),
+		stakingtypes.NewMsgUndelegate(voter, valAddrs[0], coin(2)),
+	})
+	require.ErrorIs(t, err, types.ErrVoteLocked)
+
+	// other delegators are not locked
+	err = govKeeper.CheckVoteLocks(ctx, []sdk.Msg{stakingtypes.NewMsgUndelegate(addrs[0], valAddrs[0], coin(1))})
+	require.NoError(t, err)
+
+	// an involuntary reduction of the shares, like a slash, shrinks the lock
+	// without failing
+	hooks := govKeeper.StakingHooks()
+	delegationShares[valAddrs[0].String()] = sdkmath.LegacyNewDec(4)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, voter, valAddrs[0]))
+	require.NoError(t, hooks.BeforeDelegationRemoved(ctx, voter, valAddrs[1]))
+	lock, found = govKeeper.GetVoteLock(ctx, voter)
+	require.True(t, found)
+	require.Equal(t, []*v1.LockedShares{{ValidatorAddress: valAddrs[0].String(), Shares: sdkmath.LegacyNewDec(4).String()}}, lock.Shares)
+
+	// the voting power of the remaining locked shares is tripled
+	_, _, _, tally, _ := govKeeper.Tally(ctx, proposal)
+	require.Equal(t, "23", tally.NoCount)
+}
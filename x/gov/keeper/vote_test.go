@@ -3,13 +3,16 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 )
 
@@ -19,7 +22,7 @@ func TestVotes(t *testing.T) {
 	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	metadata := "metadata"
@@ -89,4 +92,71 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, votes[1].Options[1].Weight, sdk.NewDecWithPrec(30, 2).String())
 	require.Equal(t, votes[1].Options[2].Weight, sdk.NewDecWithPrec(5, 2).String())
 	require.Equal(t, votes[1].Options[3].Weight, sdk.NewDecWithPrec(5, 2).String())
+
+	// Test votes by voter index
+	require.True(t, govKeeper.HasVoted(ctx, proposalID, addrs[0]))
+	require.False(t, govKeeper.HasVoted(ctx, proposalID+1, addrs[0]))
+	var votedProposalIDs []uint64
+	govKeeper.IterateVotesByVoter(ctx, addrs[1], func(proposalID uint64) bool {
+		votedProposalIDs = append(votedProposalIDs, proposalID)
+		return false
+	})
+	require.Equal(t, []uint64{proposalID}, votedProposalIDs)
+}
+
+func TestVoteEventVotingPower(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	addrs := simtestutil.CreateRandomAccounts(3)
+	voter := addrs[0]
+	bondedVal := stakingtypes.Validator{
+		OperatorAddress: sdk.ValAddress(addrs[1]).String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdkmath.NewInt(20),
+		DelegatorShares: sdkmath.LegacyNewDec(10),
+	}
+	unbondedVal := stakingtypes.Validator{
+		OperatorAddress: sdk.ValAddress(addrs[2]).String(),
+		Status:          stakingtypes.Unbonded,
+		Tokens:          sdkmath.NewInt(10),
+		DelegatorShares: sdkmath.LegacyNewDec(10),
+	}
+	delegations := []stakingtypes.Delegation{
+		stakingtypes.NewDelegation(voter, bondedVal.GetOperator(), sdkmath.LegacyNewDec(3)),
+		stakingtypes.NewDelegation(voter, unbondedVal.GetOperator(), sdkmath.LegacyNewDec(5)),
+	}
+	mocks.stakingKeeper.EXPECT().
+		IterateDelegations(gomock.Any(), voter, gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
+			for i, d := range delegations {
+				fn(int64(i), d)
+			}
+		})
+	mocks.stakingKeeper.EXPECT().
+		Validator(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, valAddr sdk.ValAddress) stakingtypes.ValidatorI {
+			if valAddr.Equals(bondedVal.GetOperator()) {
+				return bondedVal
+			}
+			return unbondedVal
+		}).Times(2)
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", voter, nil, false)
+	require.NoError(t, err)
+	govKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, voter, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeProposalVote {
+			continue
+		}
+		attr, ok := event.GetAttribute(types.AttributeKeyVotingPower)
+		require.True(t, ok)
+		// only the delegation to the bonded validator counts: 3 shares * 20 tokens / 10 shares
+		require.Equal(t, sdkmath.LegacyNewDec(6).String(), attr.Value)
+		found = true
+	}
+	require.True(t, found, "proposal_vote event not emitted")
 }
@@ -0,0 +1,38 @@
+package fromsdk
+
+import (
+	"encoding/json"
+)
+
+// MigrateJSON converts an exported stock cosmos-sdk v0.47 x/gov genesis state
+// to the AtomOne x/gov genesis state. As for the store migration, the two
+// genesis states only differ by the type URLs of the x/gov messages and legacy
+// contents held by the proposals.
+func MigrateJSON(govGenState json.RawMessage) (json.RawMessage, error) {
+	var genState interface{}
+	if err := json.Unmarshal(govGenState, &genState); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(convertTypeURLs(genState))
+}
+
+// convertTypeURLs walks a decoded JSON value and converts the type URLs held
+// by the "@type" fields of its objects.
+func convertTypeURLs(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if typeURL, ok := field.(string); ok && key == "@type" {
+				v[key] = ConvertTypeURL(typeURL)
+				continue
+			}
+			v[key] = convertTypeURLs(field)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = convertTypeURLs(elem)
+		}
+	}
+	return value
+}
@@ -0,0 +1,104 @@
+package fromsdk_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/atomone-hub/atomone/x/gov/migrations/fromsdk"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
+)
+
+func TestConvertTypeURL(t *testing.T) {
+	require.Equal(t, "/atomone.gov.v1.MsgVote", fromsdk.ConvertTypeURL("/cosmos.gov.v1.MsgVote"))
+	require.Equal(t, "/atomone.gov.v1beta1.TextProposal", fromsdk.ConvertTypeURL("/cosmos.gov.v1beta1.TextProposal"))
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", fromsdk.ConvertTypeURL("/cosmos.bank.v1beta1.MsgSend"))
+}
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	registry := codectypes.NewInterfaceRegistry()
+	v1.RegisterInterfaces(registry)
+	v1beta1.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	// a proposal as stored by the cosmos-sdk x/gov module
+	content, err := (&v1beta1.TextProposal{Title: "title", Description: "description"}).Marshal()
+	require.NoError(t, err)
+	execMsg, err := (&v1.MsgExecLegacyContent{
+		Content:   &codectypes.Any{TypeUrl: "/cosmos.gov.v1beta1.TextProposal", Value: content},
+		Authority: "authority",
+	}).Marshal()
+	require.NoError(t, err)
+	sendMsg, err := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil).Marshal()
+	require.NoError(t, err)
+	proposal, err := (&v1.Proposal{
+		Id: 1,
+		Messages: []*codectypes.Any{
+			{TypeUrl: "/cosmos.gov.v1.MsgExecLegacyContent", Value: execMsg},
+			{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: sendMsg},
+		},
+		Status: v1.StatusPassed,
+	}).Marshal()
+	require.NoError(t, err)
+	store.Set(types.ProposalKey(1), proposal)
+
+	require.NoError(t, fromsdk.MigrateStore(ctx, storeKey))
+
+	var migrated v1.Proposal
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ProposalKey(1)), &migrated))
+	msgs, err := migrated.GetMsgs()
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+
+	legacyContent, err := v1.LegacyContentFromMessage(msgs[0].(*v1.MsgExecLegacyContent))
+	require.NoError(t, err)
+	require.Equal(t, v1beta1.NewTextProposal("title", "description"), legacyContent)
+	require.IsType(t, &banktypes.MsgSend{}, msgs[1])
+}
+
+func TestMigrateJSON(t *testing.T) {
+	genState := json.RawMessage(`{
+		"starting_proposal_id": "2",
+		"proposals": [{
+			"id": "1",
+			"messages": [{
+				"@type": "/cosmos.gov.v1.MsgExecLegacyContent",
+				"content": {"@type": "/cosmos.gov.v1beta1.TextProposal", "title": "title", "description": "description"},
+				"authority": "cosmos1..."
+			}, {
+				"@type": "/cosmos.bank.v1beta1.MsgSend"
+			}]
+		}]
+	}`)
+
+	migrated, err := fromsdk.MigrateJSON(genState)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"starting_proposal_id": "2",
+		"proposals": [{
+			"id": "1",
+			"messages": [{
+				"@type": "/atomone.gov.v1.MsgExecLegacyContent",
+				"content": {"@type": "/atomone.gov.v1beta1.TextProposal", "title": "title", "description": "description"},
+				"authority": "cosmos1..."
+			}, {
+				"@type": "/cosmos.bank.v1beta1.MsgSend"
+			}]
+		}]
+	}`, string(migrated))
+}
@@ -0,0 +1,88 @@
+package fromsdk
+
+import (
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+const (
+	sdkTypeURLPrefix     = "/cosmos.gov."
+	atomoneTypeURLPrefix = "/atomone.gov."
+)
+
+// ConvertTypeURL converts the type URL of a stock cosmos-sdk x/gov type to the
+// type URL of its AtomOne counterpart. Other type URLs are returned unchanged.
+func ConvertTypeURL(typeURL string) string {
+	if !strings.HasPrefix(typeURL, sdkTypeURLPrefix) {
+		return typeURL
+	}
+	return atomoneTypeURLPrefix + strings.TrimPrefix(typeURL, sdkTypeURLPrefix)
+}
+
+// MigrateStore performs in-place store migrations from the stock cosmos-sdk
+// v0.47 x/gov state (consensus version 4) to the AtomOne x/gov state. The two
+// store layouts are wire compatible, so the migration only converts the type
+// URLs of the x/gov messages and legacy contents held by the proposals, which
+// are not registered under their cosmos-sdk names in AtomOne.
+//
+// The secondary indexes of AtomOne x/gov are not part of this migration, they
+// must be rebuilt by the keeper afterwards.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposalsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// unmarshal without unpacking the messages, as their cosmos-sdk type
+		// URLs can't be resolved.
+		var proposal v1.Proposal
+		if err := proposal.Unmarshal(iterator.Value()); err != nil {
+			return err
+		}
+
+		for _, msg := range proposal.Messages {
+			if err := convertAny(msg); err != nil {
+				return err
+			}
+		}
+
+		bz, err := proposal.Marshal()
+		if err != nil {
+			return err
+		}
+		store.Set(iterator.Key(), bz)
+	}
+
+	return nil
+}
+
+// convertAny converts the type URL of a proposal message, and the one of its
+// legacy content if the message is a MsgExecLegacyContent.
+func convertAny(msg *codectypes.Any) error {
+	msg.TypeUrl = ConvertTypeURL(msg.TypeUrl)
+	if msg.TypeUrl != sdk.MsgTypeURL(&v1.MsgExecLegacyContent{}) {
+		return nil
+	}
+
+	var execMsg v1.MsgExecLegacyContent
+	if err := execMsg.Unmarshal(msg.Value); err != nil {
+		return err
+	}
+	if execMsg.Content != nil {
+		execMsg.Content.TypeUrl = ConvertTypeURL(execMsg.Content.TypeUrl)
+	}
+
+	bz, err := execMsg.Marshal()
+	if err != nil {
+		return err
+	}
+	msg.Value = bz
+	return nil
+}
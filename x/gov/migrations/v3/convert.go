@@ -19,7 +19,7 @@ func ConvertToLegacyProposal(proposal v1.Proposal) (v1beta1.Proposal, error) {
 	var err error
 	legacyProposal := v1beta1.Proposal{
 		ProposalId:   proposal.Id,
-		Status:       v1beta1.ProposalStatus(proposal.Status),
+		Status:       ConvertToLegacyProposalStatus(proposal.Status),
 		TotalDeposit: types.NewCoins(proposal.TotalDeposit...),
 	}
 
@@ -69,6 +69,21 @@ func ConvertToLegacyProposal(proposal v1.Proposal) (v1beta1.Proposal, error) {
 	return legacyProposal, err
 }
 
+// ConvertToLegacyProposalStatus converts a proposal status to the legacy
+// proposal status. The statuses without a legacy equivalent are mapped to the
+// closest legacy one: a proposal in review period waits for its voting period
+// like in deposit period, and a queued proposal has passed.
+func ConvertToLegacyProposalStatus(status v1.ProposalStatus) v1beta1.ProposalStatus {
+	switch status {
+	case v1.StatusReviewPeriod:
+		return v1beta1.StatusDepositPeriod
+	case v1.StatusQueued:
+		return v1beta1.StatusPassed
+	default:
+		return v1beta1.ProposalStatus(status)
+	}
+}
+
 func ConvertToLegacyTallyResult(tally *v1.TallyResult) (v1beta1.TallyResult, error) {
 	yes, ok := types.NewIntFromString(tally.YesCount)
 	if !ok {
@@ -0,0 +1,61 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	v3 "github.com/atomone-hub/atomone/x/gov/migrations/v3"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
+)
+
+func TestConvertToLegacyProposalStatus(t *testing.T) {
+	tests := []struct {
+		status         v1.ProposalStatus
+		expectedStatus v1beta1.ProposalStatus
+	}{
+		{v1.StatusNil, v1beta1.StatusNil},
+		{v1.StatusDepositPeriod, v1beta1.StatusDepositPeriod},
+		{v1.StatusVotingPeriod, v1beta1.StatusVotingPeriod},
+		{v1.StatusPassed, v1beta1.StatusPassed},
+		{v1.StatusRejected, v1beta1.StatusRejected},
+		{v1.StatusFailed, v1beta1.StatusFailed},
+		{v1.StatusQueued, v1beta1.StatusPassed},
+		{v1.StatusReviewPeriod, v1beta1.StatusDepositPeriod},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status.String(), func(t *testing.T) {
+			status := v3.ConvertToLegacyProposalStatus(tt.status)
+			require.Equal(t, tt.expectedStatus, status)
+			// the legacy status must be defined in the legacy enum
+			require.Contains(t, v1beta1.ProposalStatus_name, int32(status))
+		})
+	}
+
+	// every status is converted to a defined legacy status
+	for value := range v1.ProposalStatus_name {
+		status := v3.ConvertToLegacyProposalStatus(v1.ProposalStatus(value))
+		require.Contains(t, v1beta1.ProposalStatus_name, int32(status), "status %s", v1.ProposalStatus(value))
+	}
+}
+
+func TestConvertToLegacyProposal(t *testing.T) {
+	govAcct := authtypes.NewModuleAddress(types.ModuleName)
+	content, err := v1.NewLegacyContent(v1beta1.NewTextProposal("title", "description"), govAcct.String())
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	proposal, err := v1.NewProposal([]sdk.Msg{content}, 1, now, now.Add(time.Hour), "", "title", "summary", sdk.AccAddress("proposer"))
+	require.NoError(t, err)
+	proposal.Status = v1.StatusQueued
+
+	legacyProposal, err := v3.ConvertToLegacyProposal(proposal)
+	require.NoError(t, err)
+	require.Equal(t, v1beta1.StatusPassed, legacyProposal.Status)
+	require.Equal(t, "title", legacyProposal.GetTitle())
+}
@@ -39,7 +39,7 @@ import (
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
 
-const ConsensusVersion = 4
+const ConsensusVersion = 5
 
 var (
 	_ module.EndBlockAppModule   = AppModule{}
@@ -284,7 +284,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	v1.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
 	m := keeper.NewMigrator(am.keeper, am.legacySubspace)
-	_ = m
+	if err := cfg.RegisterMigration(govtypes.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 4 to 5: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
@@ -0,0 +1,50 @@
+package search
+
+import (
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const FlagGovSearchEnable = "gov-search.enable"
+
+// Config defines the node configuration of the proposal search index. As the
+// index is node-local, it is not part of the consensus.
+type Config struct {
+	// Enable builds the proposal search index and serves the Search/Proposals
+	// query.
+	Enable bool `mapstructure:"enable"`
+}
+
+// DefaultConfig returns the default proposal search index configuration,
+// which disables the index.
+func DefaultConfig() Config {
+	return Config{
+		Enable: false,
+	}
+}
+
+// ConfigFromAppOptions reads the proposal search index configuration from the
+// app options, falling back to the default values for the missing ones.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := appOpts.Get(FlagGovSearchEnable); v != nil {
+		cfg.Enable = cast.ToBool(v)
+	}
+	return cfg
+}
+
+// ConfigTemplate is the app.toml section of the proposal search index
+// configuration.
+const ConfigTemplate = `
+###############################################################################
+###                     Gov Proposal Search Configuration                   ###
+###############################################################################
+
+[gov-search]
+
+# Build a node-local index of the proposal titles and summaries and serve the
+# atomone.gov.v1.Search/Proposals fuzzy search query. The index is kept in
+# memory and rebuilt from the state when the node starts.
+enable = {{ .GovSearch.Enable }}
+`
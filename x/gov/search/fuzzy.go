@@ -0,0 +1,122 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// scores of a query term matching a word, the title words weighing twice
+	// the summary ones
+	exactMatchScore  = 4
+	prefixMatchScore = 2
+	fuzzyMatchScore  = 1
+	titleWeight      = 2
+
+	// minPrefixLen and minFuzzyLen are the minimum lengths of a query term
+	// matching words by prefix and with typos
+	minPrefixLen = 2
+	minFuzzyLen  = 4
+	// maxFuzzyWordLen bounds the length of the words compared with typos, to
+	// bound the cost of the edit distance
+	maxFuzzyWordLen = 32
+)
+
+// tokenize splits s into lowercase words of letters and digits.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// score returns the relevance of the document for the query terms: the sum of
+// the best match of each term among the document words, zero if no term
+// matches.
+func (doc document) score(terms []string) uint64 {
+	var score uint64
+	for _, term := range terms {
+		best := titleWeight * bestMatch(term, doc.titleWords)
+		if s := bestMatch(term, doc.summaryWords); s > best {
+			best = s
+		}
+		score += best
+	}
+	return score
+}
+
+// bestMatch returns the score of the best match of term among words.
+func bestMatch(term string, words []string) uint64 {
+	var best uint64
+	for _, word := range words {
+		if s := match(term, word); s > best {
+			best = s
+			if best == exactMatchScore {
+				break
+			}
+		}
+	}
+	return best
+}
+
+// match returns the score of term matching word: exactly, as a prefix, or
+// within a small edit distance growing with the term length.
+func match(term, word string) uint64 {
+	switch {
+	case term == word:
+		return exactMatchScore
+	case len(term) >= minPrefixLen && strings.HasPrefix(word, term):
+		return prefixMatchScore
+	}
+
+	t, w := []rune(term), []rune(word)
+	if len(t) < minFuzzyLen || len(w) > maxFuzzyWordLen {
+		return 0
+	}
+	maxEdits := 1
+	if len(t) >= 8 {
+		maxEdits = 2
+	}
+	if editDistance(t, w, maxEdits) <= maxEdits {
+		return fuzzyMatchScore
+	}
+	return 0
+}
+
+// editDistance returns the edit distance between a and b, counting the
+// insertions, deletions, substitutions and transpositions of adjacent runes,
+// or a value above maxEdits as soon as the distance is known to exceed
+// maxEdits.
+func editDistance(a, b []rune, maxEdits int) int {
+	if d := len(a) - len(b); d > maxEdits || -d > maxEdits {
+		return maxEdits + 1
+	}
+
+	prevPrev := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = min(curr[j], prevPrev[j-2]+1)
+			}
+			if curr[j] < rowMin {
+				rowMin = curr[j]
+			}
+		}
+		if rowMin > maxEdits {
+			return maxEdits + 1
+		}
+		prevPrev, prev, curr = prev, curr, prevPrev
+	}
+	return prev[len(b)]
+}
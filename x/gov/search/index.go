@@ -0,0 +1,268 @@
+// Package search implements an optional node-local index of the proposal
+// titles and summaries, and the gov module Search service answering fuzzy
+// proposal searches from it, so that explorers don't need to maintain their
+// own index. The index is not part of the consensus state.
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+const (
+	// DefaultLimit is the number of results returned when the request doesn't
+	// set a limit.
+	DefaultLimit = 20
+	// MaxLimit is the maximum number of results returned.
+	MaxLimit = 100
+)
+
+var (
+	_ baseapp.StreamingService = (*Index)(nil)
+	_ v1.SearchServer          = (*Index)(nil)
+)
+
+// ProposalKeeper defines the gov keeper methods used to build the index.
+type ProposalKeeper interface {
+	GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool)
+	IterateProposals(ctx sdk.Context, cb func(proposal v1.Proposal) (stop bool))
+}
+
+// document is an indexed proposal.
+type document struct {
+	title        string
+	summary      string
+	titleWords   []string
+	summaryWords []string
+}
+
+// Index indexes the titles and summaries of the proposals, including the ones
+// of their JSON metadata. It hooks into the BaseApp ABCI message processing to
+// collect the proposals submitted, dropped or archived in a block, and updates
+// the index from the committed state once the block is committed. The index is
+// built from all the proposals of the state on the first commit after the node
+// starts.
+type Index struct {
+	keeper ProposalKeeper
+
+	mtx       sync.RWMutex
+	built     bool
+	documents map[uint64]document
+	submitted []uint64
+	dropped   []uint64
+}
+
+// NewIndex returns a new empty proposal search Index.
+func NewIndex(keeper ProposalKeeper) *Index {
+	return &Index{
+		keeper:    keeper,
+		documents: make(map[uint64]document),
+	}
+}
+
+// Proposals implements the Search/Proposals gRPC method.
+func (idx *Index) Proposals(_ context.Context, req *v1.SearchProposalsRequest) (*v1.SearchProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	terms := tokenize(req.Query)
+	if len(terms) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty query")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	idx.mtx.RLock()
+	var results []*v1.ProposalSearchResult
+	for id, doc := range idx.documents {
+		score := doc.score(terms)
+		if score == 0 {
+			continue
+		}
+		results = append(results, &v1.ProposalSearchResult{
+			ProposalId: id,
+			Title:      doc.title,
+			Summary:    doc.summary,
+			Score:      score,
+		})
+	}
+	idx.mtx.RUnlock()
+
+	// the most relevant first, then the most recent
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].ProposalId > results[j].ProposalId
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return &v1.SearchProposalsResponse{Results: results}, nil
+}
+
+// ListenBeginBlock implements the baseapp.ABCIListener interface.
+func (idx *Index) ListenBeginBlock(_ context.Context, _ abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.submitted = nil
+	idx.dropped = nil
+	idx.collectEvents(res.Events)
+	return nil
+}
+
+// ListenDeliverTx implements the baseapp.ABCIListener interface.
+func (idx *Index) ListenDeliverTx(_ context.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if res.IsOK() {
+		idx.collectEvents(res.Events)
+	}
+	return nil
+}
+
+// ListenEndBlock implements the baseapp.ABCIListener interface.
+func (idx *Index) ListenEndBlock(_ context.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	idx.collectEvents(res.Events)
+	return nil
+}
+
+// ListenCommit implements the baseapp.ABCIListener interface. It indexes the
+// proposals submitted in the committed block and removes the dropped and
+// archived ones, reading them from the committed state.
+func (idx *Index) ListenCommit(goCtx context.Context, _ abci.ResponseCommit) error {
+	ctx := sdk.UnwrapSDKContext(goCtx).WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if !idx.built {
+		idx.keeper.IterateProposals(ctx, func(proposal v1.Proposal) bool {
+			idx.documents[proposal.Id] = newDocument(proposal)
+			return false
+		})
+		idx.built = true
+	} else {
+		for _, id := range idx.submitted {
+			if proposal, ok := idx.keeper.GetProposal(ctx, id); ok {
+				idx.documents[id] = newDocument(proposal)
+			}
+		}
+	}
+	for _, id := range idx.dropped {
+		delete(idx.documents, id)
+	}
+
+	idx.submitted = nil
+	idx.dropped = nil
+	return nil
+}
+
+// Stream implements the baseapp.StreamingService interface. The index is
+// updated on commit, so there is no background loop to run.
+func (idx *Index) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements the baseapp.StreamingService interface. The Index
+// doesn't listen to state changes.
+func (idx *Index) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close implements the baseapp.StreamingService interface.
+func (idx *Index) Close() error {
+	return nil
+}
+
+// collectEvents records the proposals submitted, dropped or archived among
+// events. Archived proposals are removed like the dropped ones, as they are
+// pruned from the state. It must be called with the lock held.
+func (idx *Index) collectEvents(events []abci.Event) {
+	for _, event := range events {
+		switch event.Type {
+		case types.EventTypeSubmitProposal:
+			if id, ok := eventProposalID(event); ok {
+				idx.submitted = append(idx.submitted, id)
+			}
+
+		case types.EventTypeInactiveProposal, types.EventTypeArchiveProposal:
+			if id, ok := eventProposalID(event); ok {
+				idx.dropped = append(idx.dropped, id)
+			}
+		}
+	}
+}
+
+// eventProposalID returns the proposal ID attribute of the event.
+func eventProposalID(event abci.Event) (uint64, bool) {
+	for _, attr := range event.Attributes {
+		if attr.Key == types.AttributeKeyProposalID {
+			id, err := strconv.ParseUint(attr.Value, 10, 64)
+			return id, err == nil
+		}
+	}
+	return 0, false
+}
+
+// newDocument returns the document of the proposal. The title and summary
+// of its metadata are indexed too when the metadata is a JSON object.
+func newDocument(proposal v1.Proposal) document {
+	doc := document{
+		title:   proposal.Title,
+		summary: proposal.Summary,
+	}
+	titles := []string{proposal.Title}
+	summaries := []string{proposal.Summary}
+
+	var metadata struct {
+		Title   string `json:"title"`
+		Summary string `json:"summary"`
+	}
+	if err := json.Unmarshal([]byte(proposal.Metadata), &metadata); err == nil {
+		titles = append(titles, metadata.Title)
+		summaries = append(summaries, metadata.Summary)
+		if doc.title == "" {
+			doc.title = metadata.Title
+		}
+		if doc.summary == "" {
+			doc.summary = metadata.Summary
+		}
+	}
+
+	for _, title := range titles {
+		doc.titleWords = append(doc.titleWords, tokenize(title)...)
+	}
+	for _, summary := range summaries {
+		doc.summaryWords = append(doc.summaryWords, tokenize(summary)...)
+	}
+	return doc
+}
@@ -0,0 +1,121 @@
+package search_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/search"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+type mockKeeper struct {
+	proposals map[uint64]v1.Proposal
+}
+
+func (m mockKeeper) GetProposal(_ sdk.Context, proposalID uint64) (v1.Proposal, bool) {
+	proposal, ok := m.proposals[proposalID]
+	return proposal, ok
+}
+
+func (m mockKeeper) IterateProposals(_ sdk.Context, cb func(proposal v1.Proposal) bool) {
+	for _, proposal := range m.proposals {
+		if cb(proposal) {
+			return
+		}
+	}
+}
+
+func proposalEvent(typ, proposalID string) abci.Event {
+	return abci.Event{
+		Type: typ,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyProposalID, Value: proposalID},
+		},
+	}
+}
+
+func searchIDs(t *testing.T, idx *search.Index, query string) []uint64 {
+	t.Helper()
+
+	res, err := idx.Proposals(context.Background(), &v1.SearchProposalsRequest{Query: query})
+	require.NoError(t, err)
+	var ids []uint64
+	for _, result := range res.Results {
+		ids = append(ids, result.ProposalId)
+	}
+	return ids
+}
+
+func TestIndex(t *testing.T) {
+	keeper := mockKeeper{proposals: map[uint64]v1.Proposal{
+		1: {Id: 1, Title: "Community pool spend", Summary: "Fund the validators' tooling"},
+		2: {Id: 2, Title: "Upgrade to v2", Summary: "Software upgrade of the chain"},
+	}}
+	idx := search.NewIndex(keeper)
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+
+	// nothing is indexed before the first commit
+	require.Empty(t, searchIDs(t, idx, "upgrade"))
+
+	// the first commit indexes all the proposals of the state
+	require.NoError(t, idx.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	require.NoError(t, idx.ListenCommit(ctx, abci.ResponseCommit{}))
+
+	require.Equal(t, []uint64{2}, searchIDs(t, idx, "upgrade"))
+	// typos and prefixes
+	require.Equal(t, []uint64{2}, searchIDs(t, idx, "Upgarde"))
+	require.Equal(t, []uint64{1}, searchIDs(t, idx, "comm"))
+	require.Empty(t, searchIDs(t, idx, "governor"))
+
+	// proposals submitted by successful txs are indexed on commit, including
+	// the title and summary of their JSON metadata
+	keeper.proposals[3] = v1.Proposal{Id: 3, Metadata: `{"title":"Treasury budget","summary":"Upgrade the explorer"}`}
+	keeper.proposals[4] = v1.Proposal{Id: 4, Title: "Upgrade from a failed tx"}
+	require.NoError(t, idx.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	require.NoError(t, idx.ListenDeliverTx(ctx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{
+		Events: []abci.Event{proposalEvent(types.EventTypeSubmitProposal, "3")},
+	}))
+	require.NoError(t, idx.ListenDeliverTx(ctx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{
+		Code:   1,
+		Events: []abci.Event{proposalEvent(types.EventTypeSubmitProposal, "4")},
+	}))
+	require.NoError(t, idx.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	require.NoError(t, idx.ListenCommit(ctx, abci.ResponseCommit{}))
+
+	// title matches rank first
+	require.Equal(t, []uint64{2, 3}, searchIDs(t, idx, "upgrade"))
+	res, err := idx.Proposals(context.Background(), &v1.SearchProposalsRequest{Query: "treasury", Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.ProposalSearchResult{
+		{ProposalId: 3, Title: "Treasury budget", Summary: "Upgrade the explorer", Score: 8},
+	}, res.Results)
+
+	// dropped proposals are removed on commit
+	require.NoError(t, idx.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	require.NoError(t, idx.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{
+		Events: []abci.Event{proposalEvent(types.EventTypeInactiveProposal, "2")},
+	}))
+	require.NoError(t, idx.ListenCommit(ctx, abci.ResponseCommit{}))
+	require.Equal(t, []uint64{3}, searchIDs(t, idx, "upgrade"))
+
+	// as well as the archived ones, pruned from the state
+	require.NoError(t, idx.ListenBeginBlock(ctx, abci.RequestBeginBlock{}, abci.ResponseBeginBlock{}))
+	require.NoError(t, idx.ListenEndBlock(ctx, abci.RequestEndBlock{}, abci.ResponseEndBlock{
+		Events: []abci.Event{proposalEvent(types.EventTypeArchiveProposal, "3")},
+	}))
+	require.NoError(t, idx.ListenCommit(ctx, abci.ResponseCommit{}))
+	require.Empty(t, searchIDs(t, idx, "upgrade"))
+
+	// empty query
+	_, err = idx.Proposals(context.Background(), &v1.SearchProposalsRequest{Query: " - "})
+	require.Error(t, err)
+}
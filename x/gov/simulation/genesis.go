@@ -112,7 +112,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewParams(minDeposit, depositPeriod, votingPeriod, quorum.String(), threshold.String(), veto.String(), minInitialDepositRatio.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0),
+		v1.NewParams(minDeposit, depositPeriod, votingPeriod, quorum.String(), threshold.String(), veto.String(), minInitialDepositRatio.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, v1.DefaultProposalRetentionPeriod, v1.DefaultProposerBountyRatio.String(), v1.DefaultProposerBounty, v1.DefaultCommunityPoolSpendLimit, v1.DefaultCommunityPoolSpendPeriod, v1.DefaultMaxDepositPeriodProposalsPerProposer, v1.DefaultMinVotingPeriod, v1.DefaultMaxVotingPeriod, v1.DefaultProposalMetadataSchema, v1.DefaultReviewPeriod, v1.DefaultMessageReviewPeriods, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, v1.DefaultQuadraticVotingPowerCap.String(), v1.DefaultMaxVoteLockPeriods, v1.DefaultExcludeJailedValidatorsStake, v1.DefaultModuleAccountStakeRule, v1.DefaultDepositDenomWeights),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
@@ -209,6 +209,7 @@ func simulateMsgSubmitProposal(ak types.AccountKeeper, bk types.BankKeeper, k *k
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate a submit proposal msg"), nil, err
 		}
+		msg.Quadratic = k.GetParams(ctx).QuadraticVotingEnabled && r.Intn(2) == 0
 
 		account := ak.GetAccount(ctx, simAccount.Address)
 		txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
@@ -0,0 +1,52 @@
+package stream
+
+import (
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const FlagGovStreamMaxSubscribers = "gov-stream.max-subscribers"
+
+// Config defines the node configuration of the governance events streaming
+// service. As the service is node-local, it is not part of the consensus.
+type Config struct {
+	// MaxSubscribers is the maximum number of concurrent subscribers of the
+	// Stream/GovernanceEvents RPC, the extra subscriptions being rejected.
+	// Each subscriber holds a buffer of events, so this bounds the memory used
+	// by the service. Zero disables the service.
+	MaxSubscribers int `mapstructure:"max-subscribers"`
+}
+
+// DefaultConfig returns the default governance events streaming configuration.
+func DefaultConfig() Config {
+	return Config{
+		MaxSubscribers: 100,
+	}
+}
+
+// ConfigFromAppOptions reads the governance events streaming configuration
+// from the app options, falling back to the default values for the missing
+// ones.
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	cfg := DefaultConfig()
+	if v := appOpts.Get(FlagGovStreamMaxSubscribers); v != nil {
+		cfg.MaxSubscribers = cast.ToInt(v)
+	}
+	return cfg
+}
+
+// ConfigTemplate is the app.toml section of the governance events streaming
+// configuration.
+const ConfigTemplate = `
+###############################################################################
+###                     Gov Events Streaming Configuration                  ###
+###############################################################################
+
+[gov-stream]
+
+# Maximum number of concurrent subscribers of the
+# atomone.gov.v1.Stream/GovernanceEvents RPC. Each subscriber holds a buffer of
+# events, so this bounds the memory used by the service. 0 disables it.
+max-subscribers = {{ .GovStream.MaxSubscribers }}
+`
@@ -0,0 +1,245 @@
+// Package stream implements the gov module gRPC streaming service, which
+// pushes the governance events emitted in each committed block to its
+// subscribers so they don't need to poll the query service.
+package stream
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+// subscriberBufferSize is the number of blocks worth of events buffered for
+// each subscriber. Subscribers falling further behind are disconnected.
+const subscriberBufferSize = 100
+
+var (
+	_ baseapp.StreamingService = (*Server)(nil)
+	_ v1.StreamServer          = (*Server)(nil)
+)
+
+// governanceEventTypes are the event types forwarded to the subscribers.
+var governanceEventTypes = map[string]bool{
+	types.EventTypeSubmitProposal:    true,
+	types.EventTypeProposalDeposit:   true,
+	types.EventTypeProposalVote:      true,
+	types.EventTypeInactiveProposal:  true,
+	types.EventTypeActiveProposal:    true,
+	types.EventTypeReviewProposal:    true,
+	types.EventTypeQueuedProposal:    true,
+	types.EventTypeRecurringProposal: true,
+	types.EventTypeArchiveProposal:   true,
+}
+
+// Server collects the governance events emitted while a block is processed,
+// by hooking into the BaseApp ABCI message processing, and broadcasts them to
+// the subscribers of the Stream/GovernanceEvents RPC once the block is
+// committed.
+type Server struct {
+	config      Config
+	mtx         sync.Mutex
+	height      int64
+	events      []abci.Event
+	subscribers map[uint64]chan *v1.StreamGovernanceEventsResponse
+	nextID      uint64
+}
+
+// NewServer returns a new governance events streaming Server.
+func NewServer(config Config) *Server {
+	return &Server{
+		config:      config,
+		subscribers: make(map[uint64]chan *v1.StreamGovernanceEventsResponse),
+	}
+}
+
+// GovernanceEvents implements the Stream/GovernanceEvents gRPC method.
+func (s *Server) GovernanceEvents(req *v1.StreamGovernanceEventsRequest, stream v1.Stream_GovernanceEventsServer) error {
+	id, ch, ok := s.subscribe()
+	if !ok {
+		return status.Errorf(codes.ResourceExhausted, "the maximum number of %d subscribers is reached", s.config.MaxSubscribers)
+	}
+	defer s.unsubscribe(id)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case res, ok := <-ch:
+			if !ok {
+				// the subscriber was disconnected, either because it was too
+				// slow or because the server was closed
+				return nil
+			}
+
+			res = filterProposalEvents(res, req.ProposalId)
+			if len(res.Events) == 0 {
+				continue
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ListenBeginBlock implements the baseapp.ABCIListener interface.
+func (s *Server) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.height = req.Header.Height
+	s.events = nil
+	s.collectEvents(res.Events)
+	return nil
+}
+
+// ListenDeliverTx implements the baseapp.ABCIListener interface.
+func (s *Server) ListenDeliverTx(_ context.Context, _ abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if res.IsOK() {
+		s.collectEvents(res.Events)
+	}
+	return nil
+}
+
+// ListenEndBlock implements the baseapp.ABCIListener interface.
+func (s *Server) ListenEndBlock(_ context.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.collectEvents(res.Events)
+	return nil
+}
+
+// ListenCommit implements the baseapp.ABCIListener interface. It broadcasts
+// the governance events of the committed block to the subscribers.
+func (s *Server) ListenCommit(_ context.Context, _ abci.ResponseCommit) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.events) == 0 {
+		return nil
+	}
+
+	res := &v1.StreamGovernanceEventsResponse{
+		Height: s.height,
+		Events: sdk.StringifyEvents(s.events),
+	}
+	s.events = nil
+
+	for id, ch := range s.subscribers {
+		select {
+		case ch <- res:
+		default:
+			// never block the consensus process on a slow subscriber
+			close(ch)
+			delete(s.subscribers, id)
+		}
+	}
+	return nil
+}
+
+// Stream implements the baseapp.StreamingService interface. Events are pushed
+// to the subscribers on commit, so there is no background loop to run.
+func (s *Server) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements the baseapp.StreamingService interface. The Server
+// doesn't listen to state changes.
+func (s *Server) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// Close implements the baseapp.StreamingService interface. It disconnects all
+// the subscribers.
+func (s *Server) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for id, ch := range s.subscribers {
+		close(ch)
+		delete(s.subscribers, id)
+	}
+	return nil
+}
+
+// NumSubscribers returns the number of current subscribers.
+func (s *Server) NumSubscribers() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return len(s.subscribers)
+}
+
+// subscribe registers a new subscriber, unless the MaxSubscribers of the
+// config is reached.
+func (s *Server) subscribe() (uint64, <-chan *v1.StreamGovernanceEventsResponse, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if len(s.subscribers) >= s.config.MaxSubscribers {
+		return 0, nil, false
+	}
+
+	id := s.nextID
+	s.nextID++
+	ch := make(chan *v1.StreamGovernanceEventsResponse, subscriberBufferSize)
+	s.subscribers[id] = ch
+	return id, ch, true
+}
+
+func (s *Server) unsubscribe(id uint64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if ch, ok := s.subscribers[id]; ok {
+		close(ch)
+		delete(s.subscribers, id)
+	}
+}
+
+// collectEvents appends the governance events among events to the events of
+// the block being processed. It must be called with the lock held.
+func (s *Server) collectEvents(events []abci.Event) {
+	for _, event := range events {
+		if governanceEventTypes[event.Type] {
+			s.events = append(s.events, event)
+		}
+	}
+}
+
+// filterProposalEvents returns the response with only the events of the given
+// proposal. A zero proposalID matches every proposal.
+func filterProposalEvents(res *v1.StreamGovernanceEventsResponse, proposalID uint64) *v1.StreamGovernanceEventsResponse {
+	if proposalID == 0 {
+		return res
+	}
+
+	id := strconv.FormatUint(proposalID, 10)
+	filtered := &v1.StreamGovernanceEventsResponse{Height: res.Height}
+	for _, event := range res.Events {
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyProposalID && attr.Value == id {
+				filtered.Events = append(filtered.Events, event)
+				break
+			}
+		}
+	}
+	return filtered
+}
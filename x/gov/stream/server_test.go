@@ -0,0 +1,126 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/atomone-hub/atomone/x/gov/stream"
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+type mockStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *v1.StreamGovernanceEventsResponse
+}
+
+func (m *mockStream) Context() context.Context { return m.ctx }
+
+func (m *mockStream) Send(res *v1.StreamGovernanceEventsResponse) error {
+	m.sent <- res
+	return nil
+}
+
+func voteEvent(proposalID string) abci.Event {
+	return proposalEvent(types.EventTypeProposalVote, proposalID)
+}
+
+func proposalEvent(typ, proposalID string) abci.Event {
+	return abci.Event{
+		Type: typ,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyProposalID, Value: proposalID},
+		},
+	}
+}
+
+func TestGovernanceEvents(t *testing.T) {
+	server := stream.NewServer(stream.DefaultConfig())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	allStream := &mockStream{ctx: ctx, sent: make(chan *v1.StreamGovernanceEventsResponse, 10)}
+	proposalStream := &mockStream{ctx: ctx, sent: make(chan *v1.StreamGovernanceEventsResponse, 10)}
+	go server.GovernanceEvents(&v1.StreamGovernanceEventsRequest{}, allStream)                   //nolint:errcheck
+	go server.GovernanceEvents(&v1.StreamGovernanceEventsRequest{ProposalId: 2}, proposalStream) //nolint:errcheck
+
+	// wait for the subscriptions to be registered
+	require.Eventually(t, func() bool { return server.NumSubscribers() == 2 }, time.Second, time.Millisecond)
+
+	goCtx := context.Background()
+	require.NoError(t, server.ListenBeginBlock(goCtx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 10}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, server.ListenDeliverTx(goCtx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{
+		Events: []abci.Event{voteEvent("1"), {Type: "transfer"}},
+	}))
+	require.NoError(t, server.ListenDeliverTx(goCtx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{
+		Code:   1,
+		Events: []abci.Event{voteEvent("3")},
+	}))
+	require.NoError(t, server.ListenEndBlock(goCtx, abci.RequestEndBlock{}, abci.ResponseEndBlock{
+		Events: []abci.Event{
+			voteEvent("2"),
+			proposalEvent(types.EventTypeReviewProposal, "2"),
+			proposalEvent(types.EventTypeQueuedProposal, "2"),
+			proposalEvent(types.EventTypeRecurringProposal, "4"),
+		},
+	}))
+	require.NoError(t, server.ListenCommit(goCtx, abci.ResponseCommit{}))
+
+	select {
+	case res := <-allStream.sent:
+		require.Equal(t, int64(10), res.Height)
+		require.Len(t, res.Events, 5)
+		require.Equal(t, types.EventTypeProposalVote, res.Events[0].Type)
+		require.Equal(t, "1", res.Events[0].Attributes[0].Value)
+		require.Equal(t, "2", res.Events[1].Attributes[0].Value)
+		// the phase changes are forwarded too
+		require.Equal(t, types.EventTypeReviewProposal, res.Events[2].Type)
+		require.Equal(t, types.EventTypeQueuedProposal, res.Events[3].Type)
+		require.Equal(t, types.EventTypeRecurringProposal, res.Events[4].Type)
+	case <-time.After(time.Second):
+		t.Fatal("no events received")
+	}
+
+	select {
+	case res := <-proposalStream.sent:
+		require.Equal(t, int64(10), res.Height)
+		require.Len(t, res.Events, 3)
+		for _, event := range res.Events {
+			require.Equal(t, "2", event.Attributes[0].Value)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no events received")
+	}
+
+	require.NoError(t, server.Close())
+}
+
+func TestGovernanceEventsMaxSubscribers(t *testing.T) {
+	server := stream.NewServer(stream.Config{MaxSubscribers: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error)
+	go func() {
+		done <- server.GovernanceEvents(&v1.StreamGovernanceEventsRequest{}, &mockStream{ctx: ctx})
+	}()
+	require.Eventually(t, func() bool { return server.NumSubscribers() == 1 }, time.Second, time.Millisecond)
+
+	// the subscriptions past the limit are rejected
+	err := server.GovernanceEvents(&v1.StreamGovernanceEventsRequest{}, &mockStream{ctx: context.Background()})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the slot is freed once the subscriber leaves
+	cancel()
+	require.NoError(t, <-done)
+	require.Equal(t, 0, server.NumSubscribers())
+}
@@ -31,6 +31,10 @@ type BankKeeper interface {
 type StakingKeeper interface {
 	types.StakingKeeper
 
-	BondDenom(ctx sdk.Context) string
 	TokensFromConsensusPower(ctx sdk.Context, power int64) math.Int
 }
+
+// TreasuryKeeper is gov's actual expected TreasuryKeeper.
+type TreasuryKeeper interface {
+	types.TreasuryKeeper
+}
@@ -7,6 +7,7 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -83,6 +84,20 @@ func (mr *MockAccountKeeperMockRecorder) GetModuleAddress(name interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleAddress", reflect.TypeOf((*MockAccountKeeper)(nil).GetModuleAddress), name)
 }
 
+// GetModulePermissions mocks base method.
+func (m *MockAccountKeeper) GetModulePermissions() map[string]types0.PermissionsForAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModulePermissions")
+	ret0, _ := ret[0].(map[string]types0.PermissionsForAddress)
+	return ret0
+}
+
+// GetModulePermissions indicates an expected call of GetModulePermissions.
+func (mr *MockAccountKeeperMockRecorder) GetModulePermissions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModulePermissions", reflect.TypeOf((*MockAccountKeeper)(nil).GetModulePermissions))
+}
+
 // IterateAccounts mocks base method.
 func (m *MockAccountKeeper) IterateAccounts(ctx types.Context, cb func(types0.AccountI) bool) {
 	m.ctrl.T.Helper()
@@ -971,17 +986,31 @@ func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
 }
 
 // BondDenom mocks base method.
-func (m *MockStakingKeeper) BondDenom(ctx types.Context) string {
+func (m *MockStakingKeeper) BondDenom(arg0 types.Context) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BondDenom", ctx)
+	ret := m.ctrl.Call(m, "BondDenom", arg0)
 	ret0, _ := ret[0].(string)
 	return ret0
 }
 
 // BondDenom indicates an expected call of BondDenom.
-func (mr *MockStakingKeeperMockRecorder) BondDenom(ctx interface{}) *gomock.Call {
+func (mr *MockStakingKeeperMockRecorder) BondDenom(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), arg0)
+}
+
+// Delegation mocks base method.
+func (m *MockStakingKeeper) Delegation(arg0 types.Context, arg1 types.AccAddress, arg2 types.ValAddress) types2.DelegationI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delegation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(types2.DelegationI)
+	return ret0
+}
+
+// Delegation indicates an expected call of Delegation.
+func (mr *MockStakingKeeperMockRecorder) Delegation(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BondDenom", reflect.TypeOf((*MockStakingKeeper)(nil).BondDenom), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delegation", reflect.TypeOf((*MockStakingKeeper)(nil).Delegation), arg0, arg1, arg2)
 }
 
 // IterateBondedValidatorsByPower mocks base method.
@@ -1035,3 +1064,68 @@ func (mr *MockStakingKeeperMockRecorder) TotalBondedTokens(arg0 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalBondedTokens", reflect.TypeOf((*MockStakingKeeper)(nil).TotalBondedTokens), arg0)
 }
+
+// UnbondingTime mocks base method.
+func (m *MockStakingKeeper) UnbondingTime(arg0 types.Context) time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbondingTime", arg0)
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// UnbondingTime indicates an expected call of UnbondingTime.
+func (mr *MockStakingKeeperMockRecorder) UnbondingTime(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbondingTime", reflect.TypeOf((*MockStakingKeeper)(nil).UnbondingTime), arg0)
+}
+
+// Validator mocks base method.
+func (m *MockStakingKeeper) Validator(arg0 types.Context, arg1 types.ValAddress) types2.ValidatorI {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validator", arg0, arg1)
+	ret0, _ := ret[0].(types2.ValidatorI)
+	return ret0
+}
+
+// Validator indicates an expected call of Validator.
+func (mr *MockStakingKeeperMockRecorder) Validator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validator", reflect.TypeOf((*MockStakingKeeper)(nil).Validator), arg0, arg1)
+}
+
+// MockTreasuryKeeper is a mock of TreasuryKeeper interface.
+type MockTreasuryKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTreasuryKeeperMockRecorder
+}
+
+// MockTreasuryKeeperMockRecorder is the mock recorder for MockTreasuryKeeper.
+type MockTreasuryKeeperMockRecorder struct {
+	mock *MockTreasuryKeeper
+}
+
+// NewMockTreasuryKeeper creates a new mock instance.
+func NewMockTreasuryKeeper(ctrl *gomock.Controller) *MockTreasuryKeeper {
+	mock := &MockTreasuryKeeper{ctrl: ctrl}
+	mock.recorder = &MockTreasuryKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTreasuryKeeper) EXPECT() *MockTreasuryKeeperMockRecorder {
+	return m.recorder
+}
+
+// CommunityPoolSpend mocks base method.
+func (m *MockTreasuryKeeper) CommunityPoolSpend(msg types.Msg) types.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommunityPoolSpend", msg)
+	ret0, _ := ret[0].(types.Coins)
+	return ret0
+}
+
+// CommunityPoolSpend indicates an expected call of CommunityPoolSpend.
+func (mr *MockTreasuryKeeperMockRecorder) CommunityPoolSpend(msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommunityPoolSpend", reflect.TypeOf((*MockTreasuryKeeper)(nil).CommunityPoolSpend), msg)
+}
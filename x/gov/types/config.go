@@ -1,14 +1,111 @@
 package types
 
+import (
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	FlagMaxQueryPageSize   = "gov-query.max-page-size"
+	FlagMaxQueryCountTotal = "gov-query.max-count-total"
+	FlagTallyQueryTimeout  = "gov-query.tally-timeout"
+	FlagMaxSimulationGas   = "gov-query.max-simulation-gas"
+)
+
 // Config is a config struct used for intialising the gov module to avoid using globals.
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+
+	QueryConfig
+}
+
+// QueryConfig defines the guards of the gov query service, protecting the
+// nodes serving public queries from the most expensive ones. As they only
+// apply to the queries served by the node, they are not part of the
+// consensus.
+type QueryConfig struct {
+	// MaxQueryPageSize defines the maximum number of results per page of the
+	// paginated queries, larger page limits are lowered to it. Zero means no
+	// limit.
+	MaxQueryPageSize uint64 `mapstructure:"max-page-size"`
+	// MaxQueryCountTotal defines the maximum number of entries the paginated
+	// queries count when count_total is requested. Requests counting more
+	// entries are rejected. Zero means no limit.
+	MaxQueryCountTotal uint64 `mapstructure:"max-count-total"`
+	// TallyQueryTimeout defines the time budget of the TallyResult query
+	// recomputing the tally of a proposal in voting period, and of the
+	// SimulateProposalExecution query. The queries fail once it is exceeded.
+	// Zero means no limit.
+	TallyQueryTimeout time.Duration `mapstructure:"tally-timeout"`
+	// MaxSimulationGas defines the gas limit of the messages run by the
+	// SimulateProposalExecution query, lowered to the block max gas if any.
+	// Zero means the block max gas, or no limit without one.
+	MaxSimulationGas uint64 `mapstructure:"max-simulation-gas"`
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
 		MaxMetadataLen: 255,
+		QueryConfig:    DefaultQueryConfig(),
+	}
+}
+
+// DefaultQueryConfig returns the default gov query guards.
+func DefaultQueryConfig() QueryConfig {
+	return QueryConfig{
+		MaxQueryPageSize:   1000,
+		MaxQueryCountTotal: 50000,
+		TallyQueryTimeout:  5 * time.Second,
+		MaxSimulationGas:   100_000_000,
+	}
+}
+
+// QueryConfigFromAppOptions reads the gov query guards from the app options,
+// falling back to the default values for the missing ones.
+func QueryConfigFromAppOptions(appOpts servertypes.AppOptions) QueryConfig {
+	cfg := DefaultQueryConfig()
+	if v := appOpts.Get(FlagMaxQueryPageSize); v != nil {
+		cfg.MaxQueryPageSize = cast.ToUint64(v)
+	}
+	if v := appOpts.Get(FlagMaxQueryCountTotal); v != nil {
+		cfg.MaxQueryCountTotal = cast.ToUint64(v)
 	}
+	if v := appOpts.Get(FlagTallyQueryTimeout); v != nil {
+		cfg.TallyQueryTimeout = cast.ToDuration(v)
+	}
+	if v := appOpts.Get(FlagMaxSimulationGas); v != nil {
+		cfg.MaxSimulationGas = cast.ToUint64(v)
+	}
+	return cfg
 }
+
+// QueryConfigTemplate is the app.toml section of the gov query guards.
+const QueryConfigTemplate = `
+###############################################################################
+###                         Gov Query Configuration                         ###
+###############################################################################
+
+[gov-query]
+
+# Maximum number of results per page of the paginated gov queries, larger page
+# limits are lowered to it. Setting it to 0 disables the limit.
+max-page-size = {{ .GovQuery.MaxQueryPageSize }}
+
+# Maximum number of entries counted by the paginated gov queries requesting
+# count_total, larger counts are rejected. Setting it to 0 disables the limit.
+max-count-total = {{ .GovQuery.MaxQueryCountTotal }}
+
+# Time budget of the TallyResult query recomputing the tally of a proposal in
+# voting period, and of the SimulateProposalExecution query. Setting it to 0
+# disables the budget.
+tally-timeout = "{{ .GovQuery.TallyQueryTimeout }}"
+
+# Gas limit of the messages run by the SimulateProposalExecution query, lowered
+# to the block max gas if any. Setting it to 0 only applies the block max gas.
+max-simulation-gas = {{ .GovQuery.MaxSimulationGas }}
+`
@@ -22,4 +22,14 @@ var (
 	ErrInvalidSignalMsg        = sdkerrors.Register(ModuleName, 140, "signal message is invalid")                                //nolint:staticcheck
 	ErrMetadataTooLong         = sdkerrors.Register(ModuleName, 150, "metadata too long")                                        //nolint:staticcheck
 	ErrMinDepositTooSmall      = sdkerrors.Register(ModuleName, 160, "minimum deposit is too small")                             //nolint:staticcheck
+	ErrInvalidConstitution     = sdkerrors.Register(ModuleName, 170, "invalid constitution")                                     //nolint:staticcheck
+	ErrInvalidParamsUpdate     = sdkerrors.Register(ModuleName, 180, "invalid params update")                                    //nolint:staticcheck
+	ErrTooManyProposals        = sdkerrors.Register(ModuleName, 190, "too many proposals in the deposit period")                 //nolint:staticcheck
+	ErrInvalidVotingPeriod     = sdkerrors.Register(ModuleName, 200, "invalid voting period")                                    //nolint:staticcheck
+	ErrInvalidMetadata         = sdkerrors.Register(ModuleName, 210, "invalid metadata")                                         //nolint:staticcheck
+	ErrVoteLocked              = sdkerrors.Register(ModuleName, 220, "stake locked by a vote")                                   //nolint:staticcheck
+	ErrInvalidRefundAddress    = sdkerrors.Register(ModuleName, 230, "invalid refund address")                                   //nolint:staticcheck
+	ErrInvalidSchedule         = sdkerrors.Register(ModuleName, 240, "invalid recurring proposal schedule")                      //nolint:staticcheck
+	ErrScheduleNotFound        = sdkerrors.Register(ModuleName, 250, "recurring proposal not found")                             //nolint:staticcheck
+	ErrInvalidDepositDenom     = sdkerrors.Register(ModuleName, 260, "invalid deposit denom")                                    //nolint:staticcheck
 )
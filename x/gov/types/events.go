@@ -2,24 +2,37 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
-	EventTypeSignalProposal   = "signal_proposal"
+	EventTypeSubmitProposal        = "submit_proposal"
+	EventTypeProposalDeposit       = "proposal_deposit"
+	EventTypeProposalVote          = "proposal_vote"
+	EventTypeInactiveProposal      = "inactive_proposal"
+	EventTypeActiveProposal        = "active_proposal"
+	EventTypeSignalProposal        = "signal_proposal"
+	EventTypeProposerBounty        = "proposer_bounty"
+	EventTypeQueuedProposal        = "queued_proposal"
+	EventTypeReviewProposal        = "review_proposal"
+	EventTypeVoteUnlock            = "vote_unlock"
+	EventTypeRecurringProposal     = "recurring_proposal"
+	EventTypeConstitutionAmendment = "constitution_amendment"
+	EventTypeArchiveProposal       = "archive_proposal"
 
-	AttributeKeyVoter              = "voter"
-	AttributeKeyProposalResult     = "proposal_result"
-	AttributeKeyOption             = "option"
-	AttributeKeyProposalID         = "proposal_id"
-	AttributeKeyProposalMessages   = "proposal_messages" // Msg type_urls in the proposal
-	AttributeKeyVotingPeriodStart  = "voting_period_start"
-	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
-	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
-	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
-	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
-	AttributeKeyProposalType       = "proposal_type"
-	AttributeSignalTitle           = "signal_title"
-	AttributeSignalDescription     = "signal_description"
+	AttributeKeyVoter               = "voter"
+	AttributeKeyProposalResult      = "proposal_result"
+	AttributeKeyOption              = "option"
+	AttributeKeyVotingPower         = "voting_power"
+	AttributeKeyProposalID          = "proposal_id"
+	AttributeKeyRecurringProposalID = "recurring_proposal_id"
+	AttributeKeyProposer            = "proposer"
+	AttributeKeyProposalMessages    = "proposal_messages" // Msg type_urls in the proposal
+	AttributeKeyVotingPeriodStart   = "voting_period_start"
+	AttributeKeyReviewPeriodStart   = "review_period_start"
+	AttributeKeyConstitutionHash    = "constitution_hash"
+	AttributeValueProposalDropped   = "proposal_dropped"  // didn't meet min deposit
+	AttributeValueProposalPassed    = "proposal_passed"   // met vote quorum
+	AttributeValueProposalRejected  = "proposal_rejected" // didn't meet vote quorum
+	AttributeValueProposalFailed    = "proposal_failed"   // error on proposal handler
+	AttributeValueProposalQueued    = "proposal_queued"   // community pool spend limit reached
+	AttributeKeyProposalType        = "proposal_type"
+	AttributeSignalTitle            = "signal_title"
+	AttributeSignalDescription      = "signal_description"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,11 +23,19 @@ type StakingKeeper interface {
 		sdk.Context, func(index int64, validator stakingtypes.ValidatorI) (stop bool),
 	)
 
+	// get a particular validator by operator address
+	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI
+
 	TotalBondedTokens(sdk.Context) math.Int // total bonded tokens within the validator set
+	BondDenom(sdk.Context) string
 	IterateDelegations(
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
 	)
+
+	// get a particular delegation by delegator and validator addresses
+	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) stakingtypes.DelegationI
+	UnbondingTime(sdk.Context) time.Duration
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -34,6 +44,7 @@ type AccountKeeper interface {
 
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, name string) types.ModuleAccountI
+	GetModulePermissions() map[string]types.PermissionsForAddress
 
 	// TODO remove with genesis 2-phases refactor https://github.com/cosmos/cosmos-sdk/issues/2862
 	SetModuleAccount(sdk.Context, types.ModuleAccountI)
@@ -49,6 +60,16 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// TreasuryKeeper defines the expected treasury keeper, whose messages may move
+// funds from the community pool (noalias)
+type TreasuryKeeper interface {
+	// CommunityPoolSpend returns the amount a message moves from the community
+	// pool, zero if it is not a treasury message spending from it.
+	CommunityPoolSpend(msg sdk.Msg) sdk.Coins
 }
 
 // Event Hooks
@@ -62,6 +83,9 @@ type GovHooks interface {
 	AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress)        // Must be called after a vote on a proposal is cast
 	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)                      // Must be called when proposal fails to reach min deposit
 	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64)                     // Must be called when proposal's finishes it's voting period
+	AfterProposalExecuted(ctx sdk.Context, proposalID uint64, results []*sdk.Result)       // Must be called when a passed proposal's messages are executed
+	AfterProposalFailed(ctx sdk.Context, proposalID uint64, err error)                     // Must be called when a passed proposal's messages fail on execution
+	AfterProposalVetoed(ctx sdk.Context, proposalID uint64)                                // Must be called when a proposal is rejected because of NoWithVeto votes
 }
 
 type GovHooksWrapper struct{ GovHooks }
@@ -42,3 +42,21 @@ func (h MultiGovHooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalI
 		h[i].AfterProposalVotingPeriodEnded(ctx, proposalID)
 	}
 }
+
+func (h MultiGovHooks) AfterProposalExecuted(ctx sdk.Context, proposalID uint64, results []*sdk.Result) {
+	for i := range h {
+		h[i].AfterProposalExecuted(ctx, proposalID, results)
+	}
+}
+
+func (h MultiGovHooks) AfterProposalFailed(ctx sdk.Context, proposalID uint64, err error) {
+	for i := range h {
+		h[i].AfterProposalFailed(ctx, proposalID, err)
+	}
+}
+
+func (h MultiGovHooks) AfterProposalVetoed(ctx sdk.Context, proposalID uint64) {
+	for i := range h {
+		h[i].AfterProposalVetoed(ctx, proposalID)
+	}
+}
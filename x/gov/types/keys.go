@@ -1,9 +1,12 @@
 package types
 
 import (
+	"crypto/sha256"
 	"encoding/binary"
 	"time"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -38,6 +41,42 @@ const (
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
 // - 0x30: Params
+//
+// - 0x40: Constitution
+//
+// - 0x41<status (1 Byte)>: number of proposals in the given status
+//
+// - 0x42<proposalID_Bytes>: ProposalTurnout
+//
+// - 0x43<proposerAddrLen (1 Byte)><proposerAddr_Bytes><proposalID_Bytes>: []byte{0x01}
+//
+// - 0x44<sha256(msgTypeURL) (32 Bytes)><proposalID_Bytes>: []byte{0x01}
+//
+// - 0x45<proposalID_Bytes>: ArchivedProposal
+//
+// - 0x46<votingEndTime_Bytes><proposalID_Bytes>: completedProposalID
+//
+// - 0x47<voterAddrLen (1 Byte)><voterAddr_Bytes><proposalID_Bytes>: []byte{0x01}
+//
+// - 0x48: BountyPool
+//
+// - 0x49<proposalID_Bytes>: ProposerBounty
+//
+// - 0x4A: CommunityPoolSpendPeriod
+//
+// - 0x4B<proposalID_Bytes>: []byte{0x01}
+//
+// - 0x4C<proposerAddrLen (1 Byte)><proposerAddr_Bytes><proposalID_Bytes>: []byte{0x01} if the proposal is in the deposit period
+//
+// - 0x50<votingEndTime_Bytes><proposalID_Bytes>: []byte{0x01}
+//
+// - 0x51<amountLen (1 Byte)><amount_Bytes><proposalID_Bytes>: []byte{0x01}
+//
+// - 0x52<recurringProposalID_Bytes>: RecurringProposal
+//
+// - 0x53<nextSubmitTime_Bytes><recurringProposalID_Bytes>: recurringProposalID
+//
+// - 0x54: nextRecurringProposalID
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
@@ -51,6 +90,52 @@ var (
 
 	// ParamsKey is the key to query all gov params
 	ParamsKey = []byte{0x30}
+
+	// ConstitutionKey is the key string used to store the chain's constitution
+	ConstitutionKey = []byte{0x40}
+
+	ProposalStatusCountKeyPrefix   = []byte{0x41}
+	ProposalTurnoutKeyPrefix       = []byte{0x42}
+	ProposalsByProposerKeyPrefix   = []byte{0x43}
+	ProposalsByMsgTypeURLKeyPrefix = []byte{0x44}
+	ArchivedProposalsKeyPrefix     = []byte{0x45}
+	CompletedProposalQueuePrefix   = []byte{0x46}
+	VotesByVoterKeyPrefix          = []byte{0x47}
+	BountyPoolKey                  = []byte{0x48}
+	ProposerBountiesKeyPrefix      = []byte{0x49}
+	CommunityPoolSpendPeriodKey    = []byte{0x4A}
+	CommunityPoolSpendQueuePrefix  = []byte{0x4B}
+
+	// DepositPeriodProposalsByProposerKeyPrefix indexes the proposals in the
+	// deposit period by proposer
+	DepositPeriodProposalsByProposerKeyPrefix = []byte{0x4C}
+
+	// ReviewProposalQueuePrefix queues the proposals in the review period by
+	// review end time
+	ReviewProposalQueuePrefix = []byte{0x4D}
+
+	// VoteLocksKeyPrefix stores the vote locks by voter, and
+	// VoteLockQueuePrefix queues them by end time
+	VoteLocksKeyPrefix  = []byte{0x4E}
+	VoteLockQueuePrefix = []byte{0x4F}
+
+	// ProposalsByVotingEndTimeKeyPrefix and ProposalsByTotalDepositKeyPrefix
+	// index the proposals by voting end time and by the deposit value of
+	// their total deposit, to order the Proposals query results
+	ProposalsByVotingEndTimeKeyPrefix = []byte{0x50}
+	ProposalsByTotalDepositKeyPrefix  = []byte{0x51}
+
+	// RecurringProposalsKeyPrefix stores the recurring proposals by id, and
+	// RecurringProposalQueuePrefix queues them by next submit time
+	RecurringProposalsKeyPrefix  = []byte{0x52}
+	RecurringProposalQueuePrefix = []byte{0x53}
+	RecurringProposalIDKey       = []byte{0x54}
+
+	// TotalDepositIndexEntriesPrefix stores the total deposit index key of
+	// each proposal, and TotalDepositReindexKey the ID of the next proposal to
+	// reindex after a change of the deposit denom weights
+	TotalDepositIndexEntriesPrefix = []byte{0x55}
+	TotalDepositReindexKey         = []byte{0x56}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -117,6 +202,144 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// VotesByVoterKey gets the first part of the votes by voter index key based on the voter address
+func VotesByVoterKey(voterAddr sdk.AccAddress) []byte {
+	return append(VotesByVoterKeyPrefix, address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// VoteByVoterKey gets the votes by voter index key of a specific vote
+func VoteByVoterKey(voterAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(VotesByVoterKey(voterAddr), GetProposalIDBytes(proposalID)...)
+}
+
+// ProposalStatusCountKey gets the key of the number of proposals in the given status
+func ProposalStatusCountKey(status int32) []byte {
+	return append(ProposalStatusCountKeyPrefix, byte(status))
+}
+
+// ProposalTurnoutKey gets the key of the turnout of a specific proposal from the store
+func ProposalTurnoutKey(proposalID uint64) []byte {
+	return append(ProposalTurnoutKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ProposalsByProposerKey gets the first part of the proposals by proposer index key based on the proposer address
+func ProposalsByProposerKey(proposerAddr sdk.AccAddress) []byte {
+	return append(ProposalsByProposerKeyPrefix, address.MustLengthPrefix(proposerAddr.Bytes())...)
+}
+
+// ProposalByProposerKey gets the proposals by proposer index key of a specific proposal
+func ProposalByProposerKey(proposerAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(ProposalsByProposerKey(proposerAddr), GetProposalIDBytes(proposalID)...)
+}
+
+// DepositPeriodProposalsByProposerKey gets the first part of the deposit period
+// proposals by proposer index key based on the proposer address
+func DepositPeriodProposalsByProposerKey(proposerAddr sdk.AccAddress) []byte {
+	return append(DepositPeriodProposalsByProposerKeyPrefix, address.MustLengthPrefix(proposerAddr.Bytes())...)
+}
+
+// DepositPeriodProposalByProposerKey gets the deposit period proposals by
+// proposer index key of a specific proposal
+func DepositPeriodProposalByProposerKey(proposerAddr sdk.AccAddress, proposalID uint64) []byte {
+	return append(DepositPeriodProposalsByProposerKey(proposerAddr), GetProposalIDBytes(proposalID)...)
+}
+
+// ProposalsByMsgTypeURLKey gets the first part of the proposals by message type URL index key based on the type URL.
+// The type URL is hashed, so that the key has a fixed length whatever the length of the type URL.
+func ProposalsByMsgTypeURLKey(msgTypeURL string) []byte {
+	hash := sha256.Sum256([]byte(msgTypeURL))
+	return append(ProposalsByMsgTypeURLKeyPrefix, hash[:]...)
+}
+
+// ProposalByMsgTypeURLKey gets the proposals by message type URL index key of a specific proposal
+func ProposalByMsgTypeURLKey(msgTypeURL string, proposalID uint64) []byte {
+	return append(ProposalsByMsgTypeURLKey(msgTypeURL), GetProposalIDBytes(proposalID)...)
+}
+
+// ProposalByVotingEndTimeKey gets the proposals by voting end time index key
+// of a specific proposal
+func ProposalByVotingEndTimeKey(votingEndTime time.Time, proposalID uint64) []byte {
+	return append(append(ProposalsByVotingEndTimeKeyPrefix, sdk.FormatTimeBytes(votingEndTime)...), GetProposalIDBytes(proposalID)...)
+}
+
+// ProposalByTotalDepositKey gets the proposals by total deposit index key of a
+// specific proposal. The value is length-prefixed in big-endian, as its
+// underlying integer, so that the keys are ordered by value.
+func ProposalByTotalDepositKey(value math.LegacyDec, proposalID uint64) []byte {
+	amountBz := value.BigInt().Bytes()
+	key := append(append(ProposalsByTotalDepositKeyPrefix, byte(len(amountBz))), amountBz...)
+	return append(key, GetProposalIDBytes(proposalID)...)
+}
+
+// TotalDepositIndexEntryKey gets the total deposit index key of a specific
+// proposal from the store
+func TotalDepositIndexEntryKey(proposalID uint64) []byte {
+	return append(TotalDepositIndexEntriesPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ArchivedProposalKey gets a specific archived proposal from the store
+func ArchivedProposalKey(proposalID uint64) []byte {
+	return append(ArchivedProposalsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ProposerBountyKey gets the bounty paid to the proposer of a specific proposal
+// from the store
+func ProposerBountyKey(proposalID uint64) []byte {
+	return append(ProposerBountiesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// CompletedProposalByTimeKey gets the completed proposal queue key by votingEndTime
+func CompletedProposalByTimeKey(votingEndTime time.Time) []byte {
+	return append(CompletedProposalQueuePrefix, sdk.FormatTimeBytes(votingEndTime)...)
+}
+
+// CompletedProposalQueueKey returns the key for a proposalID in the completedProposalQueue
+func CompletedProposalQueueKey(proposalID uint64, votingEndTime time.Time) []byte {
+	return append(CompletedProposalByTimeKey(votingEndTime), GetProposalIDBytes(proposalID)...)
+}
+
+// ReviewProposalByTimeKey gets the review proposal queue key by reviewEndTime
+func ReviewProposalByTimeKey(reviewEndTime time.Time) []byte {
+	return append(ReviewProposalQueuePrefix, sdk.FormatTimeBytes(reviewEndTime)...)
+}
+
+// ReviewProposalQueueKey returns the key for a proposalID in the reviewProposalQueue
+func ReviewProposalQueueKey(proposalID uint64, reviewEndTime time.Time) []byte {
+	return append(ReviewProposalByTimeKey(reviewEndTime), GetProposalIDBytes(proposalID)...)
+}
+
+// VoteLockKey gets the vote lock of a specific voter from the store
+func VoteLockKey(voterAddr sdk.AccAddress) []byte {
+	return append(VoteLocksKeyPrefix, address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// VoteLockByTimeKey gets the vote lock queue key by endTime
+func VoteLockByTimeKey(endTime time.Time) []byte {
+	return append(VoteLockQueuePrefix, sdk.FormatTimeBytes(endTime)...)
+}
+
+// VoteLockQueueKey returns the key for a voter in the vote lock queue
+func VoteLockQueueKey(voterAddr sdk.AccAddress, endTime time.Time) []byte {
+	return append(VoteLockByTimeKey(endTime), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// RecurringProposalKey gets a specific recurring proposal from the store
+func RecurringProposalKey(recurringProposalID uint64) []byte {
+	return append(RecurringProposalsKeyPrefix, GetProposalIDBytes(recurringProposalID)...)
+}
+
+// RecurringProposalByTimeKey gets the recurring proposal queue key by
+// nextSubmitTime
+func RecurringProposalByTimeKey(nextSubmitTime time.Time) []byte {
+	return append(RecurringProposalQueuePrefix, sdk.FormatTimeBytes(nextSubmitTime)...)
+}
+
+// RecurringProposalQueueKey returns the key for a recurringProposalID in the
+// recurring proposal queue
+func RecurringProposalQueueKey(recurringProposalID uint64, nextSubmitTime time.Time) []byte {
+	return append(RecurringProposalByTimeKey(nextSubmitTime), GetProposalIDBytes(recurringProposalID)...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -136,6 +359,36 @@ func SplitInactiveProposalQueueKey(key []byte) (proposalID uint64, endTime time.
 	return splitKeyWithTime(key)
 }
 
+// SplitCompletedProposalQueueKey split the completed proposal key and returns the proposal id and votingEndTime
+func SplitCompletedProposalQueueKey(key []byte) (proposalID uint64, votingEndTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitReviewProposalQueueKey split the review proposal key and returns the proposal id and reviewEndTime
+func SplitReviewProposalQueueKey(key []byte) (proposalID uint64, reviewEndTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitRecurringProposalQueueKey split the recurring proposal queue key and
+// returns the recurring proposal id and nextSubmitTime
+func SplitRecurringProposalQueueKey(key []byte) (recurringProposalID uint64, nextSubmitTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
+// SplitVoteLockQueueKey split the vote lock queue key and returns the voter
+// address and endTime
+func SplitVoteLockQueueKey(key []byte) (voterAddr sdk.AccAddress, endTime time.Time) {
+	kv.AssertKeyAtLeastLength(key, 1+lenTime+2)
+
+	endTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	voterAddr = sdk.AccAddress(key[1+lenTime+1:])
+	return
+}
+
 // SplitKeyDeposit split the deposits key and returns the proposal id and depositor address
 func SplitKeyDeposit(key []byte) (proposalID uint64, depositorAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
@@ -169,3 +422,9 @@ func splitKeyWithAddress(key []byte) (proposalID uint64, addr sdk.AccAddress) {
 	addr = sdk.AccAddress(key[10:])
 	return
 }
+
+// CommunityPoolSpendQueueKey returns the key for a proposalID in the community
+// pool spend queue
+func CommunityPoolSpendQueueKey(proposalID uint64) []byte {
+	return append(CommunityPoolSpendQueuePrefix, GetProposalIDBytes(proposalID)...)
+}
@@ -1,11 +1,15 @@
 package types
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -57,3 +61,29 @@ func TestVoteKeys(t *testing.T) {
 	require.Equal(t, int(proposalID), 2)
 	require.Equal(t, addr, voterAddr)
 }
+
+func TestProposalByTotalDepositKey(t *testing.T) {
+	// keys are ordered by value, then by proposal ID
+	keys := [][]byte{
+		ProposalByTotalDepositKey(math.LegacyZeroDec(), 3),
+		ProposalByTotalDepositKey(math.LegacyNewDecWithPrec(5, 1), 5),
+		ProposalByTotalDepositKey(math.LegacyNewDec(255), 2),
+		ProposalByTotalDepositKey(math.LegacyNewDec(256), 1),
+		ProposalByTotalDepositKey(math.LegacyNewDec(256), 4),
+		ProposalByTotalDepositKey(math.LegacyNewDec(1_000_000_000_000), 0),
+	}
+	for i := 1; i < len(keys); i++ {
+		require.Negative(t, bytes.Compare(keys[i-1], keys[i]))
+	}
+	require.Equal(t, uint64(4), GetProposalIDFromBytes(keys[4][len(keys[4])-8:]))
+}
+
+func TestProposalByMsgTypeURLKey(t *testing.T) {
+	// type URLs of any length have keys of the same length
+	longTypeURL := "/" + strings.Repeat("a", 300)
+	key := ProposalByMsgTypeURLKey(longTypeURL, 1)
+	require.Len(t, key, len(ProposalByMsgTypeURLKey("/cosmos.bank.v1beta1.MsgSend", 1)))
+	require.True(t, bytes.HasPrefix(key, ProposalsByMsgTypeURLKey(longTypeURL)))
+	require.False(t, bytes.HasPrefix(key, ProposalsByMsgTypeURLKey("/cosmos.bank.v1beta1.MsgSend")))
+	require.Equal(t, uint64(1), GetProposalIDFromBytes(key[len(key)-8:]))
+}
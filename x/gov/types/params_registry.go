@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParamsValidator validates a params update message carried by a proposal,
+// rejecting the changes to params that are not governance-adjustable and the
+// values out of the bounds declared by the module owning the params.
+type ParamsValidator func(ctx sdk.Context, msg sdk.Msg) error
+
+var _ ParamsRegistry = (*paramsRegistry)(nil)
+
+// ParamsRegistry holds the ParamsValidator declared by the modules for their
+// params update messages, keyed by message type URL. The messages of a
+// proposal are validated against the registry on submission, so an invalid
+// params change never enters the voting period.
+type ParamsRegistry interface {
+	Register(msgTypeURL string, v ParamsValidator) ParamsRegistry
+	HasValidator(msgTypeURL string) bool
+	Validate(ctx sdk.Context, msg sdk.Msg) error
+	Seal()
+}
+
+type paramsRegistry struct {
+	validators map[string]ParamsValidator
+	sealed     bool
+}
+
+// NewParamsRegistry creates a new ParamsRegistry interface instance
+func NewParamsRegistry() ParamsRegistry {
+	return &paramsRegistry{
+		validators: make(map[string]ParamsValidator),
+	}
+}
+
+// Seal seals the registry which prohibits any subsequent validators to be
+// registered. Seal will panic if called more than once.
+func (r *paramsRegistry) Seal() {
+	if r.sealed {
+		panic("params registry already sealed")
+	}
+	r.sealed = true
+}
+
+// Register registers the params validator of a message type URL. It returns
+// the ParamsRegistry so Register calls can be linked. It will panic if the
+// registry is sealed or if the message type URL is already registered.
+func (r *paramsRegistry) Register(msgTypeURL string, v ParamsValidator) ParamsRegistry {
+	if r.sealed {
+		panic("params registry sealed; cannot register params validator")
+	}
+	if v == nil {
+		panic(fmt.Sprintf("nil params validator for %s", msgTypeURL))
+	}
+	if r.HasValidator(msgTypeURL) {
+		panic(fmt.Sprintf("params validator for %s has already been registered", msgTypeURL))
+	}
+
+	r.validators[msgTypeURL] = v
+	return r
+}
+
+// HasValidator returns true if a params validator is registered for the
+// message type URL.
+func (r *paramsRegistry) HasValidator(msgTypeURL string) bool {
+	return r.validators[msgTypeURL] != nil
+}
+
+// Validate validates the message with the params validator registered for
+// its type URL. Messages without a registered validator are always valid.
+func (r *paramsRegistry) Validate(ctx sdk.Context, msg sdk.Msg) error {
+	v, ok := r.validators[sdk.MsgTypeURL(msg)]
+	if !ok {
+		return nil
+	}
+	return v(ctx, msg)
+}
@@ -0,0 +1,18 @@
+package types
+
+// Telemetry metric keys of the gov module, exported under the gov prefix.
+const (
+	// MetricKeyProposals prefixes the gauges of the number of proposals in
+	// the deposit period, in the review and voting periods and queued.
+	MetricKeyProposals     = "proposals"
+	MetricKeyDepositPeriod = "deposit_period"
+	MetricKeyReviewPeriod  = "review_period"
+	MetricKeyVotingPeriod  = "voting_period"
+	MetricKeyQueued        = "queued"
+
+	// MetricKeyVotes is the counter of the votes cast.
+	MetricKeyVotes = "votes"
+
+	// MetricKeyTally is the summary of the duration of the proposal tallies.
+	MetricKeyTally = "tally"
+)
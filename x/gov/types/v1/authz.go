@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &VoteAuthorization{}
+
+// NewVoteAuthorization creates a new VoteAuthorization object, restricted to
+// the given vote options. No options allow to vote with any option. The
+// grantee can't lock the stake of the granter unless MaxLockPeriods is set.
+func NewVoteAuthorization(allowedOptions ...VoteOption) *VoteAuthorization {
+	return &VoteAuthorization{
+		AllowedOptions: allowedOptions,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a VoteAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgVote{})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a VoteAuthorization) ValidateBasic() error {
+	seen := make(map[VoteOption]bool, len(a.AllowedOptions))
+	for _, option := range a.AllowedOptions {
+		if !ValidVoteOption(option) {
+			return sdkerrors.Wrapf(errortypes.ErrInvalidRequest, "invalid vote option: %s", option)
+		}
+		if seen[option] {
+			return sdkerrors.Wrapf(errortypes.ErrInvalidRequest, "duplicate vote option: %s", option)
+		}
+		seen[option] = true
+	}
+	return nil
+}
+
+// Accept implements Authorization.Accept.
+func (a VoteAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	vote, ok := msg.(*MsgVote)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(errortypes.ErrInvalidType, "type mismatch")
+	}
+
+	if !a.isAllowed(vote.Option) {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(errortypes.ErrUnauthorized, fmt.Sprintf("vote option %s is not allowed", vote.Option))
+	}
+	if vote.LockPeriods > a.MaxLockPeriods {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(errortypes.ErrUnauthorized, "%d lock periods exceed the %d allowed", vote.LockPeriods, a.MaxLockPeriods)
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func (a VoteAuthorization) isAllowed(option VoteOption) bool {
+	if len(a.AllowedOptions) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowedOptions {
+		if allowed == option {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: atomone/gov/v1/authz.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VoteAuthorization defines an authorization to vote on behalf of the granter,
+// restricted to a set of vote options and a number of vote lock periods.
+type VoteAuthorization struct {
+	// allowed_options defines the vote options the grantee can vote with. If it
+	// is empty, the grantee can vote with any option.
+	AllowedOptions []VoteOption `protobuf:"varint,1,rep,packed,name=allowed_options,json=allowedOptions,proto3,enum=atomone.gov.v1.VoteOption" json:"allowed_options,omitempty"`
+	// max_lock_periods defines the maximum number of unbonding periods the
+	// grantee can lock the stake of the granter for when voting. Zero doesn't
+	// allow to lock it.
+	MaxLockPeriods uint32 `protobuf:"varint,2,opt,name=max_lock_periods,json=maxLockPeriods,proto3" json:"max_lock_periods,omitempty"`
+}
+
+func (m *VoteAuthorization) Reset()         { *m = VoteAuthorization{} }
+func (m *VoteAuthorization) String() string { return proto.CompactTextString(m) }
+func (*VoteAuthorization) ProtoMessage()    {}
+func (*VoteAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f73cd82ae52b5104, []int{0}
+}
+func (m *VoteAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteAuthorization.Merge(m, src)
+}
+func (m *VoteAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteAuthorization proto.InternalMessageInfo
+
+func (m *VoteAuthorization) GetAllowedOptions() []VoteOption {
+	if m != nil {
+		return m.AllowedOptions
+	}
+	return nil
+}
+
+func (m *VoteAuthorization) GetMaxLockPeriods() uint32 {
+	if m != nil {
+		return m.MaxLockPeriods
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*VoteAuthorization)(nil), "atomone.gov.v1.VoteAuthorization")
+}
+
+func init() { proto.RegisterFile("atomone/gov/v1/authz.proto", fileDescriptor_f73cd82ae52b5104) }
+
+var fileDescriptor_f73cd82ae52b5104 = []byte{
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x2c, 0xc9, 0xcf,
+	0xcd, 0xcf, 0x4b, 0xd5, 0x4f, 0xcf, 0x2f, 0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8,
+	0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xca, 0xe9, 0xa5, 0xe7, 0x97, 0xe9, 0x95,
+	0x19, 0x4a, 0x49, 0xa0, 0xa9, 0x05, 0x09, 0x83, 0x55, 0x4a, 0x49, 0x26, 0xe7, 0x17, 0xe7, 0xe6,
+	0x17, 0xc7, 0x83, 0x79, 0xfa, 0x10, 0x0e, 0x54, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f,
+	0x4c, 0x42, 0x84, 0x94, 0x4e, 0x33, 0x72, 0x09, 0x86, 0xe5, 0x97, 0xa4, 0x3a, 0x96, 0x96, 0x64,
+	0xe4, 0x17, 0x65, 0x56, 0x25, 0x96, 0x64, 0xe6, 0xe7, 0x09, 0x39, 0x73, 0xf1, 0x27, 0xe6, 0xe4,
+	0xe4, 0x97, 0xa7, 0xa6, 0xc4, 0xe7, 0x17, 0x80, 0x44, 0x8a, 0x25, 0x18, 0x15, 0x98, 0x35, 0xf8,
+	0x8c, 0xa4, 0xf4, 0x50, 0xdd, 0xa1, 0x07, 0xd2, 0xeb, 0x0f, 0x56, 0x12, 0xc4, 0x07, 0xd5, 0x02,
+	0xe1, 0x16, 0x0b, 0x69, 0x70, 0x09, 0xe4, 0x26, 0x56, 0xc4, 0xe7, 0xe4, 0x27, 0x67, 0xc7, 0x17,
+	0xa4, 0x16, 0x65, 0xe6, 0xa7, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6a, 0xf0, 0x06, 0xf1, 0xe5, 0x26,
+	0x56, 0xf8, 0xe4, 0x27, 0x67, 0x07, 0x40, 0x44, 0xad, 0xdc, 0x4f, 0x6d, 0xd1, 0x55, 0x82, 0xba,
+	0x14, 0xe2, 0xe9, 0x32, 0xc3, 0xa4, 0xd4, 0x92, 0x44, 0x43, 0x3d, 0x14, 0x67, 0x75, 0x3d, 0xdf,
+	0xa0, 0x25, 0x03, 0xf3, 0x77, 0x99, 0xa1, 0x3e, 0x86, 0xbb, 0x9d, 0xdc, 0x4f, 0x3c, 0x92, 0x63,
+	0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96,
+	0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39,
+	0x3f, 0x57, 0x1f, 0x6a, 0x84, 0x6e, 0x46, 0x69, 0x12, 0x8c, 0xad, 0x5f, 0x01, 0x0e, 0xc8, 0x92,
+	0xca, 0x82, 0xd4, 0x62, 0xfd, 0x32, 0xc3, 0x24, 0x36, 0x70, 0xe8, 0x18, 0x03, 0x06, 0x00, 0x98,
+	0x72, 0x85, 0x26, 0x93, 0x01, 0x00, 0x00,
+}
+
+func (m *VoteAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxLockPeriods != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxLockPeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowedOptions) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedOptions)*10)
+		var j1 int
+		for _, num := range m.AllowedOptions {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthz(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VoteAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedOptions) > 0 {
+		l = 0
+		for _, e := range m.AllowedOptions {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if m.MaxLockPeriods != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxLockPeriods))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VoteAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v VoteOption
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VoteOption(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedOptions = append(m.AllowedOptions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AllowedOptions) == 0 {
+					m.AllowedOptions = make([]VoteOption, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VoteOption
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VoteOption(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedOptions = append(m.AllowedOptions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedOptions", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLockPeriods", wireType)
+			}
+			m.MaxLockPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLockPeriods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,61 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+func TestVoteAuthorization(t *testing.T) {
+	voter := sdk.AccAddress("voter")
+
+	testCases := []struct {
+		name          string
+		authorization *v1.VoteAuthorization
+		msg           sdk.Msg
+		expValidErr   bool
+		expAccept     bool
+	}{
+		{"any option", v1.NewVoteAuthorization(), v1.NewMsgVote(voter, 1, v1.OptionNoWithVeto, ""), false, true},
+		{"allowed option", v1.NewVoteAuthorization(v1.OptionYes, v1.OptionAbstain), v1.NewMsgVote(voter, 1, v1.OptionAbstain, ""), false, true},
+		{"option not allowed", v1.NewVoteAuthorization(v1.OptionYes, v1.OptionAbstain), v1.NewMsgVote(voter, 1, v1.OptionNo, ""), false, false},
+		{"lock periods not allowed", v1.NewVoteAuthorization(), lockedVote(v1.NewMsgVote(voter, 1, v1.OptionYes, ""), 1), false, false},
+		{"allowed lock periods", &v1.VoteAuthorization{MaxLockPeriods: 2}, lockedVote(v1.NewMsgVote(voter, 1, v1.OptionYes, ""), 2), false, true},
+		{"lock periods over the max", &v1.VoteAuthorization{MaxLockPeriods: 2}, lockedVote(v1.NewMsgVote(voter, 1, v1.OptionYes, ""), 3), false, false},
+		{"not a vote", v1.NewVoteAuthorization(), banktypes.NewMsgSend(voter, voter, nil), false, false},
+		{"invalid option", v1.NewVoteAuthorization(v1.OptionEmpty), nil, true, false},
+		{"duplicate option", v1.NewVoteAuthorization(v1.OptionYes, v1.OptionYes), nil, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, sdk.MsgTypeURL(&v1.MsgVote{}), tc.authorization.MsgTypeURL())
+
+			err := tc.authorization.ValidateBasic()
+			if tc.expValidErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := tc.authorization.Accept(sdk.Context{}, tc.msg)
+			if !tc.expAccept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, res.Accept)
+			require.False(t, res.Delete)
+		})
+	}
+}
+
+func lockedVote(msg *v1.MsgVote, lockPeriods uint32) *v1.MsgVote {
+	msg.LockPeriods = lockPeriods
+	return msg
+}
@@ -6,6 +6,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
@@ -30,6 +31,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "atomone/v1/MsgVoteWeighted")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "atomone/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "atomone/x/gov/v1/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgProposeConstitutionAmendment{}, "atomone/v1/MsgProposeConstitutionAmend")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleRecurringProposal{}, "atomone/v1/MsgScheduleRecurringProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelRecurringProposal{}, "atomone/v1/MsgCancelRecurringProposal")
+	cdc.RegisterConcrete(&VoteAuthorization{}, "atomone/v1/VoteAuthorization", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -41,6 +46,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
+		&MsgProposeConstitutionAmendment{},
+		&MsgScheduleRecurringProposal{},
+		&MsgCancelRecurringProposal{},
+	)
+
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&VoteAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
+)
+
+// GovActionMsgTypeURLs returns the type URLs of the governance actions covered
+// by the fee allowances of NewGovActionsAllowance: the votes and deposits.
+func GovActionMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgVote{}),
+		sdk.MsgTypeURL(&MsgVoteWeighted{}),
+		sdk.MsgTypeURL(&MsgDeposit{}),
+		sdk.MsgTypeURL(&v1beta1.MsgVote{}),
+		sdk.MsgTypeURL(&v1beta1.MsgVoteWeighted{}),
+		sdk.MsgTypeURL(&v1beta1.MsgDeposit{}),
+	}
+}
+
+// NewGovActionsAllowance returns a fee allowance restricted to the governance
+// actions, so that a granter can sponsor the fees of the votes and deposits of
+// the grantee without paying for its other transactions. An empty spendLimit
+// doesn't limit the fees paid, and a nil expiration doesn't expire.
+func NewGovActionsAllowance(spendLimit sdk.Coins, expiration *time.Time) (*feegrant.AllowedMsgAllowance, error) {
+	return feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}, GovActionMsgTypeURLs())
+}
@@ -0,0 +1,55 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
+)
+
+func TestGovActionsAllowance(t *testing.T) {
+	voter := sdk.AccAddress("voter")
+	now := time.Now()
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Time: now}).WithGasMeter(sdk.NewInfiniteGasMeter())
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uphoton", 10))
+
+	expiration := now.Add(time.Hour)
+	allowance, err := v1.NewGovActionsAllowance(sdk.NewCoins(sdk.NewInt64Coin("uphoton", 15)), &expiration)
+	require.NoError(t, err)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// votes and deposits are sponsored
+	for _, msg := range []sdk.Msg{
+		v1.NewMsgVote(voter, 1, v1.OptionYes, ""),
+		v1.NewMsgVoteWeighted(voter, 1, v1.NewNonSplitVoteOption(v1.OptionNo), ""),
+		v1.NewMsgDeposit(voter, 1, fee),
+		v1beta1.NewMsgVote(voter, 1, v1beta1.OptionYes),
+	} {
+		allowance, err := v1.NewGovActionsAllowance(nil, nil)
+		require.NoError(t, err)
+		remove, err := allowance.Accept(ctx, fee, []sdk.Msg{msg})
+		require.NoError(t, err, sdk.MsgTypeURL(msg))
+		require.False(t, remove)
+	}
+
+	// other messages are not
+	_, err = allowance.Accept(ctx, fee, []sdk.Msg{
+		v1.NewMsgVote(voter, 1, v1.OptionYes, ""),
+		banktypes.NewMsgSend(voter, voter, fee),
+	})
+	require.Error(t, err)
+
+	// the spend limit applies
+	_, err = allowance.Accept(ctx, fee, []sdk.Msg{v1.NewMsgVote(voter, 1, v1.OptionYes, "")})
+	require.NoError(t, err)
+	_, err = allowance.Accept(ctx, fee, []sdk.Msg{v1.NewMsgVote(voter, 1, v1.OptionYes, "")})
+	require.Error(t, err)
+}
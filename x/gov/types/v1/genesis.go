@@ -7,6 +7,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -51,6 +52,15 @@ func ValidateGenesis(data *GenesisState) error {
 		proposalIds[p.Id] = struct{}{}
 	}
 
+	// weed out archived proposals colliding with live or other archived proposals
+	for _, p := range data.ArchivedProposals {
+		if _, ok := proposalIds[p.Id]; ok {
+			return fmt.Errorf("duplicate proposal id: %d", p.Id)
+		}
+
+		proposalIds[p.Id] = struct{}{}
+	}
+
 	// weed out duplicate deposits
 	errGroup.Go(func() error {
 		type depositKey struct {
@@ -63,6 +73,12 @@ func ValidateGenesis(data *GenesisState) error {
 				return fmt.Errorf("deposit %v has non-existent proposal id: %d", d, d.ProposalId)
 			}
 
+			if d.RefundAddress != "" {
+				if _, err := sdk.AccAddressFromBech32(d.RefundAddress); err != nil {
+					return fmt.Errorf("deposit %v has an invalid refund address: %w", d, err)
+				}
+			}
+
 			dk := depositKey{d.ProposalId, d.Depositor}
 			if _, ok := depositIds[dk]; ok {
 				return fmt.Errorf("duplicate deposit: %v", d)
@@ -97,6 +113,86 @@ func ValidateGenesis(data *GenesisState) error {
 		return nil
 	})
 
+	// verify the proposer bounty pool and weed out duplicate proposer bounties
+	errGroup.Go(func() error {
+		if data.BountyPool != nil {
+			if pool := sdk.Coins(data.BountyPool.Amount); !pool.IsValid() {
+				return fmt.Errorf("invalid proposer bounty pool: %s", pool)
+			}
+		}
+
+		bountyIds := make(map[uint64]struct{})
+		for _, b := range data.ProposerBounties {
+			if _, ok := bountyIds[b.ProposalId]; ok {
+				return fmt.Errorf("duplicate proposer bounty for proposal id: %d", b.ProposalId)
+			}
+
+			bountyIds[b.ProposalId] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// verify the community pool spend period
+	errGroup.Go(func() error {
+		if data.CommunityPoolSpendPeriod == nil {
+			return nil
+		}
+		if data.CommunityPoolSpendPeriod.StartTime == nil {
+			return fmt.Errorf("community pool spend period start time must not be nil")
+		}
+		if spent := sdk.Coins(data.CommunityPoolSpendPeriod.Spent); !spent.IsValid() {
+			return fmt.Errorf("invalid community pool spend period spent amount: %s", spent)
+		}
+		return nil
+	})
+
+	// verify the vote locks and weed out duplicate ones
+	errGroup.Go(func() error {
+		voters := make(map[string]struct{})
+		for _, l := range data.VoteLocks {
+			if _, err := sdk.AccAddressFromBech32(l.Voter); err != nil {
+				return fmt.Errorf("invalid vote lock voter address: %w", err)
+			}
+			if _, ok := voters[l.Voter]; ok {
+				return fmt.Errorf("duplicate vote lock for voter: %s", l.Voter)
+			}
+			if l.EndTime == nil {
+				return fmt.Errorf("vote lock end time must not be nil")
+			}
+			for _, locked := range l.Shares {
+				shares, err := sdk.NewDecFromStr(locked.Shares)
+				if err != nil || !shares.IsPositive() {
+					return fmt.Errorf("invalid vote lock shares: %s", locked.Shares)
+				}
+			}
+
+			voters[l.Voter] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// verify the recurring proposals and weed out duplicate ones
+	errGroup.Go(func() error {
+		ids := make(map[uint64]struct{})
+		for _, p := range data.RecurringProposals {
+			if err := p.ValidateBasic(); err != nil {
+				return err
+			}
+			if _, ok := ids[p.Id]; ok {
+				return fmt.Errorf("duplicate recurring proposal id: %d", p.Id)
+			}
+			if p.Id >= data.NextRecurringProposalId {
+				return fmt.Errorf("recurring proposal id %d is not lower than the next recurring proposal id %d", p.Id, data.NextRecurringProposalId)
+			}
+
+			ids[p.Id] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic()
@@ -115,5 +211,11 @@ func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, p := range data.RecurringProposals {
+		err := p.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
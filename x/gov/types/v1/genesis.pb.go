@@ -45,6 +45,27 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Params *Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty"`
+	// constitution is the constitution of the chain.
+	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// turnouts defines the turnouts of all the tallied proposals present at
+	// genesis.
+	Turnouts []*ProposalTurnout `protobuf:"bytes,10,rep,name=turnouts,proto3" json:"turnouts,omitempty"`
+	// archived_proposals defines all the archived proposals present at genesis.
+	ArchivedProposals []*ArchivedProposal `protobuf:"bytes,11,rep,name=archived_proposals,json=archivedProposals,proto3" json:"archived_proposals,omitempty"`
+	// bounty_pool defines the proposer bounty pool at genesis.
+	BountyPool *BountyPool `protobuf:"bytes,12,opt,name=bounty_pool,json=bountyPool,proto3" json:"bounty_pool,omitempty"`
+	// proposer_bounties defines all the proposer bounties paid at genesis.
+	ProposerBounties []*ProposerBounty `protobuf:"bytes,13,rep,name=proposer_bounties,json=proposerBounties,proto3" json:"proposer_bounties,omitempty"`
+	// community_pool_spend_period defines the community pool spend of the
+	// current period at genesis.
+	CommunityPoolSpendPeriod *CommunityPoolSpendPeriod `protobuf:"bytes,14,opt,name=community_pool_spend_period,json=communityPoolSpendPeriod,proto3" json:"community_pool_spend_period,omitempty"`
+	// vote_locks defines all the vote locks present at genesis.
+	VoteLocks []*VoteLock `protobuf:"bytes,15,rep,name=vote_locks,json=voteLocks,proto3" json:"vote_locks,omitempty"`
+	// recurring_proposals defines all the recurring proposals present at
+	// genesis.
+	RecurringProposals []*RecurringProposal `protobuf:"bytes,16,rep,name=recurring_proposals,json=recurringProposals,proto3" json:"recurring_proposals,omitempty"`
+	// next_recurring_proposal_id is the id of the next recurring proposal.
+	NextRecurringProposalId uint64 `protobuf:"varint,17,opt,name=next_recurring_proposal_id,json=nextRecurringProposalId,proto3" json:"next_recurring_proposal_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -139,6 +160,69 @@ func (m *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (m *GenesisState) GetConstitution() string {
+	if m != nil {
+		return m.Constitution
+	}
+	return ""
+}
+
+func (m *GenesisState) GetTurnouts() []*ProposalTurnout {
+	if m != nil {
+		return m.Turnouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedProposals() []*ArchivedProposal {
+	if m != nil {
+		return m.ArchivedProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBountyPool() *BountyPool {
+	if m != nil {
+		return m.BountyPool
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposerBounties() []*ProposerBounty {
+	if m != nil {
+		return m.ProposerBounties
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommunityPoolSpendPeriod() *CommunityPoolSpendPeriod {
+	if m != nil {
+		return m.CommunityPoolSpendPeriod
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteLocks() []*VoteLock {
+	if m != nil {
+		return m.VoteLocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecurringProposals() []*RecurringProposal {
+	if m != nil {
+		return m.RecurringProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRecurringProposalId() uint64 {
+	if m != nil {
+		return m.NextRecurringProposalId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "atomone.gov.v1.GenesisState")
 }
@@ -146,30 +230,45 @@ func init() {
 func init() { proto.RegisterFile("atomone/gov/v1/genesis.proto", fileDescriptor_7737a96fb154b10d) }
 
 var fileDescriptor_7737a96fb154b10d = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x4e, 0x02, 0x31,
-	0x14, 0x85, 0x29, 0x7f, 0x62, 0xf9, 0x59, 0x34, 0x44, 0x1b, 0xc5, 0x09, 0x71, 0x45, 0x4c, 0xe8,
-	0x08, 0x24, 0x3e, 0x00, 0xd1, 0xa0, 0x3b, 0x32, 0x1a, 0x17, 0x6e, 0x48, 0x71, 0x9a, 0x61, 0x12,
-	0xe0, 0x4e, 0xa6, 0xa5, 0x91, 0xb7, 0xf0, 0xb1, 0x5c, 0xb2, 0x74, 0x65, 0x0c, 0xbc, 0x88, 0xa1,
-	0x33, 0x23, 0x38, 0xea, 0xae, 0xb9, 0xe7, 0x3b, 0xa7, 0x27, 0x37, 0x17, 0x37, 0xb8, 0x82, 0x19,
-	0xcc, 0x85, 0xed, 0x81, 0xb6, 0x75, 0xc7, 0xf6, 0xc4, 0x5c, 0x48, 0x5f, 0xb2, 0x20, 0x04, 0x05,
-	0xa4, 0x16, 0xab, 0xcc, 0x03, 0xcd, 0x74, 0xe7, 0x84, 0xa6, 0x69, 0xd0, 0x11, 0x79, 0xfe, 0x91,
-	0xc3, 0x95, 0x41, 0xe4, 0xbd, 0x57, 0x5c, 0x09, 0x72, 0x89, 0xeb, 0x52, 0xf1, 0x50, 0xf9, 0x73,
-	0x6f, 0x14, 0x84, 0x10, 0x80, 0xe4, 0xd3, 0x91, 0xef, 0x52, 0xd4, 0x44, 0xad, 0xbc, 0x43, 0x12,
-	0x6d, 0x18, 0x4b, 0x77, 0x2e, 0xe9, 0xe1, 0x92, 0x2b, 0x02, 0x90, 0xbe, 0x92, 0x34, 0xdb, 0xcc,
-	0xb5, 0xca, 0xdd, 0x63, 0xf6, 0xf3, 0x7f, 0x76, 0x1d, 0xe9, 0xce, 0x37, 0x48, 0x2e, 0x70, 0x41,
-	0x83, 0x12, 0x92, 0xe6, 0x8c, 0xa3, 0x9e, 0x76, 0x3c, 0x82, 0x12, 0x4e, 0x84, 0x90, 0x2b, 0x7c,
-	0x98, 0x34, 0x91, 0x34, 0x6f, 0x78, 0x9a, 0xe6, 0x93, 0x3e, 0xce, 0x0e, 0x25, 0xb7, 0xb8, 0x16,
-	0xff, 0x37, 0x0a, 0x78, 0xc8, 0x67, 0x92, 0x16, 0x9a, 0xa8, 0x55, 0xee, 0x9e, 0xfd, 0x53, 0x6f,
-	0x68, 0xa0, 0x7e, 0x96, 0x22, 0xa7, 0xea, 0xee, 0x8f, 0xc8, 0x0d, 0xae, 0x6a, 0x88, 0x56, 0x12,
-	0x05, 0x15, 0x4d, 0x50, 0xe3, 0x8f, 0xd6, 0xdb, 0xdd, 0xec, 0x72, 0x2a, 0x7a, 0x6f, 0x42, 0xfa,
-	0xb8, 0xa2, 0xf8, 0x74, 0xba, 0x4c, 0x52, 0x0e, 0x4c, 0xca, 0x69, 0x3a, 0xe5, 0x61, 0xcb, 0xec,
-	0x85, 0x94, 0xd5, 0x6e, 0x40, 0x18, 0x2e, 0xc6, 0xee, 0x92, 0x71, 0x1f, 0xfd, 0xda, 0x84, 0x51,
-	0x9d, 0x98, 0xea, 0x0f, 0xde, 0xd6, 0x16, 0x5a, 0xad, 0x2d, 0xf4, 0xb9, 0xb6, 0xd0, 0xeb, 0xc6,
-	0xca, 0xac, 0x36, 0x56, 0xe6, 0x7d, 0x63, 0x65, 0x9e, 0xda, 0x9e, 0xaf, 0x26, 0x8b, 0x31, 0x7b,
-	0x86, 0x99, 0x1d, 0x67, 0xb4, 0x27, 0x8b, 0x71, 0xf2, 0xb6, 0x5f, 0xcc, 0xb5, 0xa8, 0x65, 0x20,
-	0xa4, 0xad, 0x3b, 0xe3, 0xa2, 0x39, 0x98, 0xde, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x1f,
-	0x36, 0x2b, 0x7a, 0x02, 0x00, 0x00,
+	// 596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xeb, 0xfe, 0xfb, 0x92, 0x49, 0xda, 0xaf, 0x1d, 0x2a, 0x3a, 0x4a, 0x8b, 0x09, 0x5d,
+	0x45, 0x48, 0xb5, 0x49, 0x2b, 0xc1, 0xa2, 0x2b, 0x02, 0xa8, 0x54, 0x20, 0x11, 0x4d, 0x2b, 0x16,
+	0x6c, 0x2c, 0xc7, 0x1e, 0x39, 0xa3, 0x3a, 0xbe, 0xd6, 0xcc, 0xd8, 0x6a, 0xde, 0x82, 0xc7, 0x62,
+	0x83, 0xd4, 0x25, 0x4b, 0x94, 0xbc, 0x08, 0xf2, 0xd8, 0x4e, 0x52, 0x27, 0xd9, 0x8d, 0xef, 0x3d,
+	0xe7, 0xe7, 0xe3, 0x6b, 0xfb, 0xa2, 0x53, 0x57, 0xc1, 0x08, 0x22, 0x66, 0x07, 0x90, 0xda, 0x69,
+	0xd7, 0x0e, 0x58, 0xc4, 0x24, 0x97, 0x56, 0x2c, 0x40, 0x01, 0xde, 0x2f, 0xba, 0x56, 0x00, 0xa9,
+	0x95, 0x76, 0x5b, 0xa4, 0xaa, 0x86, 0x34, 0x57, 0x9e, 0xfd, 0xae, 0xa1, 0xe6, 0x75, 0xee, 0xbd,
+	0x55, 0xae, 0x62, 0xf8, 0x0d, 0x3a, 0x92, 0xca, 0x15, 0x8a, 0x47, 0x81, 0x13, 0x0b, 0x88, 0x41,
+	0xba, 0xa1, 0xc3, 0x7d, 0x62, 0xb4, 0x8d, 0xce, 0x36, 0xc5, 0x65, 0xaf, 0x5f, 0xb4, 0x6e, 0x7c,
+	0x7c, 0x89, 0x6a, 0x3e, 0x8b, 0x41, 0x72, 0x25, 0xc9, 0x66, 0x7b, 0xab, 0xd3, 0xb8, 0x38, 0xb6,
+	0x9e, 0xde, 0xdf, 0xfa, 0x98, 0xf7, 0xe9, 0x4c, 0x88, 0x5f, 0xa3, 0x9d, 0x14, 0x14, 0x93, 0x64,
+	0x4b, 0x3b, 0x8e, 0xaa, 0x8e, 0xef, 0xa0, 0x18, 0xcd, 0x25, 0xf8, 0x2d, 0xaa, 0x97, 0x49, 0x24,
+	0xd9, 0xd6, 0x7a, 0x52, 0xd5, 0x97, 0x79, 0xe8, 0x5c, 0x8a, 0x3f, 0xa3, 0xfd, 0xe2, 0x7e, 0x4e,
+	0xec, 0x0a, 0x77, 0x24, 0xc9, 0x4e, 0xdb, 0xe8, 0x34, 0x2e, 0x5e, 0xac, 0x89, 0xd7, 0xd7, 0xa2,
+	0xde, 0x26, 0x31, 0xe8, 0x9e, 0xbf, 0x58, 0xc2, 0x9f, 0xd0, 0x5e, 0x0a, 0xf9, 0x48, 0x72, 0xd0,
+	0xae, 0x06, 0x9d, 0xae, 0x48, 0x9d, 0xcd, 0x66, 0xce, 0x69, 0xa6, 0x0b, 0x15, 0xdc, 0x43, 0x4d,
+	0xe5, 0x86, 0xe1, 0xb8, 0xa4, 0xfc, 0xa7, 0x29, 0x27, 0x55, 0xca, 0x5d, 0xa6, 0x59, 0x80, 0x34,
+	0xd4, 0xbc, 0x80, 0x2d, 0xb4, 0x5b, 0xb8, 0x6b, 0xda, 0xfd, 0x7c, 0x69, 0x12, 0xba, 0x4b, 0x0b,
+	0x15, 0x3e, 0x43, 0x4d, 0x0f, 0x22, 0xa9, 0xb8, 0x4a, 0x14, 0x87, 0x88, 0xd4, 0xdb, 0x46, 0xa7,
+	0x4e, 0x9f, 0xd4, 0xf0, 0x15, 0xaa, 0xa9, 0x44, 0x44, 0x90, 0x28, 0x49, 0x90, 0x9e, 0xef, 0xcb,
+	0x75, 0xf3, 0xbd, 0xcb, 0x75, 0x74, 0x66, 0xc0, 0xdf, 0x10, 0x76, 0x85, 0x37, 0xe4, 0x29, 0xf3,
+	0x9d, 0xf9, 0x6b, 0x6a, 0x68, 0x4c, 0xbb, 0x8a, 0x79, 0x5f, 0x28, 0x67, 0xaf, 0xeb, 0xd0, 0xad,
+	0x54, 0x24, 0xbe, 0x42, 0x8d, 0x01, 0x24, 0x91, 0x1a, 0x3b, 0x31, 0x40, 0x48, 0x9a, 0xfa, 0x31,
+	0x5b, 0x55, 0x52, 0x4f, 0x4b, 0xfa, 0x00, 0x21, 0x45, 0x83, 0xd9, 0x19, 0x7f, 0x41, 0x87, 0x79,
+	0x08, 0x26, 0x1c, 0x5d, 0xe6, 0x4c, 0x92, 0x3d, 0x1d, 0xc6, 0x5c, 0xfd, 0x4c, 0x4c, 0xe4, 0x28,
+	0x7a, 0x10, 0x2f, 0x5e, 0x73, 0x26, 0x71, 0x80, 0x4e, 0x3c, 0x18, 0x8d, 0x92, 0x88, 0x17, 0x61,
+	0x1c, 0x19, 0xb3, 0xc8, 0x77, 0x62, 0x26, 0x38, 0xf8, 0x64, 0x5f, 0x27, 0xeb, 0x54, 0xb1, 0x1f,
+	0x4a, 0x4b, 0x16, 0xe8, 0x36, 0x33, 0xf4, 0xb5, 0x9e, 0x12, 0x6f, 0x4d, 0x07, 0xbf, 0x43, 0x28,
+	0xfb, 0xd4, 0x9d, 0x10, 0xbc, 0x7b, 0x49, 0xfe, 0x5f, 0xfd, 0x89, 0x67, 0xbf, 0xc4, 0x57, 0xf0,
+	0xee, 0x69, 0x3d, 0x2d, 0x4e, 0x12, 0x53, 0xf4, 0x4c, 0x30, 0x2f, 0x11, 0x62, 0xf1, 0x77, 0x95,
+	0xe4, 0x40, 0x13, 0x5e, 0x55, 0x09, 0xb4, 0x94, 0xce, 0xc6, 0x8f, 0x45, 0xb5, 0x94, 0xcd, 0xbf,
+	0x15, 0xb1, 0x07, 0xe5, 0x2c, 0x83, 0xb3, 0x3d, 0x70, 0xa8, 0xf7, 0xc0, 0x71, 0xa6, 0x58, 0xc2,
+	0xdd, 0xf8, 0xbd, 0xeb, 0x5f, 0x13, 0xd3, 0x78, 0x9c, 0x98, 0xc6, 0xdf, 0x89, 0x69, 0xfc, 0x9c,
+	0x9a, 0x1b, 0x8f, 0x53, 0x73, 0xe3, 0xcf, 0xd4, 0xdc, 0xf8, 0x71, 0x1e, 0x70, 0x35, 0x4c, 0x06,
+	0x96, 0x07, 0x23, 0xbb, 0xc8, 0x75, 0x3e, 0x4c, 0x06, 0xe5, 0xd9, 0x7e, 0xd0, 0xcb, 0x49, 0x8d,
+	0x63, 0x26, 0xed, 0xb4, 0x3b, 0xd8, 0xd5, 0xfb, 0xe9, 0xf2, 0xdf, 0x00, 0x1f, 0x0e, 0x79, 0xd5,
+	0xe9, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -192,6 +291,116 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextRecurringProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRecurringProposalId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.RecurringProposals) > 0 {
+		for iNdEx := len(m.RecurringProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.VoteLocks) > 0 {
+		for iNdEx := len(m.VoteLocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.CommunityPoolSpendPeriod != nil {
+		{
+			size, err := m.CommunityPoolSpendPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ProposerBounties) > 0 {
+		for iNdEx := len(m.ProposerBounties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerBounties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.BountyPool != nil {
+		{
+			size, err := m.BountyPool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ArchivedProposals) > 0 {
+		for iNdEx := len(m.ArchivedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Turnouts) > 0 {
+		for iNdEx := len(m.Turnouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Turnouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Constitution) > 0 {
+		i -= len(m.Constitution)
+		copy(dAtA[i:], m.Constitution)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Constitution)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -344,6 +553,51 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Params.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Turnouts) > 0 {
+		for _, e := range m.Turnouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedProposals) > 0 {
+		for _, e := range m.ArchivedProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BountyPool != nil {
+		l = m.BountyPool.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ProposerBounties) > 0 {
+		for _, e := range m.ProposerBounties {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CommunityPoolSpendPeriod != nil {
+		l = m.CommunityPoolSpendPeriod.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.VoteLocks) > 0 {
+		for _, e := range m.VoteLocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecurringProposals) > 0 {
+		for _, e := range m.RecurringProposals {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRecurringProposalId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextRecurringProposalId))
+	}
 	return n
 }
 
@@ -647,6 +901,299 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constitution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constitution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Turnouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Turnouts = append(m.Turnouts, &ProposalTurnout{})
+			if err := m.Turnouts[len(m.Turnouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedProposals = append(m.ArchivedProposals, &ArchivedProposal{})
+			if err := m.ArchivedProposals[len(m.ArchivedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BountyPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BountyPool == nil {
+				m.BountyPool = &BountyPool{}
+			}
+			if err := m.BountyPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBounties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerBounties = append(m.ProposerBounties, &ProposerBounty{})
+			if err := m.ProposerBounties[len(m.ProposerBounties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpendPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommunityPoolSpendPeriod == nil {
+				m.CommunityPoolSpendPeriod = &CommunityPoolSpendPeriod{}
+			}
+			if err := m.CommunityPoolSpendPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteLocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteLocks = append(m.VoteLocks, &VoteLock{})
+			if err := m.VoteLocks[len(m.VoteLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringProposals = append(m.RecurringProposals, &RecurringProposal{})
+			if err := m.RecurringProposals[len(m.RecurringProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRecurringProposalId", wireType)
+			}
+			m.NextRecurringProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRecurringProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
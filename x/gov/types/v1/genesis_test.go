@@ -2,6 +2,7 @@ package v1_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -92,6 +93,147 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "veto threshold too large",
 		},
+		{
+			name: "community pool spend limit without period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.CommunityPoolSpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+				period := time.Duration(0)
+				params1.CommunityPoolSpendPeriod = &period
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "community pool spend period must be positive with a community pool spend limit",
+		},
+		{
+			name: "max voting period without min voting period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				minVotingPeriod := time.Duration(0)
+				maxVotingPeriod := time.Hour
+				params1.MinVotingPeriod = &minVotingPeriod
+				params1.MaxVotingPeriod = &maxVotingPeriod
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "min voting period must be positive with a max voting period",
+		},
+		{
+			name: "min voting period greater than max voting period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				minVotingPeriod := 2 * time.Hour
+				maxVotingPeriod := time.Hour
+				params1.MinVotingPeriod = &minVotingPeriod
+				params1.MaxVotingPeriod = &maxVotingPeriod
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "must not be greater than the max voting period",
+		},
+		{
+			name: "invalid proposal metadata schema",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ProposalMetadataSchema = `{"$ref": "https://example.com/schema.json"}`
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "must not reference remote documents",
+		},
+		{
+			name: "duplicate message review periods",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				reviewPeriod := time.Hour
+				params1.MessageReviewPeriods = []*v1.MessageReviewPeriod{
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", ReviewPeriod: &reviewPeriod},
+					{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", ReviewPeriod: &reviewPeriod},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "duplicate message review period",
+		},
+		{
+			name: "negative quadratic voting power cap",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.QuadraticVotingPowerCap = "-1"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "quadratic voting power cap must not be negative",
+		},
+		{
+			name: "invalid module account stake rule",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ModuleAccountStakeRule = 3
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "invalid module account stake rule",
+		},
+		{
+			name: "duplicate deposit denom weight",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.DepositDenomWeights = []*v1.DepositDenomWeight{
+					{Denom: sdk.DefaultBondDenom, Weight: "1"},
+					{Denom: sdk.DefaultBondDenom, Weight: "2"},
+				}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "duplicate deposit denom weight",
+		},
+		{
+			name: "zero deposit denom weight",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.DepositDenomWeights = []*v1.DepositDenomWeight{{Denom: sdk.DefaultBondDenom, Weight: "0"}}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "must be positive",
+		},
+		{
+			name: "min deposit denom without weight",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.DepositDenomWeights = []*v1.DepositDenomWeight{{Denom: "uphoton", Weight: "1"}}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErrMsg: "has no deposit denom weight",
+		},
+		{
+			name: "duplicate vote locks",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				endTime := time.Now()
+				lock := &v1.VoteLock{
+					Voter:   "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh",
+					EndTime: &endTime,
+					Shares:  []*v1.LockedShares{{ValidatorAddress: "cosmosvaloper1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3z8ah8h", Shares: "1"}},
+				}
+				state.VoteLocks = []*v1.VoteLock{lock, lock}
+
+				return state
+			},
+			expErrMsg: "duplicate vote lock for voter",
+		},
+		{
+			name: "community pool spend period without start time",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.CommunityPoolSpendPeriod = &v1.CommunityPoolSpendPeriod{}
+
+				return state
+			},
+			expErrMsg: "community pool spend period start time must not be nil",
+		},
 		{
 			name: "duplicate proposals",
 			genesisState: func() *v1.GenesisState {
@@ -93,6 +93,13 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_QUEUED defines a proposal status of a proposal that has
+	// passed, but whose community pool spend exceeds the remaining spend limit
+	// of the period. It is executed once the spend limit allows it.
+	ProposalStatus_PROPOSAL_STATUS_QUEUED ProposalStatus = 6
+	// PROPOSAL_STATUS_REVIEW_PERIOD defines a proposal status of a proposal that
+	// reached its minimum deposit, but whose voting period didn't start yet.
+	ProposalStatus_PROPOSAL_STATUS_REVIEW_PERIOD ProposalStatus = 7
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -102,6 +109,8 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_QUEUED",
+	7: "PROPOSAL_STATUS_REVIEW_PERIOD",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -111,6 +120,8 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_QUEUED":         6,
+	"PROPOSAL_STATUS_REVIEW_PERIOD":  7,
 }
 
 func (x ProposalStatus) String() string {
@@ -121,6 +132,42 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ecf0f9950ff6986c, []int{1}
 }
 
+// ModuleAccountStakeRule defines how the bonded stake held by the module
+// accounts, which can't vote, is accounted in the tally.
+type ModuleAccountStakeRule int32
+
+const (
+	// MODULE_ACCOUNT_STAKE_RULE_COUNT counts the stake toward the bonded tokens
+	// the quorum is computed against, as the stake of a non-voter.
+	ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_COUNT ModuleAccountStakeRule = 0
+	// MODULE_ACCOUNT_STAKE_RULE_ABSTAIN counts the stake as Abstain votes, toward
+	// the quorum but not the outcome.
+	ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_ABSTAIN ModuleAccountStakeRule = 1
+	// MODULE_ACCOUNT_STAKE_RULE_EXCLUDE excludes the stake from the bonded tokens
+	// the quorum is computed against.
+	ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_EXCLUDE ModuleAccountStakeRule = 2
+)
+
+var ModuleAccountStakeRule_name = map[int32]string{
+	0: "MODULE_ACCOUNT_STAKE_RULE_COUNT",
+	1: "MODULE_ACCOUNT_STAKE_RULE_ABSTAIN",
+	2: "MODULE_ACCOUNT_STAKE_RULE_EXCLUDE",
+}
+
+var ModuleAccountStakeRule_value = map[string]int32{
+	"MODULE_ACCOUNT_STAKE_RULE_COUNT":   0,
+	"MODULE_ACCOUNT_STAKE_RULE_ABSTAIN": 1,
+	"MODULE_ACCOUNT_STAKE_RULE_EXCLUDE": 2,
+}
+
+func (x ModuleAccountStakeRule) String() string {
+	return proto.EnumName(ModuleAccountStakeRule_name, int32(x))
+}
+
+func (ModuleAccountStakeRule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{2}
+}
+
 // WeightedVoteOption defines a unit of vote for vote split.
 type WeightedVoteOption struct {
 	// option defines the valid vote options, it must not contain duplicate vote options.
@@ -185,6 +232,9 @@ type Deposit struct {
 	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	// amount to be deposited by depositor.
 	Amount []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+	// refund_address defines the address the deposit is refunded to, instead of
+	// the depositor, if set.
+	RefundAddress string `protobuf:"bytes,4,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 }
 
 func (m *Deposit) Reset()         { *m = Deposit{} }
@@ -241,6 +291,13 @@ func (m *Deposit) GetAmount() []types.Coin {
 	return nil
 }
 
+func (m *Deposit) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
 // Proposal defines the core field members of a governance proposal.
 type Proposal struct {
 	// id defines the unique id of the proposal.
@@ -277,6 +334,19 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Proposer string `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// voting_period is the voting period requested by the proposer. When
+	// empty, the voting_period param applies.
+	VotingPeriod *time.Duration `protobuf:"bytes,14,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	// review_end_time is the end time of the review period, during which the
+	// proposal reached its minimum deposit but cannot be voted on yet.
+	ReviewEndTime *time.Time `protobuf:"bytes,15,opt,name=review_end_time,json=reviewEndTime,proto3,stdtime" json:"review_end_time,omitempty"`
+	// quadratic is true if the voting power of the voters is counted as the
+	// square root of their stake to decide the outcome of the proposal.
+	Quadratic bool `protobuf:"varint,16,opt,name=quadratic,proto3" json:"quadratic,omitempty"`
+	// final_quadratic_tally_result is the final tally result of a quadratic
+	// proposal, counting the square root of the stake of the voters. The
+	// final_tally_result keeps counting their stake.
+	FinalQuadraticTallyResult *TallyResult `protobuf:"bytes,17,opt,name=final_quadratic_tally_result,json=finalQuadraticTallyResult,proto3" json:"final_quadratic_tally_result,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -403,6 +473,34 @@ func (m *Proposal) GetProposer() string {
 	return ""
 }
 
+func (m *Proposal) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return nil
+}
+
+func (m *Proposal) GetReviewEndTime() *time.Time {
+	if m != nil {
+		return m.ReviewEndTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetQuadratic() bool {
+	if m != nil {
+		return m.Quadratic
+	}
+	return false
+}
+
+func (m *Proposal) GetFinalQuadraticTallyResult() *TallyResult {
+	if m != nil {
+		return m.FinalQuadraticTallyResult
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -476,31 +574,26 @@ func (m *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
-// Vote defines a vote on a governance proposal.
-// A Vote consists of a proposal ID, the voter, and the vote option.
-type Vote struct {
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// voter is the voter address of the proposal.
-	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	// options is the weighted vote options.
-	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
-	// metadata is any  arbitrary metadata to attached to the vote.
-	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+// ProposalStatusCount defines the number of proposals in a given status.
+type ProposalStatusCount struct {
+	// status defines the proposal status.
+	Status ProposalStatus `protobuf:"varint,1,opt,name=status,proto3,enum=atomone.gov.v1.ProposalStatus" json:"status,omitempty"`
+	// count is the number of proposals in the given status.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *Vote) Reset()         { *m = Vote{} }
-func (m *Vote) String() string { return proto.CompactTextString(m) }
-func (*Vote) ProtoMessage()    {}
-func (*Vote) Descriptor() ([]byte, []int) {
+func (m *ProposalStatusCount) Reset()         { *m = ProposalStatusCount{} }
+func (m *ProposalStatusCount) String() string { return proto.CompactTextString(m) }
+func (*ProposalStatusCount) ProtoMessage()    {}
+func (*ProposalStatusCount) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ecf0f9950ff6986c, []int{4}
 }
-func (m *Vote) XXX_Unmarshal(b []byte) error {
+func (m *ProposalStatusCount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProposalStatusCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProposalStatusCount.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -510,67 +603,53 @@ func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Vote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vote.Merge(m, src)
+func (m *ProposalStatusCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalStatusCount.Merge(m, src)
 }
-func (m *Vote) XXX_Size() int {
+func (m *ProposalStatusCount) XXX_Size() int {
 	return m.Size()
 }
-func (m *Vote) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vote.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Vote proto.InternalMessageInfo
-
-func (m *Vote) GetProposalId() uint64 {
-	if m != nil {
-		return m.ProposalId
-	}
-	return 0
+func (m *ProposalStatusCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalStatusCount.DiscardUnknown(m)
 }
 
-func (m *Vote) GetVoter() string {
-	if m != nil {
-		return m.Voter
-	}
-	return ""
-}
+var xxx_messageInfo_ProposalStatusCount proto.InternalMessageInfo
 
-func (m *Vote) GetOptions() []*WeightedVoteOption {
+func (m *ProposalStatusCount) GetStatus() ProposalStatus {
 	if m != nil {
-		return m.Options
+		return m.Status
 	}
-	return nil
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
 }
 
-func (m *Vote) GetMetadata() string {
+func (m *ProposalStatusCount) GetCount() uint64 {
 	if m != nil {
-		return m.Metadata
+		return m.Count
 	}
-	return ""
+	return 0
 }
 
-// DepositParams defines the params for deposits on governance proposals.
-type DepositParams struct {
-	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
-	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
-	// months.
-	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+// ProposalTurnout defines the turnout of a tallied proposal, i.e. the fraction
+// of the bonded tokens that took part in the vote.
+type ProposalTurnout struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// turnout is the fraction of the bonded tokens that voted on the proposal.
+	Turnout string `protobuf:"bytes,2,opt,name=turnout,proto3" json:"turnout,omitempty"`
 }
 
-func (m *DepositParams) Reset()         { *m = DepositParams{} }
-func (m *DepositParams) String() string { return proto.CompactTextString(m) }
-func (*DepositParams) ProtoMessage()    {}
-func (*DepositParams) Descriptor() ([]byte, []int) {
+func (m *ProposalTurnout) Reset()         { *m = ProposalTurnout{} }
+func (m *ProposalTurnout) String() string { return proto.CompactTextString(m) }
+func (*ProposalTurnout) ProtoMessage()    {}
+func (*ProposalTurnout) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ecf0f9950ff6986c, []int{5}
 }
-func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+func (m *ProposalTurnout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProposalTurnout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProposalTurnout.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -580,50 +659,63 @@ func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *DepositParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DepositParams.Merge(m, src)
+func (m *ProposalTurnout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalTurnout.Merge(m, src)
 }
-func (m *DepositParams) XXX_Size() int {
+func (m *ProposalTurnout) XXX_Size() int {
 	return m.Size()
 }
-func (m *DepositParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_DepositParams.DiscardUnknown(m)
+func (m *ProposalTurnout) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalTurnout.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+var xxx_messageInfo_ProposalTurnout proto.InternalMessageInfo
 
-func (m *DepositParams) GetMinDeposit() []types.Coin {
+func (m *ProposalTurnout) GetProposalId() uint64 {
 	if m != nil {
-		return m.MinDeposit
+		return m.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
+func (m *ProposalTurnout) GetTurnout() string {
 	if m != nil {
-		return m.MaxDepositPeriod
+		return m.Turnout
 	}
-	return nil
-}
-
-// VotingParams defines the params for voting on governance proposals.
-type VotingParams struct {
-	// Duration of the voting period.
-	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	return ""
 }
 
-func (m *VotingParams) Reset()         { *m = VotingParams{} }
-func (m *VotingParams) String() string { return proto.CompactTextString(m) }
-func (*VotingParams) ProtoMessage()    {}
-func (*VotingParams) Descriptor() ([]byte, []int) {
+// ArchivedProposal defines the summary of a completed proposal kept in state
+// once the proposal has been pruned after the proposal retention period.
+type ArchivedProposal struct {
+	// id defines the unique id of the proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// status defines the final status of the proposal.
+	Status ProposalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=atomone.gov.v1.ProposalStatus" json:"status,omitempty"`
+	// final_tally_result is the final tally result of the proposal.
+	FinalTallyResult *TallyResult `protobuf:"bytes,3,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result,omitempty"`
+	// submit_time is the time of proposal submission.
+	SubmitTime *time.Time `protobuf:"bytes,4,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// voting_end_time is the end time of voting on the proposal.
+	VotingEndTime *time.Time `protobuf:"bytes,5,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	// proposer is the address of the proposal sumbitter.
+	Proposer string `protobuf:"bytes,7,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *ArchivedProposal) Reset()         { *m = ArchivedProposal{} }
+func (m *ArchivedProposal) String() string { return proto.CompactTextString(m) }
+func (*ArchivedProposal) ProtoMessage()    {}
+func (*ArchivedProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ecf0f9950ff6986c, []int{6}
 }
-func (m *VotingParams) XXX_Unmarshal(b []byte) error {
+func (m *ArchivedProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ArchivedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VotingParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ArchivedProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -633,132 +725,86 @@ func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *VotingParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VotingParams.Merge(m, src)
+func (m *ArchivedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedProposal.Merge(m, src)
 }
-func (m *VotingParams) XXX_Size() int {
+func (m *ArchivedProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *VotingParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_VotingParams.DiscardUnknown(m)
+func (m *ArchivedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VotingParams proto.InternalMessageInfo
+var xxx_messageInfo_ArchivedProposal proto.InternalMessageInfo
 
-func (m *VotingParams) GetVotingPeriod() *time.Duration {
+func (m *ArchivedProposal) GetId() uint64 {
 	if m != nil {
-		return m.VotingPeriod
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-// TallyParams defines the params for tallying votes on governance proposals.
-type TallyParams struct {
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
-	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
-	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
-	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+func (m *ArchivedProposal) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
 }
 
-func (m *TallyParams) Reset()         { *m = TallyParams{} }
-func (m *TallyParams) String() string { return proto.CompactTextString(m) }
-func (*TallyParams) ProtoMessage()    {}
-func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{7}
-}
-func (m *TallyParams) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_TallyParams.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *ArchivedProposal) GetFinalTallyResult() *TallyResult {
+	if m != nil {
+		return m.FinalTallyResult
 	}
-}
-func (m *TallyParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TallyParams.Merge(m, src)
-}
-func (m *TallyParams) XXX_Size() int {
-	return m.Size()
-}
-func (m *TallyParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_TallyParams.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_TallyParams proto.InternalMessageInfo
+func (m *ArchivedProposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return nil
+}
 
-func (m *TallyParams) GetQuorum() string {
+func (m *ArchivedProposal) GetVotingEndTime() *time.Time {
 	if m != nil {
-		return m.Quorum
+		return m.VotingEndTime
 	}
-	return ""
+	return nil
 }
 
-func (m *TallyParams) GetThreshold() string {
+func (m *ArchivedProposal) GetTitle() string {
 	if m != nil {
-		return m.Threshold
+		return m.Title
 	}
 	return ""
 }
 
-func (m *TallyParams) GetVetoThreshold() string {
+func (m *ArchivedProposal) GetProposer() string {
 	if m != nil {
-		return m.VetoThreshold
+		return m.Proposer
 	}
 	return ""
 }
 
-// Params defines the parameters for the x/gov module.
-//
-// Since: cosmos-sdk 0.47
-type Params struct {
-	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
-	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
-	// months.
-	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
-	// Duration of the voting period.
-	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
-	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
-	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
-	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
-	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
-	// burn deposits if a proposal does not meet quorum
-	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
-	// burn deposits if the proposal does not enter voting period
-	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
-	// burn deposits if quorum with vote type no_veto is met
-	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+// BountyPool defines the share of the burned deposits held by the gov module
+// account to fund the proposer bounties.
+type BountyPool struct {
+	// amount is the balance of the pool.
+	Amount []types.Coin `protobuf:"bytes,1,rep,name=amount,proto3" json:"amount"`
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ecf0f9950ff6986c, []int{8}
+func (m *BountyPool) Reset()         { *m = BountyPool{} }
+func (m *BountyPool) String() string { return proto.CompactTextString(m) }
+func (*BountyPool) ProtoMessage()    {}
+func (*BountyPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{7}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *BountyPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BountyPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BountyPool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -768,1031 +814,5494 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *BountyPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountyPool.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *BountyPool) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *BountyPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountyPool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_BountyPool proto.InternalMessageInfo
 
-func (m *Params) GetMinDeposit() []types.Coin {
+func (m *BountyPool) GetAmount() []types.Coin {
 	if m != nil {
-		return m.MinDeposit
+		return m.Amount
 	}
 	return nil
 }
 
-func (m *Params) GetMaxDepositPeriod() *time.Duration {
-	if m != nil {
-		return m.MaxDepositPeriod
-	}
-	return nil
+// ProposerBounty defines the bounty paid from the bounty pool to the proposer
+// of a passed proposal.
+type ProposerBounty struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// proposer is the address of the proposal sumbitter.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// amount is the bounty paid to the proposer.
+	Amount []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
 }
 
-func (m *Params) GetVotingPeriod() *time.Duration {
-	if m != nil {
-		return m.VotingPeriod
+func (m *ProposerBounty) Reset()         { *m = ProposerBounty{} }
+func (m *ProposerBounty) String() string { return proto.CompactTextString(m) }
+func (*ProposerBounty) ProtoMessage()    {}
+func (*ProposerBounty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{8}
+}
+func (m *ProposerBounty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerBounty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerBounty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ProposerBounty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerBounty.Merge(m, src)
+}
+func (m *ProposerBounty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerBounty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerBounty.DiscardUnknown(m)
 }
 
-func (m *Params) GetQuorum() string {
+var xxx_messageInfo_ProposerBounty proto.InternalMessageInfo
+
+func (m *ProposerBounty) GetProposalId() uint64 {
 	if m != nil {
-		return m.Quorum
+		return m.ProposalId
 	}
-	return ""
+	return 0
 }
 
-func (m *Params) GetThreshold() string {
+func (m *ProposerBounty) GetProposer() string {
 	if m != nil {
-		return m.Threshold
+		return m.Proposer
 	}
 	return ""
 }
 
-func (m *Params) GetVetoThreshold() string {
+func (m *ProposerBounty) GetAmount() []types.Coin {
 	if m != nil {
-		return m.VetoThreshold
+		return m.Amount
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetMinInitialDepositRatio() string {
-	if m != nil {
-		return m.MinInitialDepositRatio
-	}
-	return ""
+// CommunityPoolSpendPeriod defines the community pool spend of the passed
+// proposals over the current community pool spend period.
+type CommunityPoolSpendPeriod struct {
+	// start_time is the start time of the period.
+	StartTime *time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// spent is the amount of the community pool spent during the period.
+	Spent []types.Coin `protobuf:"bytes,2,rep,name=spent,proto3" json:"spent"`
 }
 
-func (m *Params) GetBurnVoteQuorum() bool {
-	if m != nil {
-		return m.BurnVoteQuorum
+func (m *CommunityPoolSpendPeriod) Reset()         { *m = CommunityPoolSpendPeriod{} }
+func (m *CommunityPoolSpendPeriod) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolSpendPeriod) ProtoMessage()    {}
+func (*CommunityPoolSpendPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{9}
+}
+func (m *CommunityPoolSpendPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolSpendPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolSpendPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *CommunityPoolSpendPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolSpendPeriod.Merge(m, src)
+}
+func (m *CommunityPoolSpendPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolSpendPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolSpendPeriod.DiscardUnknown(m)
 }
 
-func (m *Params) GetBurnProposalDepositPrevote() bool {
+var xxx_messageInfo_CommunityPoolSpendPeriod proto.InternalMessageInfo
+
+func (m *CommunityPoolSpendPeriod) GetStartTime() *time.Time {
 	if m != nil {
-		return m.BurnProposalDepositPrevote
+		return m.StartTime
 	}
-	return false
+	return nil
 }
 
-func (m *Params) GetBurnVoteVeto() bool {
+func (m *CommunityPoolSpendPeriod) GetSpent() []types.Coin {
 	if m != nil {
-		return m.BurnVoteVeto
+		return m.Spent
 	}
-	return false
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("atomone.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
-	proto.RegisterEnum("atomone.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
-	proto.RegisterType((*WeightedVoteOption)(nil), "atomone.gov.v1.WeightedVoteOption")
-	proto.RegisterType((*Deposit)(nil), "atomone.gov.v1.Deposit")
-	proto.RegisterType((*Proposal)(nil), "atomone.gov.v1.Proposal")
-	proto.RegisterType((*TallyResult)(nil), "atomone.gov.v1.TallyResult")
-	proto.RegisterType((*Vote)(nil), "atomone.gov.v1.Vote")
-	proto.RegisterType((*DepositParams)(nil), "atomone.gov.v1.DepositParams")
-	proto.RegisterType((*VotingParams)(nil), "atomone.gov.v1.VotingParams")
-	proto.RegisterType((*TallyParams)(nil), "atomone.gov.v1.TallyParams")
-	proto.RegisterType((*Params)(nil), "atomone.gov.v1.Params")
+// Vote defines a vote on a governance proposal.
+// A Vote consists of a proposal ID, the voter, and the vote option.
+type Vote struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter address of the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options is the weighted vote options.
+	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	// metadata is any  arbitrary metadata to attached to the vote.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// lock_periods is the number of unbonding periods the voter locked its
+	// stake for, multiplying the voting power of its locked stake by
+	// lock_periods + 1.
+	LockPeriods uint32 `protobuf:"varint,6,opt,name=lock_periods,json=lockPeriods,proto3" json:"lock_periods,omitempty"`
 }
 
-func init() { proto.RegisterFile("atomone/gov/v1/gov.proto", fileDescriptor_ecf0f9950ff6986c) }
-
-var fileDescriptor_ecf0f9950ff6986c = []byte{
-	// 1282 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x73, 0xd3, 0x46,
-	0x14, 0x8f, 0x6c, 0xd9, 0x71, 0x9e, 0x13, 0xc7, 0x2c, 0x29, 0x28, 0x06, 0xec, 0xd4, 0xc3, 0x30,
-	0x29, 0x25, 0x76, 0x13, 0x5a, 0x2e, 0xe5, 0xe2, 0xc4, 0x02, 0xc4, 0xd0, 0xd8, 0x95, 0x45, 0x18,
-	0x7a, 0xd1, 0xc8, 0xd1, 0x62, 0xef, 0x8c, 0xa5, 0x75, 0xa5, 0xb5, 0xc1, 0x1f, 0xa1, 0x37, 0x8e,
-	0x9d, 0x9e, 0x7a, 0xec, 0xb1, 0x07, 0x66, 0xfa, 0x01, 0x7a, 0xe1, 0xd4, 0x32, 0x5c, 0xda, 0x5e,
-	0x68, 0x07, 0x0e, 0x9d, 0xe1, 0x53, 0x74, 0x76, 0xb5, 0xb2, 0x1d, 0xc7, 0x9d, 0x24, 0x5c, 0x12,
-	0xe9, 0xbd, 0xdf, 0xef, 0xbd, 0xb7, 0xef, 0xdf, 0xca, 0xa0, 0x39, 0x8c, 0x7a, 0xd4, 0xc7, 0xd5,
-	0x0e, 0x1d, 0x56, 0x87, 0xdb, 0xfc, 0x5f, 0xa5, 0x1f, 0x50, 0x46, 0x51, 0x4e, 0x6a, 0x2a, 0x5c,
-	0x34, 0xdc, 0x2e, 0x14, 0x0f, 0x69, 0xe8, 0xd1, 0xb0, 0xda, 0x76, 0x42, 0x5c, 0x1d, 0x6e, 0xb7,
-	0x31, 0x73, 0xb6, 0xab, 0x87, 0x94, 0xf8, 0x11, 0xbe, 0xb0, 0xd6, 0xa1, 0x1d, 0x2a, 0x1e, 0xab,
-	0xfc, 0x49, 0x4a, 0x4b, 0x1d, 0x4a, 0x3b, 0x3d, 0x5c, 0x15, 0x6f, 0xed, 0xc1, 0x93, 0x2a, 0x23,
-	0x1e, 0x0e, 0x99, 0xe3, 0xf5, 0x25, 0x60, 0x7d, 0x16, 0xe0, 0xf8, 0x23, 0xa9, 0x2a, 0xce, 0xaa,
-	0xdc, 0x41, 0xe0, 0x30, 0x42, 0x63, 0x8f, 0xeb, 0x51, 0x44, 0x76, 0xe4, 0x34, 0x7a, 0x91, 0xaa,
-	0x73, 0x8e, 0x47, 0x7c, 0x5a, 0x15, 0x7f, 0x23, 0x51, 0xb9, 0x0f, 0xe8, 0x11, 0x26, 0x9d, 0x2e,
-	0xc3, 0xee, 0x01, 0x65, 0xb8, 0xd1, 0xe7, 0x96, 0xd0, 0x0e, 0xa4, 0xa9, 0x78, 0xd2, 0x94, 0x0d,
-	0x65, 0x33, 0xb7, 0x53, 0xa8, 0x1c, 0x3d, 0x76, 0x65, 0x82, 0x35, 0x25, 0x12, 0x5d, 0x83, 0xf4,
-	0x53, 0x61, 0x49, 0x4b, 0x6c, 0x28, 0x9b, 0x4b, 0xbb, 0xb9, 0xd7, 0x2f, 0xb6, 0x40, 0xba, 0xaf,
-	0xe3, 0x43, 0x53, 0x6a, 0xcb, 0x3f, 0x2a, 0xb0, 0x58, 0xc7, 0x7d, 0x1a, 0x12, 0x86, 0x4a, 0x90,
-	0xed, 0x07, 0xb4, 0x4f, 0x43, 0xa7, 0x67, 0x13, 0x57, 0x38, 0x53, 0x4d, 0x88, 0x45, 0x86, 0x8b,
-	0x6e, 0xc1, 0x92, 0x1b, 0x61, 0x69, 0x20, 0xed, 0x6a, 0xaf, 0x5f, 0x6c, 0xad, 0x49, 0xbb, 0x35,
-	0xd7, 0x0d, 0x70, 0x18, 0xb6, 0x58, 0x40, 0xfc, 0x8e, 0x39, 0x81, 0xa2, 0xdb, 0x90, 0x76, 0x3c,
-	0x3a, 0xf0, 0x99, 0x96, 0xdc, 0x48, 0x6e, 0x66, 0x77, 0xd6, 0x2b, 0x92, 0xc1, 0xeb, 0x54, 0x91,
-	0x75, 0xaa, 0xec, 0x51, 0xe2, 0xef, 0x2e, 0xbd, 0x7c, 0x53, 0x5a, 0xf8, 0xe9, 0xdf, 0x9f, 0xaf,
-	0x2b, 0xa6, 0xe4, 0x94, 0x7f, 0x4d, 0x41, 0xa6, 0x29, 0x83, 0x40, 0x39, 0x48, 0x8c, 0x43, 0x4b,
-	0x10, 0x17, 0x7d, 0x06, 0x19, 0x0f, 0x87, 0xa1, 0xd3, 0xc1, 0xa1, 0x96, 0x10, 0xc6, 0xd7, 0x2a,
-	0x51, 0x49, 0x2a, 0x71, 0x49, 0x2a, 0x35, 0x7f, 0x64, 0x8e, 0x51, 0xe8, 0x16, 0xa4, 0x43, 0xe6,
-	0xb0, 0x41, 0xa8, 0x25, 0x45, 0x36, 0x8b, 0xb3, 0xd9, 0x8c, 0x7d, 0xb5, 0x04, 0xca, 0x94, 0x68,
-	0x64, 0x00, 0x7a, 0x42, 0x7c, 0xa7, 0x67, 0x33, 0xa7, 0xd7, 0x1b, 0xd9, 0x01, 0x0e, 0x07, 0x3d,
-	0xa6, 0xa9, 0x1b, 0xca, 0x66, 0x76, 0xe7, 0xd2, 0xac, 0x0d, 0x8b, 0x63, 0x4c, 0x01, 0x31, 0xf3,
-	0x82, 0x36, 0x25, 0x41, 0x35, 0xc8, 0x86, 0x83, 0xb6, 0x47, 0x98, 0xcd, 0x3b, 0x4d, 0x4b, 0x09,
-	0x1b, 0x85, 0x63, 0x71, 0x5b, 0x71, 0x1b, 0xee, 0xaa, 0xcf, 0xff, 0x2e, 0x29, 0x26, 0x44, 0x24,
-	0x2e, 0x46, 0xf7, 0x21, 0x2f, 0xf3, 0x6b, 0x63, 0xdf, 0x8d, 0xec, 0xa4, 0x4f, 0x69, 0x27, 0x27,
-	0x99, 0xba, 0xef, 0x0a, 0x5b, 0x06, 0xac, 0x30, 0xca, 0x9c, 0x9e, 0x2d, 0xe5, 0xda, 0xe2, 0x19,
-	0xaa, 0xb4, 0x2c, 0xa8, 0x71, 0x0b, 0x3d, 0x80, 0x73, 0x43, 0xca, 0x88, 0xdf, 0xb1, 0x43, 0xe6,
-	0x04, 0xf2, 0x7c, 0x99, 0x53, 0xc6, 0xb5, 0x1a, 0x51, 0x5b, 0x9c, 0x29, 0x02, 0xbb, 0x07, 0x52,
-	0x34, 0x39, 0xe3, 0xd2, 0x29, 0x6d, 0xad, 0x44, 0xc4, 0xf8, 0x88, 0x05, 0xde, 0x26, 0xcc, 0x71,
-	0x1d, 0xe6, 0x68, 0xc0, 0x1b, 0xd7, 0x1c, 0xbf, 0xa3, 0x35, 0x48, 0x31, 0xc2, 0x7a, 0x58, 0xcb,
-	0x0a, 0x45, 0xf4, 0x82, 0x34, 0x58, 0x0c, 0x07, 0x9e, 0xe7, 0x04, 0x23, 0x6d, 0x59, 0xc8, 0xe3,
-	0x57, 0xf4, 0x39, 0x64, 0xa2, 0x99, 0xc0, 0x81, 0xb6, 0x72, 0xc2, 0x10, 0x8c, 0x91, 0xe5, 0x3f,
-	0x14, 0xc8, 0x4e, 0xf7, 0xc0, 0xa7, 0xb0, 0x34, 0xc2, 0xa1, 0x7d, 0x28, 0xc6, 0x42, 0x39, 0x36,
-	0xa3, 0x86, 0xcf, 0xcc, 0xcc, 0x08, 0x87, 0x7b, 0x5c, 0x8f, 0x6e, 0xc2, 0x8a, 0xd3, 0x0e, 0x99,
-	0x43, 0x7c, 0x49, 0x48, 0xcc, 0x25, 0x2c, 0x4b, 0x50, 0x44, 0xfa, 0x04, 0x32, 0x3e, 0x95, 0xf8,
-	0xe4, 0x5c, 0xfc, 0xa2, 0x4f, 0x23, 0xe8, 0x97, 0x80, 0x7c, 0x6a, 0x3f, 0x25, 0xac, 0x6b, 0x0f,
-	0x31, 0x8b, 0x49, 0xea, 0x5c, 0xd2, 0xaa, 0x4f, 0x1f, 0x11, 0xd6, 0x3d, 0xc0, 0x2c, 0x22, 0x97,
-	0x7f, 0x51, 0x40, 0xe5, 0x1b, 0xe8, 0xe4, 0xfd, 0x51, 0x81, 0xd4, 0x90, 0x32, 0x7c, 0xf2, 0xee,
-	0x88, 0x60, 0xe8, 0x36, 0x2c, 0x46, 0xeb, 0x2c, 0xd4, 0x54, 0xd1, 0x92, 0xe5, 0xd9, 0x39, 0x3b,
-	0xbe, 0x2d, 0xcd, 0x98, 0x72, 0xa4, 0xe6, 0xa9, 0xa3, 0x35, 0xbf, 0xaf, 0x66, 0x92, 0x79, 0xb5,
-	0xfc, 0x97, 0x02, 0x2b, 0xb2, 0x73, 0x9b, 0x4e, 0xe0, 0x78, 0x21, 0x7a, 0x0c, 0x59, 0x8f, 0xf8,
-	0xe3, 0x41, 0x50, 0x4e, 0x1a, 0x84, 0x2b, 0x7c, 0x10, 0xde, 0xbf, 0x29, 0x7d, 0x34, 0xc5, 0xba,
-	0x41, 0x3d, 0xc2, 0xb0, 0xd7, 0x67, 0x23, 0x13, 0x3c, 0xe2, 0xc7, 0xa3, 0xe1, 0x01, 0xf2, 0x9c,
-	0x67, 0x31, 0xc8, 0xee, 0xe3, 0x80, 0x50, 0x57, 0x64, 0x82, 0x7b, 0x98, 0xed, 0xe7, 0xba, 0xbc,
-	0x46, 0x76, 0xaf, 0xbe, 0x7f, 0x53, 0xba, 0x7c, 0x9c, 0x38, 0x71, 0xf2, 0x3d, 0x6f, 0xf7, 0xbc,
-	0xe7, 0x3c, 0x8b, 0x4f, 0x22, 0xf4, 0x65, 0x0b, 0x96, 0x0f, 0xc4, 0x08, 0xc8, 0x93, 0xd5, 0x41,
-	0x8e, 0x44, 0xec, 0x59, 0x39, 0xc9, 0xb3, 0x2a, 0x2c, 0x2f, 0x47, 0x2c, 0x69, 0xf5, 0x87, 0xb8,
-	0x8b, 0xa5, 0xd5, 0x6b, 0x90, 0xfe, 0x76, 0x40, 0x83, 0x81, 0x37, 0xa7, 0x85, 0xc5, 0x35, 0x13,
-	0x69, 0xd1, 0x0d, 0x58, 0x62, 0xdd, 0x00, 0x87, 0x5d, 0xda, 0x73, 0xff, 0xe7, 0x46, 0x9a, 0x00,
-	0xd0, 0x17, 0x90, 0x13, 0x6d, 0x38, 0xa1, 0x24, 0xe7, 0x52, 0x56, 0x38, 0xca, 0x8a, 0x41, 0xe5,
-	0xdf, 0x55, 0x48, 0xcb, 0xb8, 0xf4, 0x33, 0xd6, 0x71, 0x6a, 0xa1, 0x4d, 0xd7, 0xec, 0xab, 0x0f,
-	0xab, 0x99, 0x3a, 0xbf, 0x26, 0xc7, 0x6b, 0x90, 0xfc, 0x80, 0x1a, 0x4c, 0xe5, 0x5c, 0x3d, 0x7d,
-	0xce, 0x53, 0x67, 0xcf, 0x79, 0xfa, 0x14, 0x39, 0x47, 0x06, 0xac, 0xf3, 0x44, 0x13, 0x9f, 0x30,
-	0x32, 0xb9, 0x41, 0x6c, 0x11, 0xbe, 0xb6, 0x38, 0xd7, 0xc2, 0x05, 0x8f, 0xf8, 0x46, 0x84, 0x97,
-	0xe9, 0x31, 0x39, 0x1a, 0x6d, 0x42, 0xbe, 0x3d, 0x08, 0x7c, 0x9b, 0xcf, 0xbe, 0x2d, 0x4f, 0xc8,
-	0xf7, 0x6b, 0xc6, 0xcc, 0x71, 0x39, 0x1f, 0xf1, 0xaf, 0xa3, 0x93, 0xd5, 0xe0, 0x8a, 0x40, 0x8e,
-	0xb7, 0xcd, 0xb8, 0x40, 0x01, 0xe6, 0x6c, 0x2d, 0x27, 0x68, 0x05, 0x0e, 0x8a, 0x6f, 0xf3, 0xb8,
-	0x12, 0x11, 0x02, 0x5d, 0x85, 0xdc, 0xc4, 0x19, 0x3f, 0x92, 0xb6, 0x2a, 0x38, 0xcb, 0xb1, 0x2b,
-	0xbe, 0xdf, 0xae, 0x7f, 0xa7, 0x00, 0x4c, 0x7d, 0x88, 0x5d, 0x82, 0x8b, 0x07, 0x0d, 0x4b, 0xb7,
-	0x1b, 0x4d, 0xcb, 0x68, 0xec, 0xdb, 0x0f, 0xf7, 0x5b, 0x4d, 0x7d, 0xcf, 0xb8, 0x63, 0xe8, 0xf5,
-	0xfc, 0x02, 0x3a, 0x0f, 0xab, 0xd3, 0xca, 0xc7, 0x7a, 0x2b, 0xaf, 0xa0, 0x8b, 0x70, 0x7e, 0x5a,
-	0x58, 0xdb, 0x6d, 0x59, 0x35, 0x63, 0x3f, 0x9f, 0x40, 0x08, 0x72, 0xd3, 0x8a, 0xfd, 0x46, 0x3e,
-	0x89, 0x2e, 0x83, 0x76, 0x54, 0x66, 0x3f, 0x32, 0xac, 0x7b, 0xf6, 0x81, 0x6e, 0x35, 0xf2, 0xea,
-	0xf5, 0xdf, 0x14, 0xc8, 0x1d, 0xfd, 0x34, 0x41, 0x25, 0xb8, 0xd4, 0x34, 0x1b, 0xcd, 0x46, 0xab,
-	0xf6, 0xc0, 0x6e, 0x59, 0x35, 0xeb, 0x61, 0x6b, 0x26, 0xa6, 0x32, 0x14, 0x67, 0x01, 0x75, 0xbd,
-	0xd9, 0x68, 0x19, 0x96, 0xdd, 0xd4, 0x4d, 0xa3, 0x51, 0xcf, 0x2b, 0xe8, 0x63, 0xb8, 0x32, 0x8b,
-	0x39, 0x68, 0x58, 0xc6, 0xfe, 0xdd, 0x18, 0x92, 0x40, 0x05, 0xb8, 0x30, 0x0b, 0x69, 0xd6, 0x5a,
-	0x2d, 0xbd, 0x1e, 0x05, 0x3d, 0xab, 0x33, 0xf5, 0xfb, 0xfa, 0x9e, 0xa5, 0xd7, 0xf3, 0xea, 0x3c,
-	0xe6, 0x9d, 0x9a, 0xf1, 0x40, 0xaf, 0xe7, 0x53, 0xbb, 0x77, 0x5f, 0xbe, 0x2d, 0x2a, 0xaf, 0xde,
-	0x16, 0x95, 0x7f, 0xde, 0x16, 0x95, 0xe7, 0xef, 0x8a, 0x0b, 0xaf, 0xde, 0x15, 0x17, 0xfe, 0x7c,
-	0x57, 0x5c, 0xf8, 0x66, 0xab, 0x43, 0x58, 0x77, 0xd0, 0xae, 0x1c, 0x52, 0xaf, 0x2a, 0x17, 0xfe,
-	0x56, 0x77, 0xd0, 0x8e, 0x9f, 0xab, 0xcf, 0xc4, 0x2f, 0x01, 0x36, 0xea, 0xe3, 0x90, 0x7f, 0xe5,
-	0xa7, 0xc5, 0xdc, 0xdc, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x04, 0x79, 0x98, 0x28, 0x0c,
-	0x00, 0x00,
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{10}
+}
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
 }
 
-func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+func (m *Vote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Vote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
 }
 
-func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Weight) > 0 {
-		i -= len(m.Weight)
-		copy(dAtA[i:], m.Weight)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
-		i--
-		dAtA[i] = 0x12
+func (m *Vote) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
 	}
-	if m.Option != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Option))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *Vote) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Vote) GetLockPeriods() uint32 {
+	if m != nil {
+		return m.LockPeriods
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VoteLock defines the stake locked by a voter to boost its votes. The locked
+// shares can't be undelegated nor redelegated until end_time.
+type VoteLock struct {
+	// voter is the address of the voter.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// end_time is the time the stake is unlocked at.
+	EndTime *time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// shares are the locked delegation shares of the voter, by validator.
+	Shares []*LockedShares `protobuf:"bytes,3,rep,name=shares,proto3" json:"shares,omitempty"`
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *VoteLock) Reset()         { *m = VoteLock{} }
+func (m *VoteLock) String() string { return proto.CompactTextString(m) }
+func (*VoteLock) ProtoMessage()    {}
+func (*VoteLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{11}
+}
+func (m *VoteLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *VoteLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteLock.Merge(m, src)
+}
+func (m *VoteLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteLock proto.InternalMessageInfo
+
+func (m *VoteLock) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *VoteLock) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VoteLock) GetShares() []*LockedShares {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// LockedShares defines the delegation shares of a voter locked on a validator.
+type LockedShares struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares are the locked delegation shares.
+	Shares string `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares,omitempty"`
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Summary) > 0 {
-		i -= len(m.Summary)
-		copy(dAtA[i:], m.Summary)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.TotalDeposit) > 0 {
-		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+func (m *LockedShares) Reset()         { *m = LockedShares{} }
+func (m *LockedShares) String() string { return proto.CompactTextString(m) }
+func (*LockedShares) ProtoMessage()    {}
+func (*LockedShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{12}
+}
+func (m *LockedShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if m.FinalTallyResult != nil {
-		{
-			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+}
+func (m *LockedShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedShares.Merge(m, src)
+}
+func (m *LockedShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedShares proto.InternalMessageInfo
+
+func (m *LockedShares) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if m.Status != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *LockedShares) GetShares() string {
+	if m != nil {
+		return m.Shares
 	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return ""
+}
+
+// RecurringProposal defines a proposal scheduled by a passed
+// MsgScheduleRecurringProposal, submitted again for a vote at each interval
+// until it is cancelled.
+type RecurringProposal struct {
+	// id defines the unique id of the recurring proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// messages are the arbitrary messages of the submitted proposals.
+	Messages []*types1.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// metadata is any arbitrary metadata attached to the submitted proposals.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the submitted proposals.
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the summary of the submitted proposals.
+	Summary string `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	// interval is the duration between two submissions.
+	Interval *time.Duration `protobuf:"bytes,6,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// next_submit_time is the time the next proposal is submitted at.
+	NextSubmitTime *time.Time `protobuf:"bytes,7,opt,name=next_submit_time,json=nextSubmitTime,proto3,stdtime" json:"next_submit_time,omitempty"`
+	// last_proposal_id is the id of the last submitted proposal, zero if no
+	// proposal was submitted yet.
+	LastProposalId uint64 `protobuf:"varint,8,opt,name=last_proposal_id,json=lastProposalId,proto3" json:"last_proposal_id,omitempty"`
+}
+
+func (m *RecurringProposal) Reset()         { *m = RecurringProposal{} }
+func (m *RecurringProposal) String() string { return proto.CompactTextString(m) }
+func (*RecurringProposal) ProtoMessage()    {}
+func (*RecurringProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{13}
+}
+func (m *RecurringProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *RecurringProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringProposal.Merge(m, src)
+}
+func (m *RecurringProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringProposal.DiscardUnknown(m)
 }
 
-func (m *TallyResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RecurringProposal proto.InternalMessageInfo
+
+func (m *RecurringProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RecurringProposal) GetMessages() []*types1.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
 }
 
-func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NoWithVetoCount) > 0 {
-		i -= len(m.NoWithVetoCount)
-		copy(dAtA[i:], m.NoWithVetoCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.NoWithVetoCount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.NoCount) > 0 {
-		i -= len(m.NoCount)
-		copy(dAtA[i:], m.NoCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AbstainCount) > 0 {
-		i -= len(m.AbstainCount)
-		copy(dAtA[i:], m.AbstainCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.YesCount) > 0 {
-		i -= len(m.YesCount)
-		copy(dAtA[i:], m.YesCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
-		i--
-		dAtA[i] = 0xa
+func (m *RecurringProposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RecurringProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RecurringProposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
+func (m *RecurringProposal) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *RecurringProposal) GetNextSubmitTime() *time.Time {
+	if m != nil {
+		return m.NextSubmitTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DepositParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RecurringProposal) GetLastProposalId() uint64 {
+	if m != nil {
+		return m.LastProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DepositParams defines the params for deposits on governance proposals.
+type DepositParams struct {
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	// months.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 }
 
-func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+func (m *DepositParams) Reset()         { *m = DepositParams{} }
+func (m *DepositParams) String() string { return proto.CompactTextString(m) }
+func (*DepositParams) ProtoMessage()    {}
+func (*DepositParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{14}
+}
+func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *DepositParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositParams.Merge(m, src)
+}
+func (m *DepositParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+
+func (m *DepositParams) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *VotingParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
+	if m != nil {
+		return m.MaxDepositPeriod
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VotingParams defines the params for voting on governance proposals.
+type VotingParams struct {
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
 }
 
-func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+func (m *VotingParams) Reset()         { *m = VotingParams{} }
+func (m *VotingParams) String() string { return proto.CompactTextString(m) }
+func (*VotingParams) ProtoMessage()    {}
+func (*VotingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{15}
+}
+func (m *VotingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VotingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingParams.Merge(m, src)
+}
+func (m *VotingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingParams.DiscardUnknown(m)
 }
 
-func (m *TallyParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VotingParams proto.InternalMessageInfo
+
+func (m *VotingParams) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TallyParams defines the params for tallying votes on governance proposals.
+type TallyParams struct {
+	// Minimum percentage of total stake needed to vote for a result to be
+	// considered valid.
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Minimum value of Veto votes to Total votes ratio for proposal to be
+	// vetoed. Default value: 1/3.
+	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
 }
 
-func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VetoThreshold) > 0 {
-		i -= len(m.VetoThreshold)
-		copy(dAtA[i:], m.VetoThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
-		i--
-		dAtA[i] = 0xa
+func (m *TallyParams) Reset()         { *m = TallyParams{} }
+func (m *TallyParams) String() string { return proto.CompactTextString(m) }
+func (*TallyParams) ProtoMessage()    {}
+func (*TallyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{16}
+}
+func (m *TallyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TallyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyParams.Merge(m, src)
+}
+func (m *TallyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyParams.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TallyParams proto.InternalMessageInfo
+
+func (m *TallyParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TallyParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BurnVoteVeto {
-		i--
-		if m.BurnVoteVeto {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
+func (m *TallyParams) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
 	}
-	if m.BurnProposalDepositPrevote {
-		i--
-		if m.BurnProposalDepositPrevote {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// Params defines the parameters for the x/gov module.
+//
+// Since: cosmos-sdk 0.47
+type Params struct {
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	// months.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	// Minimum percentage of total stake needed to vote for a result to be
+	// considered valid.
+	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Minimum value of Veto votes to Total votes ratio for proposal to be
+	// vetoed. Default value: 1/3.
+	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
+	// burn deposits if a proposal does not meet quorum
+	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
+	// burn deposits if the proposal does not enter voting period
+	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
+	// burn deposits if quorum with vote type no_veto is met
+	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// Duration a completed proposal is kept in state after the end of its voting
+	// period. Past this period, the proposal is pruned and only its archived
+	// summary is kept. A zero value disables the pruning.
+	ProposalRetentionPeriod *time.Duration `protobuf:"bytes,16,opt,name=proposal_retention_period,json=proposalRetentionPeriod,proto3,stdduration" json:"proposal_retention_period,omitempty"`
+	// The ratio of the burned deposits that funds the proposer bounty pool
+	// instead of being burned. A zero value disables the funding of the pool.
+	ProposerBountyRatio string `protobuf:"bytes,17,opt,name=proposer_bounty_ratio,json=proposerBountyRatio,proto3" json:"proposer_bounty_ratio,omitempty"`
+	// Bounty paid from the proposer bounty pool to the proposer of a passed
+	// proposal, capped by the pool balance. An empty value disables the bounty.
+	ProposerBounty []types.Coin `protobuf:"bytes,18,rep,name=proposer_bounty,json=proposerBounty,proto3" json:"proposer_bounty"`
+	// Maximum amount of the community pool spent by the passed proposals per
+	// community pool spend period. The denoms absent from the limit are not
+	// limited, an empty value disables the limit.
+	CommunityPoolSpendLimit []types.Coin `protobuf:"bytes,19,rep,name=community_pool_spend_limit,json=communityPoolSpendLimit,proto3" json:"community_pool_spend_limit"`
+	// Duration of the periods over which the community pool spend limit
+	// applies.
+	CommunityPoolSpendPeriod *time.Duration `protobuf:"bytes,20,opt,name=community_pool_spend_period,json=communityPoolSpendPeriod,proto3,stdduration" json:"community_pool_spend_period,omitempty"`
+	// Maximum number of proposals of a single proposer in the deposit period at
+	// the same time. A zero value disables the limit.
+	MaxDepositPeriodProposalsPerProposer uint64 `protobuf:"varint,21,opt,name=max_deposit_period_proposals_per_proposer,json=maxDepositPeriodProposalsPerProposer,proto3" json:"max_deposit_period_proposals_per_proposer,omitempty"`
+	// Minimum voting period a proposer can request at submission.
+	MinVotingPeriod *time.Duration `protobuf:"bytes,22,opt,name=min_voting_period,json=minVotingPeriod,proto3,stdduration" json:"min_voting_period,omitempty"`
+	// Maximum voting period a proposer can request at submission. A zero value
+	// disables the requested voting periods.
+	MaxVotingPeriod *time.Duration `protobuf:"bytes,23,opt,name=max_voting_period,json=maxVotingPeriod,proto3,stdduration" json:"max_voting_period,omitempty"`
+	// JSON schema the metadata of the submitted proposals must validate against.
+	// An empty schema disables the validation. The schema must not reference
+	// remote documents.
+	ProposalMetadataSchema string `protobuf:"bytes,24,opt,name=proposal_metadata_schema,json=proposalMetadataSchema,proto3" json:"proposal_metadata_schema,omitempty"`
+	// Duration of the review period between the deposit completion and the
+	// voting period start of a proposal. A zero value disables the review period.
+	ReviewPeriod *time.Duration `protobuf:"bytes,25,opt,name=review_period,json=reviewPeriod,proto3,stdduration" json:"review_period,omitempty"`
+	// Review periods of the proposals containing messages of a given type,
+	// overriding review_period. The review period of a proposal is the longest
+	// one among its messages.
+	MessageReviewPeriods []*MessageReviewPeriod `protobuf:"bytes,26,rep,name=message_review_periods,json=messageReviewPeriods,proto3" json:"message_review_periods,omitempty"`
+	// Whether the bonded stake of the vesting accounts that didn't vest yet is
+	// excluded from their voting power. When false, all the bonded stake of the
+	// vesting accounts counts toward their voting power.
+	ExcludeUnvestedVotingPower bool `protobuf:"varint,27,opt,name=exclude_unvested_voting_power,json=excludeUnvestedVotingPower,proto3" json:"exclude_unvested_voting_power,omitempty"`
+	// Whether proposers can submit quadratic proposals, whose outcome is decided
+	// by the square root of the stake of the voters.
+	QuadraticVotingEnabled bool `protobuf:"varint,28,opt,name=quadratic_voting_enabled,json=quadraticVotingEnabled,proto3" json:"quadratic_voting_enabled,omitempty"`
+	// Maximum quadratic voting power of a voter, the square root of its stake
+	// being capped to it. A zero value disables the cap.
+	QuadraticVotingPowerCap string `protobuf:"bytes,29,opt,name=quadratic_voting_power_cap,json=quadraticVotingPowerCap,proto3" json:"quadratic_voting_power_cap,omitempty"`
+	// Maximum number of unbonding periods a voter can lock its stake for when
+	// voting. A zero value disables the vote locks.
+	MaxVoteLockPeriods uint32 `protobuf:"varint,30,opt,name=max_vote_lock_periods,json=maxVoteLockPeriods,proto3" json:"max_vote_lock_periods,omitempty"`
+	// Whether the stake delegated to the jailed validators, tombstoned ones
+	// included, is excluded from the tally and the quorum. The validators
+	// leaving the bonded set once jailed never count, this excludes the ones
+	// jailed but not yet unbonded at the time of the tally.
+	ExcludeJailedValidatorsStake bool `protobuf:"varint,31,opt,name=exclude_jailed_validators_stake,json=excludeJailedValidatorsStake,proto3" json:"exclude_jailed_validators_stake,omitempty"`
+	// How the bonded stake held by the module accounts, e.g. the strategic
+	// stakes of the community pool, is accounted in the tally.
+	ModuleAccountStakeRule ModuleAccountStakeRule `protobuf:"varint,32,opt,name=module_account_stake_rule,json=moduleAccountStakeRule,proto3,enum=atomone.gov.v1.ModuleAccountStakeRule" json:"module_account_stake_rule,omitempty"`
+	// Denoms accepted for the deposits with their weights, e.g. their price in a
+	// reference unit. When set, the deposits are restricted to these denoms, and
+	// the min deposit is reached once the weighted sum of the deposit amounts
+	// reaches the weighted sum of min_deposit. When empty, any denom is accepted
+	// and each denom of min_deposit must be reached.
+	DepositDenomWeights []*DepositDenomWeight `protobuf:"bytes,33,rep,name=deposit_denom_weights,json=depositDenomWeights,proto3" json:"deposit_denom_weights,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{17}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x70
+		return b[:n], nil
 	}
-	if m.BurnVoteQuorum {
-		i--
-		if m.BurnVoteQuorum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
 	}
-	if len(m.MinInitialDepositRatio) > 0 {
-		i -= len(m.MinInitialDepositRatio)
-		copy(dAtA[i:], m.MinInitialDepositRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
-		i--
-		dAtA[i] = 0x3a
+	return nil
+}
+
+func (m *Params) GetMaxDepositPeriod() *time.Duration {
+	if m != nil {
+		return m.MaxDepositPeriod
 	}
-	if len(m.VetoThreshold) > 0 {
-		i -= len(m.VetoThreshold)
-		copy(dAtA[i:], m.VetoThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+func (m *Params) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
 	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *Params) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
 	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *Params) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
 	}
-	if m.VotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *Params) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
 	}
-	if m.MaxDepositPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *Params) GetMinInitialDepositRatio() string {
+	if m != nil {
+		return m.MinInitialDepositRatio
 	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *Params) GetBurnVoteQuorum() bool {
+	if m != nil {
+		return m.BurnVoteQuorum
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) GetBurnProposalDepositPrevote() bool {
+	if m != nil {
+		return m.BurnProposalDepositPrevote
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return false
 }
-func (m *WeightedVoteOption) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Params) GetBurnVoteVeto() bool {
+	if m != nil {
+		return m.BurnVoteVeto
 	}
-	var l int
-	_ = l
-	if m.Option != 0 {
-		n += 1 + sovGov(uint64(m.Option))
+	return false
+}
+
+func (m *Params) GetProposalRetentionPeriod() *time.Duration {
+	if m != nil {
+		return m.ProposalRetentionPeriod
 	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *Params) GetProposerBountyRatio() string {
+	if m != nil {
+		return m.ProposerBountyRatio
 	}
-	return n
+	return ""
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetProposerBounty() []types.Coin {
+	if m != nil {
+		return m.ProposerBounty
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
+	return nil
+}
+
+func (m *Params) GetCommunityPoolSpendLimit() []types.Coin {
+	if m != nil {
+		return m.CommunityPoolSpendLimit
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *Params) GetCommunityPoolSpendPeriod() *time.Duration {
+	if m != nil {
+		return m.CommunityPoolSpendPeriod
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
+	return nil
+}
+
+func (m *Params) GetMaxDepositPeriodProposalsPerProposer() uint64 {
+	if m != nil {
+		return m.MaxDepositPeriodProposalsPerProposer
 	}
-	return n
+	return 0
 }
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetMinVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.MinVotingPeriod
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
+	return nil
+}
+
+func (m *Params) GetMaxVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.MaxVotingPeriod
 	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
-	}
-	if m.Status != 0 {
-		n += 1 + sovGov(uint64(m.Status))
-	}
-	if m.FinalTallyResult != nil {
-		l = m.FinalTallyResult.Size()
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.SubmitTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.DepositEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if len(m.TotalDeposit) > 0 {
-		for _, e := range m.TotalDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
-	}
-	if m.VotingStartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.VotingEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Summary)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *Params) GetProposalMetadataSchema() string {
+	if m != nil {
+		return m.ProposalMetadataSchema
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *Params) GetReviewPeriod() *time.Duration {
+	if m != nil {
+		return m.ReviewPeriod
 	}
-	return n
+	return nil
 }
 
-func (m *TallyResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetMessageReviewPeriods() []*MessageReviewPeriod {
+	if m != nil {
+		return m.MessageReviewPeriods
 	}
-	var l int
-	_ = l
-	l = len(m.YesCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+func (m *Params) GetExcludeUnvestedVotingPower() bool {
+	if m != nil {
+		return m.ExcludeUnvestedVotingPower
 	}
-	l = len(m.AbstainCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return false
+}
+
+func (m *Params) GetQuadraticVotingEnabled() bool {
+	if m != nil {
+		return m.QuadraticVotingEnabled
 	}
-	l = len(m.NoCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return false
+}
+
+func (m *Params) GetQuadraticVotingPowerCap() string {
+	if m != nil {
+		return m.QuadraticVotingPowerCap
 	}
-	l = len(m.NoWithVetoCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *Params) GetMaxVoteLockPeriods() uint32 {
+	if m != nil {
+		return m.MaxVoteLockPeriods
 	}
-	return n
+	return 0
 }
 
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetExcludeJailedValidatorsStake() bool {
+	if m != nil {
+		return m.ExcludeJailedValidatorsStake
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
+	return false
+}
+
+func (m *Params) GetModuleAccountStakeRule() ModuleAccountStakeRule {
+	if m != nil {
+		return m.ModuleAccountStakeRule
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_COUNT
+}
+
+func (m *Params) GetDepositDenomWeights() []*DepositDenomWeight {
+	if m != nil {
+		return m.DepositDenomWeights
 	}
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	return nil
+}
+
+// DepositDenomWeight defines the weight of a denom accepted for the deposits.
+type DepositDenomWeight struct {
+	// denom is the denom accepted for the deposits.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// weight is the value of one unit of the denom in the deposits, e.g. its
+	// price in a reference unit.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *DepositDenomWeight) Reset()         { *m = DepositDenomWeight{} }
+func (m *DepositDenomWeight) String() string { return proto.CompactTextString(m) }
+func (*DepositDenomWeight) ProtoMessage()    {}
+func (*DepositDenomWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{18}
+}
+func (m *DepositDenomWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositDenomWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositDenomWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+}
+func (m *DepositDenomWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositDenomWeight.Merge(m, src)
+}
+func (m *DepositDenomWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositDenomWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositDenomWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositDenomWeight proto.InternalMessageInfo
+
+func (m *DepositDenomWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *DepositParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DepositDenomWeight) GetWeight() string {
+	if m != nil {
+		return m.Weight
 	}
-	var l int
-	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+// MessageReviewPeriod defines the review period of the proposals containing a
+// message type.
+type MessageReviewPeriod struct {
+	// msg_type_url is the type URL of the message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// review_period is the review period of the proposals containing the message.
+	ReviewPeriod *time.Duration `protobuf:"bytes,2,opt,name=review_period,json=reviewPeriod,proto3,stdduration" json:"review_period,omitempty"`
+}
+
+func (m *MessageReviewPeriod) Reset()         { *m = MessageReviewPeriod{} }
+func (m *MessageReviewPeriod) String() string { return proto.CompactTextString(m) }
+func (*MessageReviewPeriod) ProtoMessage()    {}
+func (*MessageReviewPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf0f9950ff6986c, []int{19}
+}
+func (m *MessageReviewPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageReviewPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageReviewPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.MaxDepositPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	return n
+}
+func (m *MessageReviewPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageReviewPeriod.Merge(m, src)
+}
+func (m *MessageReviewPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageReviewPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageReviewPeriod.DiscardUnknown(m)
 }
 
-func (m *VotingParams) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MessageReviewPeriod proto.InternalMessageInfo
+
+func (m *MessageReviewPeriod) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	var l int
-	_ = l
-	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	return ""
+}
+
+func (m *MessageReviewPeriod) GetReviewPeriod() *time.Duration {
+	if m != nil {
+		return m.ReviewPeriod
 	}
-	return n
+	return nil
 }
 
-func (m *TallyParams) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("atomone.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("atomone.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("atomone.gov.v1.ModuleAccountStakeRule", ModuleAccountStakeRule_name, ModuleAccountStakeRule_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "atomone.gov.v1.WeightedVoteOption")
+	proto.RegisterType((*Deposit)(nil), "atomone.gov.v1.Deposit")
+	proto.RegisterType((*Proposal)(nil), "atomone.gov.v1.Proposal")
+	proto.RegisterType((*TallyResult)(nil), "atomone.gov.v1.TallyResult")
+	proto.RegisterType((*ProposalStatusCount)(nil), "atomone.gov.v1.ProposalStatusCount")
+	proto.RegisterType((*ProposalTurnout)(nil), "atomone.gov.v1.ProposalTurnout")
+	proto.RegisterType((*ArchivedProposal)(nil), "atomone.gov.v1.ArchivedProposal")
+	proto.RegisterType((*BountyPool)(nil), "atomone.gov.v1.BountyPool")
+	proto.RegisterType((*ProposerBounty)(nil), "atomone.gov.v1.ProposerBounty")
+	proto.RegisterType((*CommunityPoolSpendPeriod)(nil), "atomone.gov.v1.CommunityPoolSpendPeriod")
+	proto.RegisterType((*Vote)(nil), "atomone.gov.v1.Vote")
+	proto.RegisterType((*VoteLock)(nil), "atomone.gov.v1.VoteLock")
+	proto.RegisterType((*LockedShares)(nil), "atomone.gov.v1.LockedShares")
+	proto.RegisterType((*RecurringProposal)(nil), "atomone.gov.v1.RecurringProposal")
+	proto.RegisterType((*DepositParams)(nil), "atomone.gov.v1.DepositParams")
+	proto.RegisterType((*VotingParams)(nil), "atomone.gov.v1.VotingParams")
+	proto.RegisterType((*TallyParams)(nil), "atomone.gov.v1.TallyParams")
+	proto.RegisterType((*Params)(nil), "atomone.gov.v1.Params")
+	proto.RegisterType((*DepositDenomWeight)(nil), "atomone.gov.v1.DepositDenomWeight")
+	proto.RegisterType((*MessageReviewPeriod)(nil), "atomone.gov.v1.MessageReviewPeriod")
+}
+
+func init() { proto.RegisterFile("atomone/gov/v1/gov.proto", fileDescriptor_ecf0f9950ff6986c) }
+
+var fileDescriptor_ecf0f9950ff6986c = []byte{
+	// 2278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xdd, 0x6f, 0xdb, 0xd6,
+	0x15, 0x37, 0x65, 0x59, 0x92, 0x8f, 0x6d, 0x59, 0xbe, 0x76, 0x6c, 0xda, 0x71, 0x6c, 0x47, 0xed,
+	0x0a, 0x37, 0x6b, 0xe4, 0x25, 0xed, 0x8a, 0x61, 0x29, 0x50, 0xc8, 0x16, 0xdb, 0x2a, 0x71, 0x2c,
+	0x85, 0x92, 0x9d, 0x65, 0x2b, 0x46, 0xd0, 0xe2, 0x8d, 0xcc, 0x85, 0xe4, 0x55, 0xc9, 0x4b, 0xc5,
+	0x7e, 0xe8, 0x1f, 0x30, 0x0c, 0x03, 0xfa, 0xb6, 0x61, 0xef, 0x03, 0xf6, 0x32, 0x60, 0x0f, 0xfd,
+	0x23, 0xfa, 0xd6, 0xa2, 0x18, 0xb0, 0x8f, 0x87, 0x6c, 0x4b, 0x1e, 0x06, 0xf4, 0x8f, 0x18, 0x86,
+	0xfb, 0x41, 0xea, 0xd3, 0x33, 0xed, 0xe5, 0x25, 0x11, 0xcf, 0xfd, 0x9d, 0x73, 0xef, 0xf9, 0xbc,
+	0x3f, 0xd2, 0xa0, 0x9a, 0x94, 0xb8, 0xc4, 0xc3, 0x3b, 0x6d, 0xd2, 0xdd, 0xe9, 0xde, 0x61, 0xff,
+	0x95, 0x3a, 0x3e, 0xa1, 0x04, 0xe5, 0xe5, 0x4a, 0x89, 0x89, 0xba, 0x77, 0xd6, 0x36, 0x5a, 0x24,
+	0x70, 0x49, 0xb0, 0x73, 0x6c, 0x06, 0x78, 0xa7, 0x7b, 0xe7, 0x18, 0x53, 0xf3, 0xce, 0x4e, 0x8b,
+	0xd8, 0x9e, 0xc0, 0xaf, 0x2d, 0xb5, 0x49, 0x9b, 0xf0, 0x9f, 0x3b, 0xec, 0x97, 0x94, 0x6e, 0xb6,
+	0x09, 0x69, 0x3b, 0x78, 0x87, 0x3f, 0x1d, 0x87, 0x4f, 0x77, 0xa8, 0xed, 0xe2, 0x80, 0x9a, 0x6e,
+	0x47, 0x02, 0x56, 0x87, 0x01, 0xa6, 0x77, 0x26, 0x97, 0x36, 0x86, 0x97, 0xac, 0xd0, 0x37, 0xa9,
+	0x4d, 0xa2, 0x1d, 0x57, 0xc5, 0x89, 0x0c, 0xb1, 0xa9, 0x78, 0x90, 0x4b, 0x0b, 0xa6, 0x6b, 0x7b,
+	0x64, 0x87, 0xff, 0x2b, 0x44, 0xc5, 0x0e, 0xa0, 0xc7, 0xd8, 0x6e, 0x9f, 0x50, 0x6c, 0x1d, 0x11,
+	0x8a, 0x6b, 0x1d, 0x66, 0x09, 0xdd, 0x85, 0x0c, 0xe1, 0xbf, 0x54, 0x65, 0x4b, 0xd9, 0xce, 0xdf,
+	0x5d, 0x2b, 0x0d, 0xba, 0x5d, 0xea, 0x61, 0x75, 0x89, 0x44, 0x6f, 0x41, 0xe6, 0x39, 0xb7, 0xa4,
+	0xa6, 0xb6, 0x94, 0xed, 0xe9, 0xdd, 0xfc, 0xb7, 0x5f, 0xde, 0x06, 0xb9, 0x7d, 0x05, 0xb7, 0x74,
+	0xb9, 0x5a, 0xfc, 0x97, 0x02, 0xd9, 0x0a, 0xee, 0x90, 0xc0, 0xa6, 0x68, 0x13, 0x66, 0x3a, 0x3e,
+	0xe9, 0x90, 0xc0, 0x74, 0x0c, 0xdb, 0xe2, 0x9b, 0xa5, 0x75, 0x88, 0x44, 0x55, 0x0b, 0xbd, 0x0f,
+	0xd3, 0x96, 0xc0, 0x12, 0x5f, 0xda, 0x55, 0xbf, 0xfd, 0xf2, 0xf6, 0x92, 0xb4, 0x5b, 0xb6, 0x2c,
+	0x1f, 0x07, 0x41, 0x83, 0xfa, 0xb6, 0xd7, 0xd6, 0x7b, 0x50, 0xf4, 0x01, 0x64, 0x4c, 0x97, 0x84,
+	0x1e, 0x55, 0x27, 0xb7, 0x26, 0xb7, 0x67, 0xee, 0xae, 0x96, 0xa4, 0x06, 0xcb, 0x53, 0x49, 0xe6,
+	0xa9, 0xb4, 0x47, 0x6c, 0x6f, 0x77, 0xfa, 0xab, 0x17, 0x9b, 0x13, 0x7f, 0xf8, 0xf7, 0x9f, 0x6e,
+	0x29, 0xba, 0xd4, 0x41, 0x1f, 0x42, 0xde, 0xc7, 0x4f, 0x43, 0xcf, 0x32, 0x4c, 0xb1, 0x81, 0x9a,
+	0xbe, 0x60, 0xeb, 0x39, 0x81, 0x97, 0xc2, 0xe2, 0xd7, 0x59, 0xc8, 0xd5, 0xa5, 0x17, 0x28, 0x0f,
+	0xa9, 0xd8, 0xb7, 0x94, 0x6d, 0xa1, 0x1f, 0x40, 0xce, 0xc5, 0x41, 0x60, 0xb6, 0x71, 0xa0, 0xa6,
+	0xf8, 0xe9, 0x96, 0x4a, 0x22, 0xa7, 0xa5, 0x28, 0xa7, 0xa5, 0xb2, 0x77, 0xa6, 0xc7, 0x28, 0xf4,
+	0x3e, 0x64, 0x02, 0x6a, 0xd2, 0x30, 0x50, 0x27, 0x79, 0x3a, 0x36, 0x86, 0xd3, 0x11, 0xed, 0xd5,
+	0xe0, 0x28, 0x5d, 0xa2, 0x51, 0x15, 0xd0, 0x53, 0xdb, 0x33, 0x1d, 0x83, 0x9a, 0x8e, 0x73, 0x66,
+	0xf8, 0x38, 0x08, 0x1d, 0xca, 0x7d, 0x99, 0xb9, 0x7b, 0x7d, 0xd8, 0x46, 0x93, 0x61, 0x74, 0x0e,
+	0xd1, 0x0b, 0x5c, 0xad, 0x4f, 0x82, 0xca, 0x30, 0x13, 0x84, 0xc7, 0xae, 0x4d, 0x0d, 0x56, 0xaa,
+	0xea, 0x14, 0xb7, 0xb1, 0x36, 0x72, 0xee, 0x66, 0x54, 0xc7, 0xbb, 0xe9, 0x2f, 0xfe, 0xb1, 0xa9,
+	0xe8, 0x20, 0x94, 0x98, 0x18, 0xdd, 0x87, 0x82, 0x4c, 0x90, 0x81, 0x3d, 0x4b, 0xd8, 0xc9, 0x24,
+	0xb4, 0x93, 0x97, 0x9a, 0x9a, 0x67, 0x71, 0x5b, 0x55, 0x98, 0xa3, 0x84, 0x9a, 0x8e, 0x21, 0xe5,
+	0x6a, 0xf6, 0x12, 0x69, 0x9e, 0xe5, 0xaa, 0x51, 0x0d, 0xee, 0xc3, 0x42, 0x97, 0x50, 0xdb, 0x6b,
+	0x1b, 0x01, 0x35, 0x7d, 0xe9, 0x5f, 0x2e, 0xe1, 0xb9, 0xe6, 0x85, 0x6a, 0x83, 0x69, 0xf2, 0x83,
+	0x7d, 0x02, 0x52, 0xd4, 0xf3, 0x71, 0x3a, 0xa1, 0xad, 0x39, 0xa1, 0x18, 0xb9, 0xb8, 0xc6, 0xca,
+	0x84, 0x9a, 0x96, 0x49, 0x4d, 0x15, 0x58, 0xf9, 0xe9, 0xf1, 0x33, 0x5a, 0x82, 0x29, 0x6a, 0x53,
+	0x07, 0xab, 0x33, 0x7c, 0x41, 0x3c, 0x20, 0x15, 0xb2, 0x41, 0xe8, 0xba, 0xa6, 0x7f, 0xa6, 0xce,
+	0x72, 0x79, 0xf4, 0x88, 0xde, 0x83, 0x9c, 0x68, 0x2a, 0xec, 0xab, 0x73, 0x17, 0x94, 0x72, 0x8c,
+	0x44, 0x15, 0x90, 0x47, 0x32, 0x3a, 0xd8, 0xb7, 0x89, 0xa5, 0xe6, 0xb9, 0x27, 0xab, 0x23, 0x9e,
+	0x54, 0xe4, 0x04, 0xda, 0x4d, 0xff, 0x96, 0x39, 0x32, 0x2b, 0xb4, 0xea, 0x5c, 0x89, 0x45, 0xc4,
+	0xc7, 0x5d, 0x1b, 0x3f, 0xef, 0x45, 0x64, 0x3e, 0x69, 0x44, 0x84, 0x62, 0x14, 0x91, 0x75, 0x98,
+	0xfe, 0x2c, 0x34, 0x2d, 0xb6, 0x57, 0x4b, 0x2d, 0x6c, 0x29, 0xdb, 0x39, 0xbd, 0x27, 0x40, 0x9f,
+	0xc2, 0xba, 0x28, 0xf6, 0x58, 0x34, 0x58, 0xf6, 0x0b, 0x17, 0x97, 0xfd, 0x2a, 0x37, 0xf0, 0x28,
+	0xd2, 0xef, 0x5b, 0x2a, 0xfe, 0x45, 0x81, 0x99, 0xfe, 0x7e, 0xf8, 0x3e, 0x4c, 0x9f, 0xe1, 0xc0,
+	0x68, 0xf1, 0x19, 0xa3, 0x8c, 0x0c, 0xbc, 0xaa, 0x47, 0xf5, 0xdc, 0x19, 0x0e, 0xf6, 0xf8, 0x3c,
+	0x79, 0x17, 0xe6, 0xcc, 0xe3, 0x80, 0x9a, 0xb6, 0x27, 0x15, 0x52, 0x63, 0x15, 0x66, 0x25, 0x48,
+	0x28, 0xbd, 0x0d, 0x39, 0x8f, 0x48, 0xfc, 0xe4, 0x58, 0x7c, 0xd6, 0x23, 0x02, 0x7a, 0x0f, 0x90,
+	0x47, 0x8c, 0xe7, 0x36, 0x3d, 0x31, 0xba, 0x98, 0x46, 0x4a, 0xe9, 0xb1, 0x4a, 0xf3, 0x1e, 0x79,
+	0x6c, 0xd3, 0x93, 0x23, 0x4c, 0x85, 0x72, 0xb1, 0x05, 0x8b, 0x83, 0xe3, 0x43, 0xd8, 0xec, 0xcd,
+	0x1c, 0xe5, 0x52, 0x33, 0x67, 0x09, 0xa6, 0x7a, 0x3e, 0xa6, 0x75, 0xf1, 0x50, 0xfc, 0x14, 0xe6,
+	0x23, 0x7c, 0x33, 0xf4, 0x3d, 0x12, 0x26, 0x98, 0xfd, 0xdb, 0x90, 0xa5, 0x02, 0x7b, 0xce, 0x8d,
+	0x12, 0x2d, 0x17, 0xff, 0x93, 0x82, 0x42, 0xd9, 0x6f, 0x9d, 0xd8, 0x5d, 0x6c, 0x9d, 0x3b, 0x76,
+	0x7b, 0x0e, 0xa5, 0x5e, 0xc3, 0x10, 0x9d, 0x7c, 0x0d, 0x43, 0x34, 0x7d, 0x85, 0x21, 0x3a, 0x66,
+	0xbe, 0x4c, 0x5d, 0x6d, 0xbe, 0xc4, 0x33, 0x24, 0xd3, 0x3f, 0x43, 0xfa, 0x27, 0x45, 0x36, 0xe9,
+	0xa4, 0x28, 0xde, 0x07, 0xd8, 0x65, 0x79, 0x3e, 0xab, 0x13, 0xe2, 0xf4, 0x5d, 0xbe, 0xca, 0xe5,
+	0x2f, 0xdf, 0xe2, 0xef, 0x15, 0xc8, 0xd7, 0xa5, 0x61, 0x61, 0xf4, 0xe2, 0x52, 0xe9, 0x3f, 0x75,
+	0x2a, 0xf1, 0x7c, 0xfb, 0xbf, 0x48, 0x42, 0xf1, 0x37, 0x0a, 0xa8, 0x7b, 0xc4, 0x75, 0x43, 0xcf,
+	0x16, 0x7e, 0x37, 0x3a, 0xd8, 0xb3, 0xe4, 0xd0, 0xfb, 0x10, 0xa0, 0xef, 0x36, 0x51, 0x12, 0x66,
+	0x68, 0x3a, 0x88, 0xef, 0x91, 0x1f, 0xc3, 0x54, 0xd0, 0xc1, 0xbc, 0x8d, 0x92, 0x1f, 0x4d, 0xa8,
+	0x14, 0xff, 0xae, 0x40, 0x9a, 0x11, 0xb4, 0x8b, 0xe3, 0x56, 0x82, 0xa9, 0x2e, 0xa1, 0x09, 0x82,
+	0x26, 0x60, 0xe8, 0x03, 0xc8, 0x0a, 0xb6, 0xc7, 0x18, 0x11, 0x3b, 0x57, 0x71, 0xb8, 0x01, 0x46,
+	0xc9, 0xa4, 0x1e, 0xa9, 0x0c, 0xdc, 0x68, 0x53, 0x43, 0x37, 0xda, 0x4d, 0x98, 0x75, 0x48, 0xeb,
+	0x99, 0xbc, 0x69, 0x02, 0x5e, 0x94, 0x73, 0xfa, 0x0c, 0x93, 0x89, 0x90, 0x06, 0xf7, 0xd3, 0xb9,
+	0xc9, 0x42, 0xba, 0xf8, 0x47, 0x05, 0x72, 0xcc, 0xf8, 0x3e, 0x69, 0x3d, 0xeb, 0x9d, 0x5f, 0x49,
+	0x76, 0xfe, 0x7b, 0x90, 0x8b, 0xdb, 0x26, 0x95, 0x30, 0x29, 0x59, 0x2c, 0x1b, 0xe6, 0x3d, 0xc8,
+	0x04, 0x27, 0xa6, 0x8f, 0x03, 0x59, 0x2e, 0xeb, 0xc3, 0xbe, 0xb3, 0x23, 0x61, 0xab, 0xc1, 0x31,
+	0xba, 0xc4, 0x16, 0x3f, 0x87, 0xd9, 0x7e, 0x39, 0xd2, 0x60, 0xa1, 0x6b, 0x3a, 0xb6, 0x65, 0x52,
+	0xe2, 0xc7, 0xf4, 0xf2, 0xa2, 0xe3, 0x17, 0x62, 0x15, 0x29, 0x67, 0x6c, 0x5b, 0x1e, 0xe6, 0x1c,
+	0xb6, 0x2d, 0xb7, 0xff, 0x73, 0x0a, 0x16, 0x74, 0xdc, 0x0a, 0x7d, 0x66, 0xe7, 0x35, 0x52, 0xd2,
+	0xfe, 0x5c, 0x4e, 0x9e, 0xc7, 0x4e, 0xd2, 0xe7, 0xb0, 0x93, 0xa9, 0x41, 0x76, 0x72, 0x0f, 0x72,
+	0xb6, 0x47, 0xb1, 0xdf, 0x35, 0x1d, 0x35, 0x93, 0x8c, 0x62, 0xc4, 0x0a, 0x8c, 0x55, 0x7a, 0xf8,
+	0x94, 0x1a, 0xfd, 0x83, 0x35, 0x9b, 0x94, 0x55, 0x32, 0xcd, 0x46, 0x6f, 0xb8, 0x6e, 0x43, 0xc1,
+	0x31, 0x03, 0x6a, 0xf4, 0x37, 0x4d, 0x8e, 0x07, 0x29, 0xcf, 0xe4, 0xf5, 0xb8, 0x71, 0x8a, 0x7f,
+	0x53, 0x60, 0x4e, 0x12, 0xc8, 0xba, 0xe9, 0x9b, 0x6e, 0x80, 0x9e, 0xc0, 0x8c, 0x6b, 0x7b, 0x31,
+	0x1f, 0xbd, 0x70, 0xf2, 0xdd, 0x60, 0x6d, 0xfb, 0xdd, 0x8b, 0xcd, 0x6b, 0x7d, 0x5a, 0xef, 0x10,
+	0xd7, 0xa6, 0xd8, 0xed, 0xd0, 0x33, 0x1d, 0x5c, 0xdb, 0x8b, 0x18, 0xaa, 0x0b, 0xc8, 0x35, 0x4f,
+	0x23, 0x50, 0x44, 0xc6, 0x52, 0x17, 0x45, 0xea, 0xcd, 0xef, 0x5e, 0x6c, 0xae, 0x8f, 0x2a, 0xf6,
+	0x36, 0xe1, 0x91, 0x2c, 0xb8, 0xe6, 0x69, 0xe4, 0x09, 0x5f, 0x2f, 0x36, 0x61, 0xf6, 0x48, 0x10,
+	0x38, 0xe1, 0xd9, 0x08, 0x0d, 0x54, 0xae, 0x40, 0x03, 0x8b, 0xbf, 0x8b, 0x08, 0x94, 0xb4, 0xfa,
+	0x16, 0x64, 0x3e, 0x0b, 0x89, 0x1f, 0xba, 0xaa, 0x32, 0xbe, 0x80, 0xc5, 0x2a, 0x7a, 0x07, 0xa6,
+	0xe9, 0x89, 0x8f, 0x83, 0x13, 0xe2, 0x58, 0xe7, 0xd4, 0x7a, 0x0f, 0x80, 0x7e, 0x08, 0x79, 0xce,
+	0x80, 0x7a, 0x2a, 0x93, 0x63, 0x55, 0xe6, 0x18, 0xaa, 0x19, 0x81, 0x8a, 0x5f, 0xcf, 0x43, 0x46,
+	0x9e, 0x4b, 0xbb, 0x64, 0x1e, 0xfb, 0xc6, 0x6f, 0x7f, 0xce, 0x1e, 0x5e, 0x2d, 0x67, 0xe9, 0xf1,
+	0x39, 0x19, 0xcd, 0xc1, 0xe4, 0x55, 0xa8, 0x78, 0x2f, 0xe6, 0xe9, 0xe4, 0x31, 0x9f, 0xba, 0x7c,
+	0xcc, 0x33, 0x09, 0x62, 0x8e, 0xaa, 0xb0, 0xca, 0x02, 0x6d, 0x7b, 0x36, 0xb5, 0x7b, 0x2f, 0x72,
+	0x06, 0x3f, 0xbe, 0x9a, 0x1d, 0x6b, 0x61, 0xd9, 0xb5, 0xbd, 0xaa, 0xc0, 0xcb, 0xf0, 0xe8, 0x0c,
+	0xcd, 0xfa, 0xf6, 0x38, 0xf4, 0x3d, 0x83, 0x0d, 0x79, 0x43, 0x7a, 0x38, 0xc7, 0xdf, 0x0f, 0xf2,
+	0x4c, 0xce, 0xae, 0x8b, 0x47, 0xc2, 0xb3, 0x32, 0xdc, 0xe0, 0xc8, 0xb8, 0xc3, 0xe3, 0x04, 0xf9,
+	0x98, 0x69, 0xf3, 0x57, 0x9c, 0x9c, 0xbe, 0xc6, 0x40, 0x51, 0xbb, 0x47, 0x99, 0x10, 0x08, 0xf4,
+	0x26, 0xe4, 0x7b, 0x9b, 0x31, 0x97, 0xf8, 0xeb, 0x4c, 0x4e, 0x9f, 0x8d, 0xb6, 0x62, 0xd4, 0x1a,
+	0xfd, 0x0c, 0x56, 0xe3, 0x3d, 0x7c, 0x4c, 0xb1, 0xc7, 0x92, 0x12, 0x25, 0xaf, 0x90, 0x2c, 0x79,
+	0x2b, 0x91, 0x05, 0x3d, 0x32, 0x20, 0xf3, 0xb8, 0x0b, 0xd7, 0x22, 0x12, 0x63, 0x1c, 0x73, 0x8a,
+	0x24, 0xc3, 0xb6, 0x30, 0x36, 0x6c, 0x8b, 0x9d, 0x01, 0x3a, 0x25, 0x62, 0xf6, 0x10, 0xe6, 0x87,
+	0x6c, 0xa8, 0xe8, 0x12, 0xb5, 0x9e, 0x1f, 0xb4, 0x89, 0x4c, 0x58, 0x6b, 0x45, 0x64, 0xc8, 0xe8,
+	0x10, 0xe2, 0x18, 0x8c, 0x8b, 0x58, 0x86, 0x63, 0xbb, 0x36, 0x55, 0x17, 0x2f, 0x61, 0x79, 0xa5,
+	0x35, 0x42, 0xaa, 0xf6, 0x99, 0x11, 0xf4, 0x73, 0xb8, 0x3e, 0x76, 0x0b, 0x19, 0xd4, 0xa5, 0x64,
+	0x41, 0x55, 0x5b, 0xe7, 0x71, 0xb6, 0xc7, 0xf0, 0xf6, 0x68, 0xcb, 0xc6, 0x95, 0x12, 0x30, 0x81,
+	0x11, 0xb3, 0xcc, 0x6b, 0xfc, 0x5a, 0x78, 0x73, 0xb8, 0x51, 0xa3, 0x9a, 0x09, 0xea, 0xd8, 0x8f,
+	0x48, 0x2c, 0x7a, 0x00, 0x0b, 0xac, 0xd2, 0x07, 0x1b, 0x78, 0x39, 0xd9, 0x71, 0xe7, 0x5d, 0xdb,
+	0x3b, 0xea, 0xef, 0x61, 0x66, 0xcc, 0x3c, 0x1d, 0x32, 0xb6, 0x92, 0xd4, 0x98, 0x79, 0x3a, 0x60,
+	0xec, 0x47, 0xa0, 0xc6, 0x55, 0x1a, 0x5d, 0xdf, 0x46, 0xd0, 0x3a, 0xc1, 0xae, 0xa9, 0xaa, 0xfc,
+	0x92, 0x5e, 0x8e, 0xd6, 0x1f, 0xca, 0xe5, 0x06, 0x5f, 0x65, 0x03, 0x49, 0xbe, 0xd5, 0xcb, 0x23,
+	0xac, 0x26, 0x1c, 0x48, 0x42, 0x4b, 0xee, 0xff, 0x04, 0x96, 0x25, 0xa3, 0x30, 0x06, 0xac, 0x05,
+	0xea, 0x1a, 0xaf, 0x98, 0x37, 0x86, 0x29, 0xd6, 0x43, 0x81, 0xd6, 0xfb, 0x8c, 0xe8, 0x4b, 0xee,
+	0xa8, 0x30, 0x60, 0x9d, 0x8e, 0x4f, 0x5b, 0x4e, 0x68, 0x61, 0x23, 0xf4, 0xba, 0x38, 0xa0, 0xd8,
+	0x8a, 0x83, 0x46, 0x9e, 0x63, 0x5f, 0xbd, 0x2e, 0x3a, 0x5d, 0x82, 0x0e, 0x25, 0x46, 0x86, 0x87,
+	0x21, 0x58, 0x74, 0x7a, 0xdf, 0x12, 0xe2, 0xb7, 0x2e, 0xf3, 0xd8, 0xc1, 0x96, 0xba, 0xce, 0xb5,
+	0x97, 0xe3, 0xf5, 0x23, 0xf9, 0x6e, 0xc5, 0x57, 0xd1, 0x03, 0x58, 0x1b, 0xd1, 0xe4, 0xbb, 0x1a,
+	0x2d, 0xb3, 0xa3, 0xde, 0x18, 0xdb, 0xa5, 0x2b, 0x43, 0xb6, 0xf8, 0x19, 0xf6, 0xcc, 0x0e, 0xba,
+	0x03, 0xd7, 0x64, 0xc6, 0xb1, 0x31, 0xc0, 0x91, 0x37, 0x38, 0x47, 0x46, 0x22, 0xa9, 0x9c, 0x10,
+	0x47, 0xce, 0x6b, 0xb0, 0x19, 0x39, 0xff, 0x0b, 0xd3, 0x76, 0x98, 0xeb, 0x11, 0x81, 0x0c, 0xd8,
+	0x67, 0xae, 0x67, 0x58, 0xdd, 0xe4, 0x0e, 0xac, 0x4b, 0xd8, 0x7d, 0x8e, 0x3a, 0x8a, 0x41, 0x0d,
+	0x86, 0x41, 0x26, 0xac, 0xba, 0xc4, 0x0a, 0x1d, 0x6c, 0x98, 0x2d, 0xfe, 0x1e, 0x2f, 0x74, 0x0d,
+	0x3f, 0x74, 0xb0, 0xba, 0xc5, 0xdf, 0xa2, 0xdf, 0x1a, 0xc9, 0x10, 0x57, 0x28, 0x0b, 0x3c, 0x37,
+	0xa3, 0x87, 0x0e, 0xd6, 0x97, 0xdd, 0xb1, 0x72, 0x74, 0x04, 0xd7, 0xa2, 0x86, 0xb3, 0xb0, 0x47,
+	0x5c, 0x43, 0x7c, 0x25, 0x0e, 0xd4, 0x9b, 0xe3, 0xdf, 0x2f, 0x64, 0xb7, 0x55, 0x18, 0x56, 0xbc,
+	0x6b, 0xe8, 0x8b, 0xd6, 0x88, 0x2c, 0x28, 0xea, 0x80, 0x46, 0xa1, 0x8c, 0x99, 0xf2, 0x5d, 0x04,
+	0xe7, 0xd0, 0xc5, 0x43, 0xe2, 0x2f, 0xd7, 0x9f, 0xc3, 0xe2, 0x98, 0xfa, 0x43, 0x5b, 0x30, 0xeb,
+	0x06, 0x6d, 0x83, 0x9e, 0x75, 0xb0, 0x11, 0xfa, 0x8e, 0xb4, 0x0d, 0x6e, 0xd0, 0x6e, 0x9e, 0x75,
+	0xf0, 0xa1, 0xef, 0x8c, 0x36, 0x4b, 0xea, 0x0a, 0xcd, 0x72, 0xeb, 0x97, 0x0a, 0x40, 0xdf, 0x37,
+	0xfa, 0xeb, 0xb0, 0x72, 0x54, 0x6b, 0x6a, 0x46, 0xad, 0xde, 0xac, 0xd6, 0x0e, 0x8c, 0xc3, 0x83,
+	0x46, 0x5d, 0xdb, 0xab, 0x7e, 0x54, 0xd5, 0x2a, 0x85, 0x09, 0xb4, 0x08, 0xf3, 0xfd, 0x8b, 0x4f,
+	0xb4, 0x46, 0x41, 0x41, 0x2b, 0xb0, 0xd8, 0x2f, 0x2c, 0xef, 0x36, 0x9a, 0xe5, 0xea, 0x41, 0x21,
+	0x85, 0x10, 0xe4, 0xfb, 0x17, 0x0e, 0x6a, 0x85, 0x49, 0xb4, 0x0e, 0xea, 0xa0, 0xcc, 0x78, 0x5c,
+	0x6d, 0x7e, 0x62, 0x1c, 0x69, 0xcd, 0x5a, 0x21, 0x7d, 0xeb, 0xd7, 0x29, 0xc8, 0x0f, 0x7e, 0x2f,
+	0x41, 0x9b, 0x70, 0xbd, 0xae, 0xd7, 0xea, 0xb5, 0x46, 0x79, 0xdf, 0x68, 0x34, 0xcb, 0xcd, 0xc3,
+	0xc6, 0xd0, 0x99, 0x8a, 0xb0, 0x31, 0x0c, 0xa8, 0x68, 0xf5, 0x5a, 0xa3, 0xda, 0x34, 0xea, 0x9a,
+	0x5e, 0xad, 0x55, 0x0a, 0x0a, 0xba, 0x09, 0x37, 0x86, 0x31, 0x47, 0xb5, 0x66, 0xf5, 0xe0, 0xe3,
+	0x08, 0x92, 0x42, 0x6b, 0xb0, 0x3c, 0x0c, 0xa9, 0x97, 0x1b, 0x0d, 0xad, 0x22, 0x0e, 0x3d, 0xbc,
+	0xa6, 0x6b, 0xf7, 0xb5, 0xbd, 0xa6, 0x56, 0x29, 0xa4, 0xc7, 0x69, 0x7e, 0x54, 0xae, 0xee, 0x6b,
+	0x95, 0xc2, 0xd4, 0xb8, 0xb5, 0x47, 0x87, 0xda, 0xa1, 0x56, 0x29, 0x64, 0xc6, 0x1d, 0x4a, 0xd7,
+	0x8e, 0xaa, 0xda, 0xe3, 0xe8, 0x50, 0xd9, 0x5b, 0xbf, 0x52, 0x60, 0x79, 0x7c, 0xe5, 0xa3, 0x37,
+	0x60, 0xf3, 0x61, 0xad, 0x72, 0xb8, 0xaf, 0x19, 0xe5, 0xbd, 0xbd, 0xda, 0xe1, 0x41, 0x93, 0xd9,
+	0x78, 0xa0, 0x19, 0x3a, 0x13, 0x71, 0x41, 0x61, 0x02, 0x7d, 0x0f, 0x6e, 0x9e, 0x0f, 0x8a, 0x12,
+	0xa5, 0xfc, 0x6f, 0x98, 0xf6, 0x93, 0xbd, 0xfd, 0xc3, 0x8a, 0x56, 0x48, 0xed, 0x7e, 0xfc, 0xd5,
+	0xcb, 0x0d, 0xe5, 0x9b, 0x97, 0x1b, 0xca, 0x3f, 0x5f, 0x6e, 0x28, 0x5f, 0xbc, 0xda, 0x98, 0xf8,
+	0xe6, 0xd5, 0xc6, 0xc4, 0x5f, 0x5f, 0x6d, 0x4c, 0xfc, 0xf4, 0x76, 0xdb, 0xa6, 0x27, 0xe1, 0x71,
+	0xa9, 0x45, 0xdc, 0x1d, 0xd9, 0x59, 0xb7, 0x4f, 0xc2, 0xe3, 0xe8, 0xf7, 0xce, 0x29, 0xff, 0x8b,
+	0x17, 0x2b, 0xe5, 0x80, 0xfd, 0x35, 0x2b, 0xc3, 0x2b, 0xf3, 0xdd, 0xff, 0x0e, 0x00, 0x97, 0x8d,
+	0xb4, 0xbe, 0x10, 0x1b, 0x00, 0x00,
+}
+
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.VetoThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.MaxDepositPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalQuadraticTallyResult != nil {
+		{
+			size, err := m.FinalQuadraticTallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Quadratic {
+		i--
+		if m.Quadratic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ReviewEndTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ReviewEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReviewEndTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x7a
 	}
 	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+		n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x72
 	}
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x62
 	}
-	l = len(m.VetoThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.MinInitialDepositRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.BurnVoteQuorum {
-		n += 2
+	if m.VotingEndTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.BurnProposalDepositPrevote {
-		n += 2
+	if m.VotingStartTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.BurnVoteVeto {
-		n += 2
+	if len(m.TotalDeposit) > 0 {
+		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DepositEndTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SubmitTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FinalTallyResult != nil {
+		{
+			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NoWithVetoCount) > 0 {
+		i -= len(m.NoWithVetoCount)
+		copy(dAtA[i:], m.NoWithVetoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoWithVetoCount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NoCount) > 0 {
+		i -= len(m.NoCount)
+		copy(dAtA[i:], m.NoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AbstainCount) > 0 {
+		i -= len(m.AbstainCount)
+		copy(dAtA[i:], m.AbstainCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.YesCount) > 0 {
+		i -= len(m.YesCount)
+		copy(dAtA[i:], m.YesCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalStatusCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalStatusCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalStatusCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalTurnout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalTurnout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalTurnout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Turnout) > 0 {
+		i -= len(m.Turnout)
+		copy(dAtA[i:], m.Turnout)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Turnout)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.VotingEndTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SubmitTime != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FinalTallyResult != nil {
+		{
+			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BountyPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BountyPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountyPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerBounty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerBounty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerBounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StartTime != nil {
+		n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockPeriods != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.LockPeriods))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndTime != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		i -= len(m.Shares)
+		copy(dAtA[i:], m.Shares)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Shares)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecurringProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.LastProposalId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NextSubmitTime != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextSubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextSubmitTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Interval != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintGov(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDepositPeriod != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintGov(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintGov(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositDenomWeights) > 0 {
+		for iNdEx := len(m.DepositDenomWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositDenomWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.ModuleAccountStakeRule != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ModuleAccountStakeRule))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ExcludeJailedValidatorsStake {
+		i--
+		if m.ExcludeJailedValidatorsStake {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.MaxVoteLockPeriods != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxVoteLockPeriods))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.QuadraticVotingPowerCap) > 0 {
+		i -= len(m.QuadraticVotingPowerCap)
+		copy(dAtA[i:], m.QuadraticVotingPowerCap)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.QuadraticVotingPowerCap)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.QuadraticVotingEnabled {
+		i--
+		if m.QuadraticVotingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.ExcludeUnvestedVotingPower {
+		i--
+		if m.ExcludeUnvestedVotingPower {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.MessageReviewPeriods) > 0 {
+		for iNdEx := len(m.MessageReviewPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageReviewPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if m.ReviewPeriod != nil {
+		n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ReviewPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ReviewPeriod):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintGov(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.ProposalMetadataSchema) > 0 {
+		i -= len(m.ProposalMetadataSchema)
+		copy(dAtA[i:], m.ProposalMetadataSchema)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalMetadataSchema)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.MaxVotingPeriod != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriod):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintGov(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.MinVotingPeriod != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MinVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MinVotingPeriod):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintGov(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.MaxDepositPeriodProposalsPerProposer != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxDepositPeriodProposalsPerProposer))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.CommunityPoolSpendPeriod != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.CommunityPoolSpendPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.CommunityPoolSpendPeriod):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintGov(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.CommunityPoolSpendLimit) > 0 {
+		for iNdEx := len(m.CommunityPoolSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.ProposerBounty) > 0 {
+		for iNdEx := len(m.ProposerBounty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerBounty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ProposerBountyRatio) > 0 {
+		i -= len(m.ProposerBountyRatio)
+		copy(dAtA[i:], m.ProposerBountyRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposerBountyRatio)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ProposalRetentionPeriod != nil {
+		n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ProposalRetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ProposalRetentionPeriod):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintGov(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.BurnVoteVeto {
+		i--
+		if m.BurnVoteVeto {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BurnProposalDepositPrevote {
+		i--
+		if m.BurnProposalDepositPrevote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BurnVoteQuorum {
+		i--
+		if m.BurnVoteQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.MinInitialDepositRatio) > 0 {
+		i -= len(m.MinInitialDepositRatio)
+		copy(dAtA[i:], m.MinInitialDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPeriod != nil {
+		n23, err23 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintGov(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxDepositPeriod != nil {
+		n24, err24 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintGov(dAtA, i, uint64(n24))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositDenomWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositDenomWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositDenomWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageReviewPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageReviewPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageReviewPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReviewPeriod != nil {
+		n25, err25 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ReviewPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ReviewPeriod):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintGov(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.FinalTallyResult != nil {
+		l = m.FinalTallyResult.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DepositEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.TotalDeposit) > 0 {
+		for _, e := range m.TotalDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingStartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ReviewEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReviewEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Quadratic {
+		n += 3
+	}
+	if m.FinalQuadraticTallyResult != nil {
+		l = m.FinalQuadraticTallyResult.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.YesCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.AbstainCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoWithVetoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalStatusCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.Count != 0 {
+		n += 1 + sovGov(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *ProposalTurnout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Turnout)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ArchivedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.FinalTallyResult != nil {
+		l = m.FinalTallyResult.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *BountyPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposerBounty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.LockPeriods != 0 {
+		n += 1 + sovGov(uint64(m.LockPeriods))
+	}
+	return n
+}
+
+func (m *VoteLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockedShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Shares)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *RecurringProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Interval != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.NextSubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextSubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.LastProposalId != 0 {
+		n += 1 + sovGov(uint64(m.LastProposalId))
+	}
+	return n
+}
+
+func (m *DepositParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *VotingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MinInitialDepositRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.BurnVoteQuorum {
+		n += 2
+	}
+	if m.BurnProposalDepositPrevote {
+		n += 2
+	}
+	if m.BurnVoteVeto {
+		n += 2
+	}
+	if m.ProposalRetentionPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ProposalRetentionPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposerBountyRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.ProposerBounty) > 0 {
+		for _, e := range m.ProposerBounty {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.CommunityPoolSpendLimit) > 0 {
+		for _, e := range m.CommunityPoolSpendLimit {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.CommunityPoolSpendPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.CommunityPoolSpendPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MaxDepositPeriodProposalsPerProposer != 0 {
+		n += 2 + sovGov(uint64(m.MaxDepositPeriodProposalsPerProposer))
+	}
+	if m.MinVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MinVotingPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MaxVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxVotingPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalMetadataSchema)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ReviewPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ReviewPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.MessageReviewPeriods) > 0 {
+		for _, e := range m.MessageReviewPeriods {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ExcludeUnvestedVotingPower {
+		n += 3
+	}
+	if m.QuadraticVotingEnabled {
+		n += 3
+	}
+	l = len(m.QuadraticVotingPowerCap)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.MaxVoteLockPeriods != 0 {
+		n += 2 + sovGov(uint64(m.MaxVoteLockPeriods))
+	}
+	if m.ExcludeJailedValidatorsStake {
+		n += 3
+	}
+	if m.ModuleAccountStakeRule != 0 {
+		n += 2 + sovGov(uint64(m.ModuleAccountStakeRule))
+	}
+	if len(m.DepositDenomWeights) > 0 {
+		for _, e := range m.DepositDenomWeights {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DepositDenomWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageReviewPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ReviewPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ReviewPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &TallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositEndTime == nil {
+				m.DepositEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingStartTime == nil {
+				m.VotingStartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingEndTime == nil {
+				m.VotingEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReviewEndTime == nil {
+				m.ReviewEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ReviewEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quadratic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quadratic = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalQuadraticTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalQuadraticTallyResult == nil {
+				m.FinalQuadraticTallyResult = &TallyResult{}
+			}
+			if err := m.FinalQuadraticTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalStatusCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalStatusCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalStatusCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalTurnout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalTurnout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalTurnout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Turnout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Turnout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &TallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingEndTime == nil {
+				m.VotingEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BountyPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountyPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountyPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerBounty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerBounty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerBounty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolSpendPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolSpendPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolSpendPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockPeriods", wireType)
+			}
+			m.LockPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockPeriods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, &LockedShares{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+func (m *RecurringProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1815,17 +6324,17 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecurringProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecurringProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Option = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1835,14 +6344,48 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1870,8 +6413,163 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextSubmitTime == nil {
+				m.NextSubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextSubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProposalId", wireType)
+			}
+			m.LastProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -1893,7 +6591,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1916,36 +6614,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1955,27 +6634,29 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2002,8 +6683,10 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2028,7 +6711,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2051,34 +6734,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2105,35 +6769,68 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
 			}
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2143,33 +6840,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalTallyResult == nil {
-				m.FinalTallyResult = &TallyResult{}
-			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2179,33 +6872,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitTime == nil {
-				m.SubmitTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2215,31 +6904,77 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DepositEndTime == nil {
-				m.DepositEndTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2266,14 +7001,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2300,16 +7035,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingStartTime == nil {
-				m.VotingStartTime = new(time.Time)
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2336,16 +7071,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingEndTime == nil {
-				m.VotingEndTime = new(time.Time)
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2373,11 +7108,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2405,11 +7140,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2437,11 +7172,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2469,63 +7204,73 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.BurnVoteQuorum = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.BurnProposalDepositPrevote = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BurnVoteVeto = bool(v != 0)
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalRetentionPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2535,27 +7280,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
+			if m.ProposalRetentionPeriod == nil {
+				m.ProposalRetentionPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ProposalRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBountyRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2583,13 +7332,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			m.ProposerBountyRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBounty", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2599,29 +7348,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
+			m.ProposerBounty = append(m.ProposerBounty, types.Coin{})
+			if err := m.ProposerBounty[len(m.ProposerBounty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpendLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2631,79 +7382,67 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
+			m.CommunityPoolSpendLimit = append(m.CommunityPoolSpendLimit, types.Coin{})
+			if err := m.CommunityPoolSpendLimit[len(m.CommunityPoolSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpendPeriod", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Vote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
+			if msglen < 0 {
+				return ErrInvalidLengthGov
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.CommunityPoolSpendPeriod == nil {
+				m.CommunityPoolSpendPeriod = new(time.Duration)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.CommunityPoolSpendPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriodProposalsPerProposer", wireType)
 			}
-			m.ProposalId = 0
+			m.MaxDepositPeriodProposalsPerProposer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2713,16 +7452,16 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.MaxDepositPeriodProposalsPerProposer |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVotingPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2732,27 +7471,31 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if m.MinVotingPeriod == nil {
+				m.MinVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MinVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2779,14 +7522,16 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxVotingPeriod == nil {
+				m.MaxVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalMetadataSchema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2814,61 +7559,11 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.ProposalMetadataSchema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DepositParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2895,14 +7590,16 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReviewPeriod == nil {
+				m.ReviewPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ReviewPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageReviewPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2929,68 +7626,16 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.MessageReviewPeriods = append(m.MessageReviewPeriods, &MessageReviewPeriod{})
+			if err := m.MessageReviewPeriods[len(m.MessageReviewPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VotingParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeUnvestedVotingPower", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3000,81 +7645,35 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ExcludeUnvestedVotingPower = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuadraticVotingEnabled", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.QuadraticVotingEnabled = bool(v != 0)
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuadraticVotingPowerCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3102,13 +7701,13 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.QuadraticVotingPowerCap = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteLockPeriods", wireType)
 			}
-			var stringLen uint64
+			m.MaxVoteLockPeriods = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3118,29 +7717,55 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxVoteLockPeriods |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeJailedValidatorsStake", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExcludeJailedValidatorsStake = bool(v != 0)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountStakeRule", wireType)
+			}
+			m.ModuleAccountStakeRule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModuleAccountStakeRule |= ModuleAccountStakeRule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositDenomWeights", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3150,23 +7775,25 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.DepositDenomWeights = append(m.DepositDenomWeights, &DepositDenomWeight{})
+			if err := m.DepositDenomWeights[len(m.DepositDenomWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3189,7 +7816,7 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DepositDenomWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3212,17 +7839,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositDenomWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositDenomWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3232,101 +7859,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3354,43 +7907,61 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.Weight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageReviewPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageReviewPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageReviewPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3418,13 +7989,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3434,84 +8005,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BurnVoteQuorum = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.BurnProposalDepositPrevote = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+			if m.ReviewPeriod == nil {
+				m.ReviewPeriod = new(time.Duration)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ReviewPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.BurnVoteVeto = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
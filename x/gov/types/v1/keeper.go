@@ -0,0 +1,13 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GovReadKeeper defines the read-only view of the governance keeper that other
+// modules can depend on, without importing the governance keeper.
+type GovReadKeeper interface {
+	GetProposal(ctx sdk.Context, proposalID uint64) (Proposal, bool)
+	GetVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (Vote, bool)
+	GetTallyResult(ctx sdk.Context, proposalID uint64) (TallyResult, bool)
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+)
+
+// ValidateMetadataSchema checks that schema is a valid JSON schema. Schemas
+// referencing documents outside of themselves are rejected, since loading them
+// would make the validation of the proposal metadata non-deterministic.
+func ValidateMetadataSchema(schema string) error {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(schema), &doc); err != nil {
+		return fmt.Errorf("invalid proposal metadata schema: %w", err)
+	}
+	if err := assertLocalRefs(doc); err != nil {
+		return err
+	}
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(doc)); err != nil {
+		return fmt.Errorf("invalid proposal metadata schema: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateMetadata checks that metadata is a JSON document valid against
+// schema. An empty schema or an empty metadata is always valid.
+func ValidateMetadata(schema, metadata string) error {
+	if schema == "" || metadata == "" {
+		return nil
+	}
+
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewStringLoader(schema),
+		gojsonschema.NewStringLoader(metadata),
+	)
+	if err != nil {
+		return types.ErrInvalidMetadata.Wrap(err.Error())
+	}
+	if !result.Valid() {
+		errs := make([]string, len(result.Errors()))
+		for i, resErr := range result.Errors() {
+			errs[i] = resErr.String()
+		}
+		return types.ErrInvalidMetadata.Wrap(strings.Join(errs, "; "))
+	}
+
+	return nil
+}
+
+// assertLocalRefs walks a decoded JSON schema and returns an error for every
+// $ref that doesn't point inside the schema itself.
+func assertLocalRefs(doc interface{}) error {
+	switch v := doc.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value := v[key]
+			if key == "$ref" {
+				ref, ok := value.(string)
+				if !ok || !strings.HasPrefix(ref, "#") {
+					return fmt.Errorf("proposal metadata schema must not reference remote documents: %v", value)
+				}
+				continue
+			}
+			if err := assertLocalRefs(value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if err := assertLocalRefs(value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
+	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
+)
+
+const testMetadataSchema = `{
+	"type": "object",
+	"properties": {
+		"title": {"type": "string", "maxLength": 64},
+		"summary": {"type": "string"},
+		"forum": {"type": "string", "pattern": "^https://"},
+		"ipfs": {"$ref": "#/definitions/cid"}
+	},
+	"required": ["title", "summary"],
+	"definitions": {
+		"cid": {"type": "string", "pattern": "^(Qm|bafy)"}
+	}
+}`
+
+func TestValidateMetadataSchema(t *testing.T) {
+	tests := []struct {
+		name      string
+		schema    string
+		expErrMsg string
+	}{
+		{"valid schema", testMetadataSchema, ""},
+		{"invalid json", `{"type":`, "invalid proposal metadata schema"},
+		{"invalid schema", `{"type": 1}`, "invalid proposal metadata schema"},
+		{"remote ref", `{"properties": {"a": {"$ref": "https://example.com/schema.json"}}}`, "must not reference remote documents"},
+	}
+
+	for _, tc := range tests {
+		err := v1.ValidateMetadataSchema(tc.schema)
+		if tc.expErrMsg != "" {
+			require.ErrorContains(t, err, tc.expErrMsg, "test: %s", tc.name)
+		} else {
+			require.NoError(t, err, "test: %s", tc.name)
+		}
+	}
+}
+
+func TestValidateMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		metadata string
+		expErr   bool
+	}{
+		{"no schema", "", "not json", false},
+		{"no metadata", testMetadataSchema, "", false},
+		{"valid metadata", testMetadataSchema, `{"title":"t","summary":"s","forum":"https://forum.example","ipfs":"QmHash"}`, false},
+		{"not json", testMetadataSchema, "ipfs://QmHash", true},
+		{"missing summary", testMetadataSchema, `{"title":"t"}`, true},
+		{"invalid forum link", testMetadataSchema, `{"title":"t","summary":"s","forum":"forum.example"}`, true},
+		{"invalid ipfs hash", testMetadataSchema, `{"title":"t","summary":"s","ipfs":"hash"}`, true},
+	}
+
+	for _, tc := range tests {
+		err := v1.ValidateMetadata(tc.schema, tc.metadata)
+		if tc.expErr {
+			require.ErrorIs(t, err, types.ErrInvalidMetadata, "test: %s", tc.name)
+		} else {
+			require.NoError(t, err, "test: %s", tc.name)
+		}
+	}
+}
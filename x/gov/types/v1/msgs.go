@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 
@@ -17,7 +18,8 @@ import (
 
 var (
 	_, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}
-	_, _             codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _          sdk.Msg                            = &MsgProposeConstitutionAmendment{}, &MsgScheduleRecurringProposal{}, &MsgCancelRecurringProposal{}
+	_, _, _          codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}, &MsgScheduleRecurringProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -87,6 +89,10 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, deposit.String()) //nolint:staticcheck
 	}
 
+	if m.VotingPeriod != nil && m.VotingPeriod.Seconds() <= 0 {
+		return types.ErrInvalidVotingPeriod.Wrapf("voting period must be positive: %s", m.VotingPeriod)
+	}
+
 	// Check that either metadata or Msgs length is non nil.
 	if len(m.Messages) == 0 && len(m.Metadata) == 0 {
 		return sdkerrors.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil") //nolint:staticcheck
@@ -128,7 +134,7 @@ func (m MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) err
 //
 //nolint:interfacer
 func NewMsgDeposit(depositor sdk.AccAddress, proposalID uint64, amount sdk.Coins) *MsgDeposit {
-	return &MsgDeposit{proposalID, depositor.String(), amount}
+	return &MsgDeposit{ProposalId: proposalID, Depositor: depositor.String(), Amount: amount}
 }
 
 // Route implements the sdk.Msg interface.
@@ -142,6 +148,11 @@ func (msg MsgDeposit) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
 	}
+	if msg.RefundAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid refund address: %s", err)
+		}
+	}
 	amount := sdk.NewCoins(msg.Amount...)
 	if !amount.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String()) //nolint:staticcheck
@@ -169,7 +180,7 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 //
 //nolint:interfacer
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption, metadata string) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), option, metadata}
+	return &MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: option, Metadata: metadata}
 }
 
 // Route implements the sdk.Msg interface.
@@ -206,7 +217,7 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 //
 //nolint:interfacer
 func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions, metadata string) *MsgVoteWeighted {
-	return &MsgVoteWeighted{proposalID, voter.String(), options, metadata}
+	return &MsgVoteWeighted{ProposalId: proposalID, Voter: voter.String(), Options: options, Metadata: metadata}
 }
 
 // Route implements the sdk.Msg interface.
@@ -322,3 +333,174 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{authority}
 }
+
+// NewMsgProposeConstitutionAmendment creates a new MsgProposeConstitutionAmendment instance
+//
+//nolint:interfacer
+func NewMsgProposeConstitutionAmendment(authority sdk.AccAddress, constitution string) *MsgProposeConstitutionAmendment {
+	return &MsgProposeConstitutionAmendment{
+		Authority:    authority.String(),
+		Constitution: constitution,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgProposeConstitutionAmendment) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgProposeConstitutionAmendment) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgProposeConstitutionAmendment) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if msg.Constitution == "" {
+		return types.ErrInvalidConstitution.Wrap("constitution cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgProposeConstitutionAmendment) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgProposeConstitutionAmendment.
+func (msg MsgProposeConstitutionAmendment) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgScheduleRecurringProposal creates a new MsgScheduleRecurringProposal
+// instance
+//
+//nolint:interfacer
+func NewMsgScheduleRecurringProposal(authority sdk.AccAddress, messages []sdk.Msg, metadata, title, summary string, interval time.Duration) (*MsgScheduleRecurringProposal, error) {
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgScheduleRecurringProposal{
+		Authority: authority.String(),
+		Messages:  anys,
+		Metadata:  metadata,
+		Title:     title,
+		Summary:   summary,
+		Interval:  &interval,
+	}, nil
+}
+
+// GetMsgs unpacks msg.Messages Any's into sdk.Msg's
+func (msg MsgScheduleRecurringProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Messages, "sdk.MsgProposal")
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgScheduleRecurringProposal) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgScheduleRecurringProposal) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgScheduleRecurringProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if msg.Title == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal title cannot be empty") //nolint:staticcheck
+	}
+	if msg.Summary == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal summary cannot be empty") //nolint:staticcheck
+	}
+
+	if msg.Interval == nil || msg.Interval.Seconds() <= 0 {
+		return types.ErrInvalidSchedule.Wrap("interval must be positive")
+	}
+
+	if len(msg.Messages) == 0 && len(msg.Metadata) == 0 {
+		return sdkerrors.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil") //nolint:staticcheck
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for idx, m := range msgs {
+		// a recurring proposal can't schedule other recurring proposals
+		if _, ok := m.(*MsgScheduleRecurringProposal); ok {
+			return types.ErrInvalidSchedule.Wrapf("msg: %d, recurring proposals can't be nested", idx)
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidProposalMsg, //nolint:staticcheck
+				fmt.Sprintf("msg: %d, err: %s", idx, err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgScheduleRecurringProposal) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgScheduleRecurringProposal.
+func (msg MsgScheduleRecurringProposal) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgScheduleRecurringProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Messages)
+}
+
+// NewMsgCancelRecurringProposal creates a new MsgCancelRecurringProposal
+// instance
+//
+//nolint:interfacer
+func NewMsgCancelRecurringProposal(authority sdk.AccAddress, recurringProposalID uint64) *MsgCancelRecurringProposal {
+	return &MsgCancelRecurringProposal{
+		Authority:           authority.String(),
+		RecurringProposalId: recurringProposalID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelRecurringProposal) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelRecurringProposal) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelRecurringProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if msg.RecurringProposalId == 0 {
+		return types.ErrScheduleNotFound.Wrap("recurring proposal id can not be 0")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelRecurringProposal) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCancelRecurringProposal.
+func (msg MsgCancelRecurringProposal) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -3,6 +3,7 @@ package v1_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -11,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/atomone-hub/atomone/x/gov/types"
 	v1 "github.com/atomone-hub/atomone/x/gov/types/v1"
 	"github.com/atomone-hub/atomone/x/gov/types/v1beta1"
 )
@@ -44,16 +46,20 @@ func TestMsgDeposit(t *testing.T) {
 		proposalID    uint64
 		depositorAddr sdk.AccAddress
 		depositAmount sdk.Coins
+		refundAddress string
 		expectPass    bool
 	}{
-		{0, addrs[0], coinsPos, true},
-		{1, sdk.AccAddress{}, coinsPos, false},
-		{1, addrs[0], coinsZero, true},
-		{1, addrs[0], coinsMulti, true},
+		{0, addrs[0], coinsPos, "", true},
+		{1, sdk.AccAddress{}, coinsPos, "", false},
+		{1, addrs[0], coinsZero, "", true},
+		{1, addrs[0], coinsMulti, "", true},
+		{1, addrs[0], coinsPos, addrs[1].String(), true},
+		{1, addrs[0], coinsPos, "invalid", false},
 	}
 
 	for i, tc := range tests {
 		msg := v1.NewMsgDeposit(tc.depositorAddr, tc.proposalID, tc.depositAmount)
+		msg.RefundAddress = tc.refundAddress
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
@@ -175,6 +181,74 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitProposal_ValidateBasicVotingPeriod(t *testing.T) {
+	tests := []struct {
+		name         string
+		votingPeriod *time.Duration
+		expErr       bool
+	}{
+		{"no voting period", nil, false},
+		{"positive voting period", durationPtr(time.Hour), false},
+		{"zero voting period", durationPtr(0), true},
+		{"negative voting period", durationPtr(-time.Hour), true},
+	}
+
+	for _, tc := range tests {
+		msg, err := v1.NewMsgSubmitProposal(nil, coinsPos, addrs[0].String(), "metadata", "Title", "Summary")
+		require.NoError(t, err)
+		msg.VotingPeriod = tc.votingPeriod
+		if tc.expErr {
+			require.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidVotingPeriod, "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+}
+
+func TestMsgScheduleRecurringProposal_ValidateBasic(t *testing.T) {
+	msg1, err := v1.NewLegacyContent(v1beta1.NewTextProposal("Title", "description"), addrs[0].String())
+	require.NoError(t, err)
+	nested, err := v1.NewMsgScheduleRecurringProposal(addrs[0], []sdk.Msg{msg1}, "", "Title", "Summary", time.Hour)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		authority sdk.AccAddress
+		messages  []sdk.Msg
+		metadata  string
+		title     string
+		summary   string
+		interval  time.Duration
+		expErr    bool
+	}{
+		{"invalid authority", sdk.AccAddress{}, []sdk.Msg{msg1}, "", "Title", "Summary", time.Hour, true},
+		{"empty msgs and metadata", addrs[0], nil, "", "Title", "Summary", time.Hour, true},
+		{"empty title", addrs[0], []sdk.Msg{msg1}, "", "", "Summary", time.Hour, true},
+		{"empty summary", addrs[0], []sdk.Msg{msg1}, "", "Title", "", time.Hour, true},
+		{"zero interval", addrs[0], []sdk.Msg{msg1}, "", "Title", "Summary", 0, true},
+		{"nested recurring proposal", addrs[0], []sdk.Msg{nested}, "", "Title", "Summary", time.Hour, true},
+		{"valid with no Msg", addrs[0], nil, "metadata", "Title", "Summary", time.Hour, false},
+		{"valid", addrs[0], []sdk.Msg{msg1}, "", "Title", "Summary", time.Hour, false},
+	}
+
+	for _, tc := range tests {
+		msg, err := v1.NewMsgScheduleRecurringProposal(tc.authority, tc.messages, tc.metadata, tc.title, tc.summary, tc.interval)
+		require.NoError(t, err)
+		if tc.expErr {
+			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
+		}
+	}
+
+	require.Error(t, v1.NewMsgCancelRecurringProposal(addrs[0], 0).ValidateBasic())
+	require.NoError(t, v1.NewMsgCancelRecurringProposal(addrs[0], 1).ValidateBasic())
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
+
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
 func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 	testcases := []struct {
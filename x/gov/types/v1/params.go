@@ -4,14 +4,26 @@ import (
 	"fmt"
 	"time"
 
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/gov/types"
 )
 
 // Default period for deposits & voting
 const (
 	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	// zero disables the pruning of completed proposals
+	DefaultProposalRetentionPeriod time.Duration = 0
+	// period over which the community pool spend limit applies
+	DefaultCommunityPoolSpendPeriod time.Duration = time.Hour * 24 * 30 // 30 days
+	// zero bounds disable the voting periods requested by the proposers
+	DefaultMinVotingPeriod time.Duration = 0
+	DefaultMaxVotingPeriod time.Duration = 0
+	// zero disables the review period between the deposit and voting periods
+	DefaultReviewPeriod time.Duration = 0
 )
 
 // Default governance params
@@ -24,6 +36,32 @@ var (
 	DefaultBurnProposalPrevote    = false // set to false to replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteQuorom         = false // set to false to  replicate behavior of when this change was made (0.47)
 	DefaultBurnVoteVeto           = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultProposerBountyRatio    = sdk.ZeroDec()
+	DefaultProposerBounty         = sdk.Coins(nil)
+	// an empty limit disables the community pool spend limit
+	DefaultCommunityPoolSpendLimit = sdk.Coins(nil)
+	// zero disables the limit of proposals in deposit period per proposer
+	DefaultMaxDepositPeriodProposalsPerProposer = uint64(0)
+	// an empty schema disables the validation of the proposal metadata
+	DefaultProposalMetadataSchema = ""
+	// no message type overrides the review period by default
+	DefaultMessageReviewPeriods = []*MessageReviewPeriod(nil)
+	// set to false to keep counting all the bonded stake of the vesting accounts
+	DefaultExcludeUnvestedVotingPower = false
+	// quadratic proposals are disabled by default
+	DefaultQuadraticVotingEnabled = false
+	// a zero cap doesn't limit the quadratic voting power of the voters
+	DefaultQuadraticVotingPowerCap = sdk.ZeroDec()
+	// zero disables the vote locks
+	DefaultMaxVoteLockPeriods = uint32(0)
+	// set to false to keep counting the stake of the jailed validators until
+	// they leave the bonded set
+	DefaultExcludeJailedValidatorsStake = false
+	// the stake of the module accounts counts toward the quorum, as the stake
+	// of a non-voter
+	DefaultModuleAccountStakeRule = ModuleAccountStakeRule_MODULE_ACCOUNT_STAKE_RULE_COUNT
+	// no deposit denom weights accept any denom for the deposits
+	DefaultDepositDenomWeights = []*DepositDenomWeight(nil)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -54,6 +92,13 @@ func NewVotingParams(votingPeriod *time.Duration) VotingParams {
 func NewParams(
 	minDeposit sdk.Coins, maxDepositPeriod, votingPeriod time.Duration,
 	quorum, threshold, vetoThreshold, minInitialDepositRatio string, burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
+	proposalRetentionPeriod time.Duration, proposerBountyRatio string, proposerBounty sdk.Coins,
+	communityPoolSpendLimit sdk.Coins, communityPoolSpendPeriod time.Duration,
+	maxDepositPeriodProposalsPerProposer uint64, minVotingPeriod, maxVotingPeriod time.Duration,
+	proposalMetadataSchema string, reviewPeriod time.Duration, messageReviewPeriods []*MessageReviewPeriod,
+	excludeUnvestedVotingPower, quadraticVotingEnabled bool, quadraticVotingPowerCap string,
+	maxVoteLockPeriods uint32, excludeJailedValidatorsStake bool, moduleAccountStakeRule ModuleAccountStakeRule,
+	depositDenomWeights []*DepositDenomWeight,
 ) Params {
 	return Params{
 		MinDeposit:                 minDeposit,
@@ -66,6 +111,25 @@ func NewParams(
 		BurnProposalDepositPrevote: burnProposalDeposit,
 		BurnVoteQuorum:             burnVoteQuorum,
 		BurnVoteVeto:               burnVoteVeto,
+		ProposalRetentionPeriod:    &proposalRetentionPeriod,
+		ProposerBountyRatio:        proposerBountyRatio,
+		ProposerBounty:             proposerBounty,
+		CommunityPoolSpendLimit:    communityPoolSpendLimit,
+		CommunityPoolSpendPeriod:   &communityPoolSpendPeriod,
+		MinVotingPeriod:            &minVotingPeriod,
+		MaxVotingPeriod:            &maxVotingPeriod,
+		ProposalMetadataSchema:     proposalMetadataSchema,
+		ReviewPeriod:               &reviewPeriod,
+		MessageReviewPeriods:       messageReviewPeriods,
+		ExcludeUnvestedVotingPower: excludeUnvestedVotingPower,
+		QuadraticVotingEnabled:     quadraticVotingEnabled,
+		QuadraticVotingPowerCap:    quadraticVotingPowerCap,
+		MaxVoteLockPeriods:         maxVoteLockPeriods,
+
+		MaxDepositPeriodProposalsPerProposer: maxDepositPeriodProposalsPerProposer,
+		ExcludeJailedValidatorsStake:         excludeJailedValidatorsStake,
+		ModuleAccountStakeRule:               moduleAccountStakeRule,
+		DepositDenomWeights:                  depositDenomWeights,
 	}
 }
 
@@ -82,6 +146,24 @@ func DefaultParams() Params {
 		DefaultBurnProposalPrevote,
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
+		DefaultProposalRetentionPeriod,
+		DefaultProposerBountyRatio.String(),
+		DefaultProposerBounty,
+		DefaultCommunityPoolSpendLimit,
+		DefaultCommunityPoolSpendPeriod,
+		DefaultMaxDepositPeriodProposalsPerProposer,
+		DefaultMinVotingPeriod,
+		DefaultMaxVotingPeriod,
+		DefaultProposalMetadataSchema,
+		DefaultReviewPeriod,
+		DefaultMessageReviewPeriods,
+		DefaultExcludeUnvestedVotingPower,
+		DefaultQuadraticVotingEnabled,
+		DefaultQuadraticVotingPowerCap.String(),
+		DefaultMaxVoteLockPeriods,
+		DefaultExcludeJailedValidatorsStake,
+		DefaultModuleAccountStakeRule,
+		DefaultDepositDenomWeights,
 	)
 }
 
@@ -151,5 +233,171 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("mininum initial deposit ratio of proposal is too large: %s", minInitialDepositRatio)
 	}
 
+	// a nil proposal retention period disables the pruning, like a zero one
+	if p.ProposalRetentionPeriod != nil && p.ProposalRetentionPeriod.Seconds() < 0 {
+		return fmt.Errorf("proposal retention period must not be negative: %s", p.ProposalRetentionPeriod)
+	}
+
+	// an empty proposer bounty ratio disables the funding of the bounty pool,
+	// like a zero one
+	if p.ProposerBountyRatio != "" {
+		proposerBountyRatio, err := math.LegacyNewDecFromStr(p.ProposerBountyRatio)
+		if err != nil {
+			return fmt.Errorf("invalid proposer bounty ratio: %w", err)
+		}
+		if proposerBountyRatio.IsNegative() {
+			return fmt.Errorf("proposer bounty ratio must not be negative: %s", proposerBountyRatio)
+		}
+		if proposerBountyRatio.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("proposer bounty ratio too large: %s", proposerBountyRatio)
+		}
+	}
+
+	if proposerBounty := sdk.Coins(p.ProposerBounty); !proposerBounty.IsValid() {
+		return fmt.Errorf("invalid proposer bounty: %s", proposerBounty)
+	}
+
+	if communityPoolSpendLimit := sdk.Coins(p.CommunityPoolSpendLimit); !communityPoolSpendLimit.IsValid() {
+		return fmt.Errorf("invalid community pool spend limit: %s", communityPoolSpendLimit)
+	}
+
+	// the community pool spend period is only required with a limit
+	if p.CommunityPoolSpendPeriod != nil && p.CommunityPoolSpendPeriod.Seconds() < 0 {
+		return fmt.Errorf("community pool spend period must not be negative: %s", p.CommunityPoolSpendPeriod)
+	}
+	if len(p.CommunityPoolSpendLimit) > 0 && (p.CommunityPoolSpendPeriod == nil || p.CommunityPoolSpendPeriod.Seconds() <= 0) {
+		return fmt.Errorf("community pool spend period must be positive with a community pool spend limit: %s", p.CommunityPoolSpendPeriod)
+	}
+
+	// the requested voting period bounds are only required when enabled
+	if p.MinVotingPeriod != nil && p.MinVotingPeriod.Seconds() < 0 {
+		return fmt.Errorf("min voting period must not be negative: %s", p.MinVotingPeriod)
+	}
+	if p.MaxVotingPeriod != nil && p.MaxVotingPeriod.Seconds() > 0 {
+		if p.MinVotingPeriod == nil || p.MinVotingPeriod.Seconds() <= 0 {
+			return fmt.Errorf("min voting period must be positive with a max voting period: %s", p.MinVotingPeriod)
+		}
+		if *p.MinVotingPeriod > *p.MaxVotingPeriod {
+			return fmt.Errorf("min voting period %s must not be greater than the max voting period %s", p.MinVotingPeriod, p.MaxVotingPeriod)
+		}
+	} else if p.MaxVotingPeriod != nil && p.MaxVotingPeriod.Seconds() < 0 {
+		return fmt.Errorf("max voting period must not be negative: %s", p.MaxVotingPeriod)
+	}
+
+	if p.ProposalMetadataSchema != "" {
+		if err := ValidateMetadataSchema(p.ProposalMetadataSchema); err != nil {
+			return err
+		}
+	}
+
+	if p.ReviewPeriod != nil && p.ReviewPeriod.Seconds() < 0 {
+		return fmt.Errorf("review period must not be negative: %s", p.ReviewPeriod)
+	}
+	// an empty quadratic voting power cap disables the cap, like a zero one
+	if p.QuadraticVotingPowerCap != "" {
+		quadraticVotingPowerCap, err := math.LegacyNewDecFromStr(p.QuadraticVotingPowerCap)
+		if err != nil {
+			return fmt.Errorf("invalid quadratic voting power cap: %w", err)
+		}
+		if quadraticVotingPowerCap.IsNegative() {
+			return fmt.Errorf("quadratic voting power cap must not be negative: %s", quadraticVotingPowerCap)
+		}
+	}
+
+	msgTypeURLs := make(map[string]bool, len(p.MessageReviewPeriods))
+	for _, messageReviewPeriod := range p.MessageReviewPeriods {
+		if messageReviewPeriod.MsgTypeUrl == "" {
+			return fmt.Errorf("message review period type url must not be empty")
+		}
+		if msgTypeURLs[messageReviewPeriod.MsgTypeUrl] {
+			return fmt.Errorf("duplicate message review period for %s", messageReviewPeriod.MsgTypeUrl)
+		}
+		msgTypeURLs[messageReviewPeriod.MsgTypeUrl] = true
+		if messageReviewPeriod.ReviewPeriod == nil || messageReviewPeriod.ReviewPeriod.Seconds() < 0 {
+			return fmt.Errorf("review period of %s must not be negative: %s", messageReviewPeriod.MsgTypeUrl, messageReviewPeriod.ReviewPeriod)
+		}
+	}
+
+	if _, ok := ModuleAccountStakeRule_name[int32(p.ModuleAccountStakeRule)]; !ok {
+		return fmt.Errorf("invalid module account stake rule: %d", p.ModuleAccountStakeRule)
+	}
+
+	weightedDenoms := make(map[string]bool, len(p.DepositDenomWeights))
+	for _, denomWeight := range p.DepositDenomWeights {
+		if err := sdk.ValidateDenom(denomWeight.Denom); err != nil {
+			return fmt.Errorf("invalid deposit denom: %w", err)
+		}
+		if weightedDenoms[denomWeight.Denom] {
+			return fmt.Errorf("duplicate deposit denom weight for %s", denomWeight.Denom)
+		}
+		weightedDenoms[denomWeight.Denom] = true
+		weight, err := math.LegacyNewDecFromStr(denomWeight.Weight)
+		if err != nil {
+			return fmt.Errorf("invalid weight of deposit denom %s: %w", denomWeight.Denom, err)
+		}
+		if !weight.IsPositive() {
+			return fmt.Errorf("weight of deposit denom %s must be positive: %s", denomWeight.Denom, weight)
+		}
+	}
+	// the min deposit must be reachable with the accepted denoms
+	if len(weightedDenoms) > 0 {
+		for _, coin := range p.MinDeposit {
+			if !weightedDenoms[coin.Denom] {
+				return fmt.Errorf("min deposit denom %s has no deposit denom weight", coin.Denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+// DepositValue returns the value of coins deposited: the sum of their amounts
+// weighted by the deposit denom weights. The denoms without weight are worth
+// nothing.
+func (p Params) DepositValue(coins sdk.Coins) sdk.Dec {
+	value := math.LegacyZeroDec()
+	for _, denomWeight := range p.DepositDenomWeights {
+		amount := coins.AmountOf(denomWeight.Denom)
+		if amount.IsZero() {
+			continue
+		}
+		weight, err := math.LegacyNewDecFromStr(denomWeight.Weight)
+		if err != nil {
+			// validated with the params
+			panic(err)
+		}
+		value = value.Add(weight.MulInt(amount))
+	}
+	return value
+}
+
+// IsMinDepositReached returns true if the deposit reaches the min deposit. With
+// deposit denom weights, the value of the deposit must reach the value of the
+// min deposit, otherwise each denom of the min deposit must be reached.
+func (p Params) IsMinDepositReached(deposit sdk.Coins) bool {
+	if len(p.DepositDenomWeights) == 0 {
+		return deposit.IsAllGTE(p.MinDeposit)
+	}
+	return p.DepositValue(deposit).GTE(p.DepositValue(p.MinDeposit))
+}
+
+// ValidateDepositDenoms returns an error if the deposit holds a denom without
+// deposit denom weight, unless no weight is set.
+func (p Params) ValidateDepositDenoms(deposit sdk.Coins) error {
+	if len(p.DepositDenomWeights) == 0 {
+		return nil
+	}
+	for _, coin := range deposit {
+		accepted := false
+		for _, denomWeight := range p.DepositDenomWeights {
+			if denomWeight.Denom == coin.Denom {
+				accepted = true
+				break
+			}
+		}
+		if !accepted {
+			return sdkerrors.Wrapf(types.ErrInvalidDepositDenom, "%s is not accepted for the deposits", coin.Denom)
+		}
+	}
 	return nil
 }
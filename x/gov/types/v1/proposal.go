@@ -20,6 +20,8 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusQueued        = ProposalStatus_PROPOSAL_STATUS_QUEUED
+	StatusReviewPeriod  = ProposalStatus_PROPOSAL_STATUS_REVIEW_PERIOD
 )
 
 // NewProposal creates a new Proposal instance
@@ -115,7 +117,9 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusQueued ||
+		status == StatusReviewPeriod {
 		return true
 	}
 	return false
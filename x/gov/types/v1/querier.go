@@ -1,6 +1,7 @@
 package v1
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,6 +23,15 @@ const (
 	ParamTallying = "tallying"
 )
 
+// orders of the Query/Proposals results
+const (
+	OrderByUnspecified   = ProposalsOrderBy_PROPOSALS_ORDER_BY_UNSPECIFIED
+	OrderByIDAsc         = ProposalsOrderBy_PROPOSALS_ORDER_BY_ID_ASC
+	OrderByIDDesc        = ProposalsOrderBy_PROPOSALS_ORDER_BY_ID_DESC
+	OrderByVotingEndTime = ProposalsOrderBy_PROPOSALS_ORDER_BY_VOTING_END_TIME
+	OrderByTotalDeposit  = ProposalsOrderBy_PROPOSALS_ORDER_BY_TOTAL_DEPOSIT
+)
+
 // QueryProposalParams is used for queries:
 // - 'custom/gov/proposal'
 // - 'custom/gov/deposits'
@@ -100,3 +110,13 @@ func NewQueryProposalsParams(page, limit int, status ProposalStatus, voter, depo
 		ProposalStatus: status,
 	}
 }
+
+var _ codectypes.UnpackInterfacesMessage = QueryValidateProposalRequest{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (req QueryValidateProposalRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if req.Proposal == nil {
+		return nil
+	}
+	return req.Proposal.UnpackInterfaces(unpacker)
+}
@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ types.UnpackInterfacesMessage = RecurringProposal{}
+
+// GetMsgs unpacks the recurring proposal messages Any's into sdk.Msg's
+func (p RecurringProposal) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p RecurringProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, p.Messages)
+}
+
+// ValidateBasic performs a stateless validation of the recurring proposal.
+func (p RecurringProposal) ValidateBasic() error {
+	if p.Id == 0 {
+		return fmt.Errorf("recurring proposal id must be greater than 0")
+	}
+	if p.Interval == nil || p.Interval.Seconds() <= 0 {
+		return fmt.Errorf("recurring proposal %d interval must be positive", p.Id)
+	}
+	if p.NextSubmitTime == nil {
+		return fmt.Errorf("recurring proposal %d next submit time must not be nil", p.Id)
+	}
+	return nil
+}
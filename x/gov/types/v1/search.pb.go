@@ -0,0 +1,939 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: atomone/gov/v1/search.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SearchProposalsRequest is the request type for the Search/Proposals RPC
+// method.
+type SearchProposalsRequest struct {
+	// query defines the words to search for in the proposal titles and
+	// summaries.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// limit defines the maximum number of results returned. Defaults to 20 if
+	// unset, and is capped at 100.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *SearchProposalsRequest) Reset()         { *m = SearchProposalsRequest{} }
+func (m *SearchProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchProposalsRequest) ProtoMessage()    {}
+func (*SearchProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b8afd9646d6a263, []int{0}
+}
+func (m *SearchProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchProposalsRequest.Merge(m, src)
+}
+func (m *SearchProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchProposalsRequest proto.InternalMessageInfo
+
+func (m *SearchProposalsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchProposalsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// SearchProposalsResponse is the response type for the Search/Proposals RPC
+// method.
+type SearchProposalsResponse struct {
+	// results defines the matching proposals, the most relevant first.
+	Results []*ProposalSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *SearchProposalsResponse) Reset()         { *m = SearchProposalsResponse{} }
+func (m *SearchProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchProposalsResponse) ProtoMessage()    {}
+func (*SearchProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b8afd9646d6a263, []int{1}
+}
+func (m *SearchProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchProposalsResponse.Merge(m, src)
+}
+func (m *SearchProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchProposalsResponse proto.InternalMessageInfo
+
+func (m *SearchProposalsResponse) GetResults() []*ProposalSearchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// ProposalSearchResult defines a proposal matching a search query.
+type ProposalSearchResult struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is a short summary of the proposal.
+	Summary string `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	// score defines the relevance of the proposal for the query, the higher the
+	// more relevant.
+	Score uint64 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (m *ProposalSearchResult) Reset()         { *m = ProposalSearchResult{} }
+func (m *ProposalSearchResult) String() string { return proto.CompactTextString(m) }
+func (*ProposalSearchResult) ProtoMessage()    {}
+func (*ProposalSearchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b8afd9646d6a263, []int{2}
+}
+func (m *ProposalSearchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalSearchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalSearchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalSearchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalSearchResult.Merge(m, src)
+}
+func (m *ProposalSearchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalSearchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalSearchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalSearchResult proto.InternalMessageInfo
+
+func (m *ProposalSearchResult) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalSearchResult) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ProposalSearchResult) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *ProposalSearchResult) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SearchProposalsRequest)(nil), "atomone.gov.v1.SearchProposalsRequest")
+	proto.RegisterType((*SearchProposalsResponse)(nil), "atomone.gov.v1.SearchProposalsResponse")
+	proto.RegisterType((*ProposalSearchResult)(nil), "atomone.gov.v1.ProposalSearchResult")
+}
+
+func init() { proto.RegisterFile("atomone/gov/v1/search.proto", fileDescriptor_4b8afd9646d6a263) }
+
+var fileDescriptor_4b8afd9646d6a263 = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x31, 0x4f, 0xe3, 0x30,
+	0x18, 0xad, 0xdb, 0x5e, 0xab, 0xba, 0xba, 0x1b, 0xac, 0xea, 0x2e, 0xea, 0xa1, 0x10, 0x45, 0x08,
+	0xb2, 0x34, 0x56, 0xcb, 0xce, 0x80, 0x90, 0x10, 0x1b, 0x0a, 0x13, 0x2c, 0x28, 0x6d, 0xad, 0xd4,
+	0x52, 0x92, 0x2f, 0xb5, 0x9d, 0x88, 0x0e, 0x2c, 0x0c, 0xcc, 0x20, 0xfe, 0x14, 0x63, 0x25, 0x16,
+	0x46, 0xd4, 0xf2, 0x43, 0x50, 0xed, 0xa6, 0x12, 0x50, 0x89, 0xf1, 0x3d, 0xbf, 0xef, 0xf9, 0xbd,
+	0xcf, 0xc6, 0xff, 0x43, 0x05, 0x09, 0xa4, 0x8c, 0x46, 0x50, 0xd0, 0xa2, 0x4f, 0x25, 0x0b, 0xc5,
+	0x68, 0xe2, 0x67, 0x02, 0x14, 0x90, 0x3f, 0xeb, 0x43, 0x3f, 0x82, 0xc2, 0x2f, 0xfa, 0xdd, 0x9d,
+	0x08, 0x20, 0x8a, 0x19, 0x0d, 0x33, 0x4e, 0xc3, 0x34, 0x05, 0x15, 0x2a, 0x0e, 0xa9, 0x34, 0x6a,
+	0xf7, 0x04, 0xff, 0xbd, 0xd0, 0xd3, 0xe7, 0x02, 0x32, 0x90, 0x61, 0x2c, 0x03, 0x36, 0xcd, 0x99,
+	0x54, 0xa4, 0x83, 0x7f, 0x4d, 0x73, 0x26, 0x66, 0x16, 0x72, 0x90, 0xd7, 0x0a, 0x0c, 0x58, 0xb1,
+	0x31, 0x4f, 0xb8, 0xb2, 0xaa, 0x0e, 0xf2, 0x7e, 0x07, 0x06, 0xb8, 0x97, 0xf8, 0xdf, 0x37, 0x17,
+	0x99, 0x41, 0x2a, 0x19, 0x39, 0xc2, 0x4d, 0xc1, 0x64, 0x1e, 0x2b, 0x69, 0x21, 0xa7, 0xe6, 0xb5,
+	0x07, 0x7b, 0xfe, 0xe7, 0x80, 0x7e, 0x39, 0x63, 0x1c, 0x02, 0x2d, 0x0e, 0xca, 0x21, 0xf7, 0x16,
+	0x77, 0xb6, 0x09, 0xc8, 0x2e, 0x6e, 0x67, 0x6b, 0xfe, 0x9a, 0x8f, 0x75, 0xc8, 0x7a, 0x80, 0x4b,
+	0xea, 0x6c, 0xbc, 0x4a, 0xaa, 0xb8, 0x8a, 0x99, 0x4e, 0xda, 0x0a, 0x0c, 0x20, 0x16, 0x6e, 0xca,
+	0x3c, 0x49, 0x42, 0x31, 0xb3, 0x6a, 0x9a, 0x2f, 0xe1, 0x4a, 0x2f, 0x47, 0x20, 0x98, 0x55, 0xd7,
+	0x56, 0x06, 0x0c, 0x1e, 0x11, 0x6e, 0x98, 0x7b, 0xc9, 0x3d, 0xc2, 0xad, 0x4d, 0x3f, 0xb2, 0xff,
+	0xb5, 0xc6, 0xf6, 0x35, 0x76, 0x0f, 0x7e, 0xd4, 0x99, 0x45, 0xb9, 0xde, 0xdd, 0xcb, 0xfb, 0x53,
+	0xd5, 0x25, 0x0e, 0xdd, 0xfa, 0xba, 0xb4, 0xac, 0x26, 0x8f, 0x4f, 0x9f, 0x17, 0x36, 0x9a, 0x2f,
+	0x6c, 0xf4, 0xb6, 0xb0, 0xd1, 0xc3, 0xd2, 0xae, 0xcc, 0x97, 0x76, 0xe5, 0x75, 0x69, 0x57, 0xae,
+	0x7a, 0x11, 0x57, 0x93, 0x7c, 0xe8, 0x8f, 0x20, 0x29, 0x5d, 0x7a, 0x93, 0x7c, 0xb8, 0x71, 0xbc,
+	0xd1, 0x9e, 0x6a, 0x96, 0x31, 0x49, 0x8b, 0xfe, 0xb0, 0xa1, 0xff, 0xc0, 0xe1, 0xc7, 0x00, 0x70,
+	0x58, 0x10, 0x4b, 0x50, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SearchClient is the client API for Search service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SearchClient interface {
+	// Proposals returns the proposals whose title or summary match the query,
+	// tolerating typos and partial words, ordered by relevance.
+	Proposals(ctx context.Context, in *SearchProposalsRequest, opts ...grpc.CallOption) (*SearchProposalsResponse, error)
+}
+
+type searchClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewSearchClient(cc grpc1.ClientConn) SearchClient {
+	return &searchClient{cc}
+}
+
+func (c *searchClient) Proposals(ctx context.Context, in *SearchProposalsRequest, opts ...grpc.CallOption) (*SearchProposalsResponse, error) {
+	out := new(SearchProposalsResponse)
+	err := c.cc.Invoke(ctx, "/atomone.gov.v1.Search/Proposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchServer is the server API for Search service.
+type SearchServer interface {
+	// Proposals returns the proposals whose title or summary match the query,
+	// tolerating typos and partial words, ordered by relevance.
+	Proposals(context.Context, *SearchProposalsRequest) (*SearchProposalsResponse, error)
+}
+
+// UnimplementedSearchServer can be embedded to have forward compatible implementations.
+type UnimplementedSearchServer struct {
+}
+
+func (*UnimplementedSearchServer) Proposals(ctx context.Context, req *SearchProposalsRequest) (*SearchProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+
+func RegisterSearchServer(s grpc1.Server, srv SearchServer) {
+	s.RegisterService(&_Search_serviceDesc, srv)
+}
+
+func _Search_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).Proposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.gov.v1.Search/Proposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).Proposals(ctx, req.(*SearchProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Search_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomone.gov.v1.Search",
+	HandlerType: (*SearchServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Proposals",
+			Handler:    _Search_Proposals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "atomone/gov/v1/search.proto",
+}
+
+func (m *SearchProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintSearch(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintSearch(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSearch(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalSearchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalSearchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalSearchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Score != 0 {
+		i = encodeVarintSearch(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintSearch(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintSearch(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintSearch(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSearch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSearch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SearchProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovSearch(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovSearch(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *SearchProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovSearch(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalSearchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovSearch(uint64(m.ProposalId))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovSearch(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovSearch(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovSearch(uint64(m.Score))
+	}
+	return n
+}
+
+func sovSearch(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSearch(x uint64) (n int) {
+	return sovSearch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SearchProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSearch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSearch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSearch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSearch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSearch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &ProposalSearchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSearch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSearch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalSearchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSearch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalSearchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalSearchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSearch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSearch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSearch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSearch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSearch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSearch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSearch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSearch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSearch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSearch
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSearch
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSearch
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSearch        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSearch          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSearch = fmt.Errorf("proto: unexpected end of group")
+)
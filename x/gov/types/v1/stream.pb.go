@@ -0,0 +1,651 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: atomone/gov/v1/stream.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StreamGovernanceEventsRequest is the request type for the
+// Stream/GovernanceEvents RPC method.
+type StreamGovernanceEventsRequest struct {
+	// proposal_id restricts the stream to the events of the given proposal, if
+	// set.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *StreamGovernanceEventsRequest) Reset()         { *m = StreamGovernanceEventsRequest{} }
+func (m *StreamGovernanceEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamGovernanceEventsRequest) ProtoMessage()    {}
+func (*StreamGovernanceEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e04fd8f34e1ba8a, []int{0}
+}
+func (m *StreamGovernanceEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamGovernanceEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamGovernanceEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamGovernanceEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamGovernanceEventsRequest.Merge(m, src)
+}
+func (m *StreamGovernanceEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamGovernanceEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamGovernanceEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamGovernanceEventsRequest proto.InternalMessageInfo
+
+func (m *StreamGovernanceEventsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// StreamGovernanceEventsResponse is the response type for the
+// Stream/GovernanceEvents RPC method. One response is sent for each committed
+// block containing matching governance events.
+type StreamGovernanceEventsResponse struct {
+	// height is the height of the block the events were emitted in.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// events defines the governance events emitted in the block.
+	Events []types.StringEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events"`
+}
+
+func (m *StreamGovernanceEventsResponse) Reset()         { *m = StreamGovernanceEventsResponse{} }
+func (m *StreamGovernanceEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamGovernanceEventsResponse) ProtoMessage()    {}
+func (*StreamGovernanceEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e04fd8f34e1ba8a, []int{1}
+}
+func (m *StreamGovernanceEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamGovernanceEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamGovernanceEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamGovernanceEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamGovernanceEventsResponse.Merge(m, src)
+}
+func (m *StreamGovernanceEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamGovernanceEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamGovernanceEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamGovernanceEventsResponse proto.InternalMessageInfo
+
+func (m *StreamGovernanceEventsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StreamGovernanceEventsResponse) GetEvents() []types.StringEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StreamGovernanceEventsRequest)(nil), "atomone.gov.v1.StreamGovernanceEventsRequest")
+	proto.RegisterType((*StreamGovernanceEventsResponse)(nil), "atomone.gov.v1.StreamGovernanceEventsResponse")
+}
+
+func init() { proto.RegisterFile("atomone/gov/v1/stream.proto", fileDescriptor_4e04fd8f34e1ba8a) }
+
+var fileDescriptor_4e04fd8f34e1ba8a = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0xfb, 0x40,
+	0x10, 0xc6, 0x93, 0x7f, 0x4b, 0x0e, 0x5b, 0xf8, 0x23, 0x41, 0xa4, 0x54, 0xdc, 0x96, 0x8a, 0xd0,
+	0x4b, 0x77, 0x4d, 0x7d, 0x01, 0xa9, 0x48, 0xf1, 0x9a, 0xde, 0xbc, 0xc8, 0x26, 0x1d, 0x36, 0x01,
+	0xb3, 0x13, 0xb3, 0xdb, 0x45, 0x0f, 0x7d, 0x07, 0x1f, 0xab, 0xc7, 0x1e, 0x3d, 0x89, 0xb4, 0x2f,
+	0x22, 0xdd, 0xa4, 0x07, 0x05, 0xc5, 0xdb, 0x4c, 0xe6, 0x9b, 0x2f, 0xbf, 0xd9, 0x8f, 0x9c, 0x0a,
+	0x83, 0x05, 0x2a, 0xe0, 0x12, 0x2d, 0xb7, 0x11, 0xd7, 0xa6, 0x02, 0x51, 0xb0, 0xb2, 0x42, 0x83,
+	0xe1, 0xff, 0x66, 0xc8, 0x24, 0x5a, 0x66, 0xa3, 0xde, 0xb1, 0x44, 0x89, 0x6e, 0xc4, 0xf7, 0x55,
+	0xad, 0xea, 0x9d, 0xa7, 0xa8, 0x0b, 0xd4, 0x3c, 0x11, 0x1a, 0xb8, 0x48, 0xd2, 0x9c, 0xdb, 0x28,
+	0x01, 0x23, 0x22, 0xd7, 0xd4, 0xa2, 0xe1, 0x35, 0x39, 0x9b, 0x3b, 0xeb, 0x19, 0x5a, 0xa8, 0x94,
+	0x50, 0x29, 0xdc, 0x5a, 0x50, 0x46, 0xc7, 0xf0, 0xb4, 0x04, 0x6d, 0xc2, 0x3e, 0xe9, 0x94, 0x15,
+	0x96, 0xa8, 0xc5, 0xe3, 0x43, 0xbe, 0xe8, 0xfa, 0x03, 0x7f, 0xd4, 0x8e, 0xc9, 0xe1, 0xd3, 0xdd,
+	0x62, 0xb8, 0x22, 0xf4, 0x27, 0x07, 0x5d, 0xa2, 0xd2, 0x10, 0x9e, 0x90, 0x20, 0x83, 0x5c, 0x66,
+	0xc6, 0x6d, 0xb7, 0xe2, 0xa6, 0x0b, 0x6f, 0x48, 0x00, 0x4e, 0xd9, 0xfd, 0x37, 0x68, 0x8d, 0x3a,
+	0x93, 0x0b, 0x56, 0x13, 0xb3, 0x3d, 0x31, 0x73, 0x90, 0x0d, 0x31, 0x9b, 0x9b, 0x2a, 0x57, 0xd2,
+	0xf9, 0x4e, 0xdb, 0xeb, 0xf7, 0xbe, 0x17, 0x37, 0xab, 0x93, 0x15, 0x09, 0xea, 0xdf, 0x87, 0x9a,
+	0x1c, 0x7d, 0x47, 0x08, 0xc7, 0xec, 0xeb, 0x53, 0xb1, 0x5f, 0x8f, 0xed, 0xb1, 0xbf, 0xca, 0xeb,
+	0xcb, 0x2e, 0xfd, 0xe9, 0x6c, 0xbd, 0xa5, 0xfe, 0x66, 0x4b, 0xfd, 0x8f, 0x2d, 0xf5, 0x5f, 0x77,
+	0xd4, 0xdb, 0xec, 0xa8, 0xf7, 0xb6, 0xa3, 0xde, 0xfd, 0x58, 0xe6, 0x26, 0x5b, 0x26, 0x2c, 0xc5,
+	0x82, 0x37, 0xae, 0xe3, 0x6c, 0x99, 0x1c, 0x6a, 0xfe, 0xec, 0xa2, 0x35, 0x2f, 0x25, 0xe8, 0x7d,
+	0x30, 0x81, 0xcb, 0xe3, 0xea, 0x73, 0x00, 0x55, 0x96, 0xfd, 0x12, 0xf9, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamClient interface {
+	// GovernanceEvents streams the governance events emitted in each committed
+	// block: proposal submissions, deposits, votes and proposal status changes.
+	GovernanceEvents(ctx context.Context, in *StreamGovernanceEventsRequest, opts ...grpc.CallOption) (Stream_GovernanceEventsClient, error)
+}
+
+type streamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamClient(cc grpc1.ClientConn) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) GovernanceEvents(ctx context.Context, in *StreamGovernanceEventsRequest, opts ...grpc.CallOption) (Stream_GovernanceEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Stream_serviceDesc.Streams[0], "/atomone.gov.v1.Stream/GovernanceEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamGovernanceEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stream_GovernanceEventsClient interface {
+	Recv() (*StreamGovernanceEventsResponse, error)
+	grpc.ClientStream
+}
+
+type streamGovernanceEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamGovernanceEventsClient) Recv() (*StreamGovernanceEventsResponse, error) {
+	m := new(StreamGovernanceEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+type StreamServer interface {
+	// GovernanceEvents streams the governance events emitted in each committed
+	// block: proposal submissions, deposits, votes and proposal status changes.
+	GovernanceEvents(*StreamGovernanceEventsRequest, Stream_GovernanceEventsServer) error
+}
+
+// UnimplementedStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (*UnimplementedStreamServer) GovernanceEvents(req *StreamGovernanceEventsRequest, srv Stream_GovernanceEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GovernanceEvents not implemented")
+}
+
+func RegisterStreamServer(s grpc1.Server, srv StreamServer) {
+	s.RegisterService(&_Stream_serviceDesc, srv)
+}
+
+func _Stream_GovernanceEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamGovernanceEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServer).GovernanceEvents(m, &streamGovernanceEventsServer{stream})
+}
+
+type Stream_GovernanceEventsServer interface {
+	Send(*StreamGovernanceEventsResponse) error
+	grpc.ServerStream
+}
+
+type streamGovernanceEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamGovernanceEventsServer) Send(m *StreamGovernanceEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Stream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomone.gov.v1.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GovernanceEvents",
+			Handler:       _Stream_GovernanceEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "atomone/gov/v1/stream.proto",
+}
+
+func (m *StreamGovernanceEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamGovernanceEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamGovernanceEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamGovernanceEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamGovernanceEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamGovernanceEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamGovernanceEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovStream(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *StreamGovernanceEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStream(uint64(m.Height))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamGovernanceEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamGovernanceEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamGovernanceEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamGovernanceEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamGovernanceEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamGovernanceEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types.StringEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
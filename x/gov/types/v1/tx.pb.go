@@ -14,18 +14,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -52,6 +56,13 @@ type MsgSubmitProposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	// voting_period is the voting period requested for the proposal, within the
+	// min_voting_period and max_voting_period params. When empty, the
+	// voting_period param applies.
+	VotingPeriod *time.Duration `protobuf:"bytes,7,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	// quadratic requests a quadratic tally of the proposal, allowed if the
+	// quadratic_voting_enabled param is set.
+	Quadratic bool `protobuf:"varint,8,opt,name=quadratic,proto3" json:"quadratic,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -129,6 +140,20 @@ func (m *MsgSubmitProposal) GetSummary() string {
 	return ""
 }
 
+func (m *MsgSubmitProposal) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return nil
+}
+
+func (m *MsgSubmitProposal) GetQuadratic() bool {
+	if m != nil {
+		return m.Quadratic
+	}
+	return false
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -278,6 +303,9 @@ type MsgVote struct {
 	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=atomone.gov.v1.VoteOption" json:"option,omitempty"`
 	// metadata is any arbitrary metadata attached to the Vote.
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// lock_periods is the number of unbonding periods the voter locks its stake
+	// for, up to the max_vote_lock_periods param.
+	LockPeriods uint32 `protobuf:"varint,5,opt,name=lock_periods,json=lockPeriods,proto3" json:"lock_periods,omitempty"`
 }
 
 func (m *MsgVote) Reset()         { *m = MsgVote{} }
@@ -341,6 +369,13 @@ func (m *MsgVote) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgVote) GetLockPeriods() uint32 {
+	if m != nil {
+		return m.LockPeriods
+	}
+	return 0
+}
+
 // MsgVoteResponse defines the Msg/Vote response type.
 type MsgVoteResponse struct {
 }
@@ -388,6 +423,9 @@ type MsgVoteWeighted struct {
 	Options []*WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
 	// metadata is any arbitrary metadata attached to the VoteWeighted.
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// lock_periods is the number of unbonding periods the voter locks its stake
+	// for, up to the max_vote_lock_periods param.
+	LockPeriods uint32 `protobuf:"varint,5,opt,name=lock_periods,json=lockPeriods,proto3" json:"lock_periods,omitempty"`
 }
 
 func (m *MsgVoteWeighted) Reset()         { *m = MsgVoteWeighted{} }
@@ -451,6 +489,13 @@ func (m *MsgVoteWeighted) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgVoteWeighted) GetLockPeriods() uint32 {
+	if m != nil {
+		return m.LockPeriods
+	}
+	return 0
+}
+
 // MsgVoteWeightedResponse defines the Msg/VoteWeighted response type.
 type MsgVoteWeightedResponse struct {
 }
@@ -496,6 +541,10 @@ type MsgDeposit struct {
 	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	// amount to be deposited by depositor.
 	Amount []types1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+	// refund_address defines the address the deposits of the depositor on the
+	// proposal are refunded to, instead of the depositor, if set. It replaces
+	// the refund address of the former deposits of the depositor.
+	RefundAddress string `protobuf:"bytes,4,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 }
 
 func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
@@ -552,6 +601,13 @@ func (m *MsgDeposit) GetAmount() []types1.Coin {
 	return nil
 }
 
+func (m *MsgDeposit) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
 // MsgDepositResponse defines the Msg/Deposit response type.
 type MsgDepositResponse struct {
 }
@@ -688,6 +744,335 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgProposeConstitutionAmendment is the Msg/ProposeConstitutionAmendment
+// request type.
+type MsgProposeConstitutionAmendment struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// constitution is the full text of the amended constitution.
+	Constitution string `protobuf:"bytes,2,opt,name=constitution,proto3" json:"constitution,omitempty"`
+}
+
+func (m *MsgProposeConstitutionAmendment) Reset()         { *m = MsgProposeConstitutionAmendment{} }
+func (m *MsgProposeConstitutionAmendment) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeConstitutionAmendment) ProtoMessage()    {}
+func (*MsgProposeConstitutionAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{12}
+}
+func (m *MsgProposeConstitutionAmendment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeConstitutionAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeConstitutionAmendment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeConstitutionAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeConstitutionAmendment.Merge(m, src)
+}
+func (m *MsgProposeConstitutionAmendment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeConstitutionAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeConstitutionAmendment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeConstitutionAmendment proto.InternalMessageInfo
+
+func (m *MsgProposeConstitutionAmendment) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgProposeConstitutionAmendment) GetConstitution() string {
+	if m != nil {
+		return m.Constitution
+	}
+	return ""
+}
+
+// MsgProposeConstitutionAmendmentResponse defines the response structure for
+// executing a MsgProposeConstitutionAmendment message.
+type MsgProposeConstitutionAmendmentResponse struct {
+}
+
+func (m *MsgProposeConstitutionAmendmentResponse) Reset() {
+	*m = MsgProposeConstitutionAmendmentResponse{}
+}
+func (m *MsgProposeConstitutionAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeConstitutionAmendmentResponse) ProtoMessage()    {}
+func (*MsgProposeConstitutionAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{13}
+}
+func (m *MsgProposeConstitutionAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeConstitutionAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeConstitutionAmendmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeConstitutionAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeConstitutionAmendmentResponse.Merge(m, src)
+}
+func (m *MsgProposeConstitutionAmendmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeConstitutionAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeConstitutionAmendmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeConstitutionAmendmentResponse proto.InternalMessageInfo
+
+// MsgScheduleRecurringProposal is the Msg/ScheduleRecurringProposal request
+// type.
+type MsgScheduleRecurringProposal struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// messages are the arbitrary messages of the submitted proposals.
+	Messages []*types.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// metadata is any arbitrary metadata attached to the submitted proposals.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the submitted proposals.
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the summary of the submitted proposals.
+	Summary string `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	// interval is the duration between two submissions, the first proposal is
+	// submitted one interval after the scheduling.
+	Interval *time.Duration `protobuf:"bytes,6,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+}
+
+func (m *MsgScheduleRecurringProposal) Reset()         { *m = MsgScheduleRecurringProposal{} }
+func (m *MsgScheduleRecurringProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleRecurringProposal) ProtoMessage()    {}
+func (*MsgScheduleRecurringProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{14}
+}
+func (m *MsgScheduleRecurringProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleRecurringProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleRecurringProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleRecurringProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleRecurringProposal.Merge(m, src)
+}
+func (m *MsgScheduleRecurringProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleRecurringProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleRecurringProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleRecurringProposal proto.InternalMessageInfo
+
+func (m *MsgScheduleRecurringProposal) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleRecurringProposal) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *MsgScheduleRecurringProposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgScheduleRecurringProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *MsgScheduleRecurringProposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *MsgScheduleRecurringProposal) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+// MsgScheduleRecurringProposalResponse defines the response structure for
+// executing a MsgScheduleRecurringProposal message.
+type MsgScheduleRecurringProposalResponse struct {
+	// recurring_proposal_id defines the unique id of the recurring proposal.
+	RecurringProposalId uint64 `protobuf:"varint,1,opt,name=recurring_proposal_id,json=recurringProposalId,proto3" json:"recurring_proposal_id,omitempty"`
+}
+
+func (m *MsgScheduleRecurringProposalResponse) Reset()         { *m = MsgScheduleRecurringProposalResponse{} }
+func (m *MsgScheduleRecurringProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleRecurringProposalResponse) ProtoMessage()    {}
+func (*MsgScheduleRecurringProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{15}
+}
+func (m *MsgScheduleRecurringProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleRecurringProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleRecurringProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleRecurringProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleRecurringProposalResponse.Merge(m, src)
+}
+func (m *MsgScheduleRecurringProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleRecurringProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleRecurringProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleRecurringProposalResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleRecurringProposalResponse) GetRecurringProposalId() uint64 {
+	if m != nil {
+		return m.RecurringProposalId
+	}
+	return 0
+}
+
+// MsgCancelRecurringProposal is the Msg/CancelRecurringProposal request type.
+type MsgCancelRecurringProposal struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// recurring_proposal_id defines the unique id of the recurring proposal.
+	RecurringProposalId uint64 `protobuf:"varint,2,opt,name=recurring_proposal_id,json=recurringProposalId,proto3" json:"recurring_proposal_id,omitempty"`
+}
+
+func (m *MsgCancelRecurringProposal) Reset()         { *m = MsgCancelRecurringProposal{} }
+func (m *MsgCancelRecurringProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecurringProposal) ProtoMessage()    {}
+func (*MsgCancelRecurringProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{16}
+}
+func (m *MsgCancelRecurringProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecurringProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecurringProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecurringProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecurringProposal.Merge(m, src)
+}
+func (m *MsgCancelRecurringProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecurringProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecurringProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecurringProposal proto.InternalMessageInfo
+
+func (m *MsgCancelRecurringProposal) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelRecurringProposal) GetRecurringProposalId() uint64 {
+	if m != nil {
+		return m.RecurringProposalId
+	}
+	return 0
+}
+
+// MsgCancelRecurringProposalResponse defines the response structure for
+// executing a MsgCancelRecurringProposal message.
+type MsgCancelRecurringProposalResponse struct {
+}
+
+func (m *MsgCancelRecurringProposalResponse) Reset()         { *m = MsgCancelRecurringProposalResponse{} }
+func (m *MsgCancelRecurringProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecurringProposalResponse) ProtoMessage()    {}
+func (*MsgCancelRecurringProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6c84786701fca8d, []int{17}
+}
+func (m *MsgCancelRecurringProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecurringProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecurringProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecurringProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecurringProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelRecurringProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecurringProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecurringProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecurringProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "atomone.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "atomone.gov.v1.MsgSubmitProposalResponse")
@@ -701,69 +1086,97 @@ func init() {
 	proto.RegisterType((*MsgDepositResponse)(nil), "atomone.gov.v1.MsgDepositResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "atomone.gov.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "atomone.gov.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgProposeConstitutionAmendment)(nil), "atomone.gov.v1.MsgProposeConstitutionAmendment")
+	proto.RegisterType((*MsgProposeConstitutionAmendmentResponse)(nil), "atomone.gov.v1.MsgProposeConstitutionAmendmentResponse")
+	proto.RegisterType((*MsgScheduleRecurringProposal)(nil), "atomone.gov.v1.MsgScheduleRecurringProposal")
+	proto.RegisterType((*MsgScheduleRecurringProposalResponse)(nil), "atomone.gov.v1.MsgScheduleRecurringProposalResponse")
+	proto.RegisterType((*MsgCancelRecurringProposal)(nil), "atomone.gov.v1.MsgCancelRecurringProposal")
+	proto.RegisterType((*MsgCancelRecurringProposalResponse)(nil), "atomone.gov.v1.MsgCancelRecurringProposalResponse")
 }
 
 func init() { proto.RegisterFile("atomone/gov/v1/tx.proto", fileDescriptor_f6c84786701fca8d) }
 
 var fileDescriptor_f6c84786701fca8d = []byte{
-	// 912 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
-	0x18, 0xae, 0xfb, 0x91, 0x6c, 0xa7, 0xa8, 0xab, 0x8e, 0x02, 0x75, 0x4d, 0x95, 0x74, 0x2d, 0x24,
-	0xba, 0x15, 0xb5, 0x49, 0x16, 0x90, 0x88, 0x7a, 0x60, 0xb3, 0x20, 0xb4, 0x12, 0xd1, 0xae, 0xbc,
-	0xe2, 0x43, 0x1c, 0xa8, 0x26, 0xf1, 0x30, 0xb5, 0x54, 0x7b, 0x2c, 0xcf, 0x24, 0x6a, 0x6e, 0x88,
-	0x23, 0x27, 0x4e, 0xfc, 0x06, 0x8e, 0x3d, 0xec, 0x65, 0xff, 0x00, 0x5a, 0x71, 0x5a, 0x71, 0xe2,
-	0xb4, 0x42, 0xed, 0xa1, 0x12, 0xff, 0x01, 0x09, 0xcd, 0x97, 0x13, 0xc7, 0x6e, 0x53, 0x71, 0xe0,
-	0x12, 0xcd, 0xbc, 0x1f, 0xcf, 0xbc, 0xcf, 0x33, 0xef, 0x3b, 0x0e, 0xd8, 0x46, 0x9c, 0xc6, 0x34,
-	0xc1, 0x3e, 0xa1, 0x63, 0x7f, 0xdc, 0xf6, 0xf9, 0x99, 0x97, 0x66, 0x94, 0x53, 0xb8, 0xa9, 0x1d,
-	0x1e, 0xa1, 0x63, 0x6f, 0xdc, 0x76, 0x9a, 0x43, 0xca, 0x62, 0xca, 0xfc, 0x01, 0x62, 0xd8, 0x1f,
-	0xb7, 0x07, 0x98, 0xa3, 0xb6, 0x3f, 0xa4, 0x51, 0xa2, 0xe2, 0x1d, 0x7b, 0x0e, 0x48, 0xa4, 0x29,
-	0x4f, 0x83, 0x50, 0x42, 0xe5, 0xd2, 0x17, 0x2b, 0x6d, 0xdd, 0x51, 0x78, 0xc7, 0xca, 0xa1, 0x36,
-	0xc6, 0x45, 0x28, 0x25, 0xa7, 0xd8, 0x97, 0xbb, 0xc1, 0xe8, 0x7b, 0x1f, 0x25, 0x13, 0xed, 0xda,
-	0xd6, 0x55, 0xc4, 0x8c, 0x88, 0x43, 0x62, 0x46, 0xb4, 0x63, 0x0b, 0xc5, 0x51, 0x42, 0x7d, 0xf9,
-	0xab, 0x4c, 0xee, 0x6f, 0xcb, 0x60, 0xab, 0xcf, 0xc8, 0xb3, 0xd1, 0x20, 0x8e, 0xf8, 0xd3, 0x8c,
-	0xa6, 0x94, 0xa1, 0x53, 0xf8, 0x3e, 0xb8, 0x13, 0x63, 0xc6, 0x10, 0xc1, 0xcc, 0xb6, 0xf6, 0x56,
-	0xf6, 0x37, 0x3a, 0x0d, 0x4f, 0x9d, 0xe7, 0x99, 0xf3, 0xbc, 0x87, 0xc9, 0x24, 0xc8, 0xa3, 0x60,
-	0x1f, 0xdc, 0x8d, 0x92, 0x88, 0x47, 0xe8, 0xf4, 0x38, 0xc4, 0x29, 0x65, 0x11, 0xb7, 0x97, 0x65,
-	0xe2, 0x8e, 0xa7, 0xcb, 0x16, 0x9a, 0x78, 0x5a, 0x13, 0xef, 0x11, 0x8d, 0x92, 0xde, 0xfa, 0xcb,
-	0xd7, 0xad, 0xa5, 0x5f, 0xaf, 0xce, 0x0f, 0xac, 0x60, 0x53, 0x27, 0x7f, 0xaa, 0x72, 0xe1, 0x07,
-	0xe0, 0x4e, 0x2a, 0x8b, 0xc1, 0x99, 0xbd, 0xb2, 0x67, 0xed, 0xaf, 0xf7, 0xec, 0x3f, 0x9e, 0x1f,
-	0x36, 0x34, 0xd4, 0xc3, 0x30, 0xcc, 0x30, 0x63, 0xcf, 0x78, 0x16, 0x25, 0x24, 0xc8, 0x23, 0xa1,
-	0x23, 0xca, 0xe6, 0x28, 0x44, 0x1c, 0xd9, 0xab, 0x22, 0x2b, 0xc8, 0xf7, 0xb0, 0x01, 0xd6, 0x78,
-	0xc4, 0x4f, 0xb1, 0xbd, 0x26, 0x1d, 0x6a, 0x03, 0x6d, 0x50, 0x67, 0xa3, 0x38, 0x46, 0xd9, 0xc4,
-	0xae, 0x49, 0xbb, 0xd9, 0x76, 0xbd, 0x1f, 0xaf, 0xce, 0x0f, 0x72, 0xe8, 0x9f, 0xae, 0xce, 0x0f,
-	0x76, 0xcd, 0xe5, 0x8d, 0xdb, 0x7e, 0x49, 0x32, 0xf7, 0x08, 0xec, 0x94, 0x8c, 0x01, 0x66, 0x29,
-	0x4d, 0x18, 0x86, 0x2d, 0xb0, 0x91, 0x6a, 0xdb, 0x71, 0x14, 0xda, 0xd6, 0x9e, 0xb5, 0xbf, 0x1a,
-	0x00, 0x63, 0x7a, 0x1c, 0xba, 0x2f, 0x2c, 0xd0, 0xe8, 0x33, 0xf2, 0xd9, 0x19, 0x1e, 0x7e, 0x81,
-	0x09, 0x1a, 0x4e, 0x1e, 0xd1, 0x84, 0xe3, 0x84, 0xc3, 0x27, 0xa0, 0x3e, 0x54, 0x4b, 0x99, 0x75,
-	0xcd, 0x45, 0xf4, 0x5a, 0xbf, 0x3f, 0x3f, 0x7c, 0xbb, 0xd8, 0x8c, 0x46, 0x68, 0x99, 0x1c, 0x18,
-	0x14, 0xb8, 0x0b, 0xd6, 0xd1, 0x88, 0x9f, 0xd0, 0x2c, 0xe2, 0x13, 0x7b, 0x59, 0x72, 0x9e, 0x1a,
-	0xba, 0x1d, 0xc1, 0x7a, 0xba, 0x17, 0xb4, 0x5b, 0x45, 0xda, 0xa5, 0x12, 0xdd, 0x26, 0xd8, 0xad,
-	0xb2, 0x1b, 0xf2, 0xee, 0xa5, 0x05, 0xea, 0x7d, 0x46, 0xbe, 0xa2, 0x1c, 0xc3, 0x0f, 0x2b, 0x84,
-	0xe8, 0x35, 0xfe, 0x7e, 0xdd, 0x9a, 0x35, 0xab, 0x96, 0x98, 0x91, 0x07, 0x7a, 0x60, 0x6d, 0x4c,
-	0x39, 0xce, 0x54, 0xc1, 0x37, 0xf4, 0x82, 0x0a, 0x83, 0x1d, 0x50, 0xa3, 0x29, 0x8f, 0x68, 0x22,
-	0x9b, 0x67, 0xb3, 0xe3, 0x78, 0x45, 0x6d, 0x3c, 0x51, 0xcc, 0x13, 0x19, 0x11, 0xe8, 0xc8, 0x9b,
-	0x9a, 0xa7, 0x7b, 0x4f, 0xc8, 0xa2, 0xb0, 0x85, 0x24, 0xb0, 0x28, 0x89, 0x00, 0x73, 0xb7, 0xc0,
-	0x5d, 0xbd, 0xcc, 0x89, 0xff, 0x63, 0xe5, 0xb6, 0xaf, 0x71, 0x44, 0x4e, 0x38, 0x0e, 0xff, 0x2f,
-	0x01, 0x8e, 0x40, 0x5d, 0xd1, 0x62, 0xf6, 0x8a, 0x1c, 0x43, 0x77, 0x5e, 0x01, 0x53, 0xd1, 0x8c,
-	0x12, 0x26, 0xe5, 0x46, 0x29, 0xee, 0x17, 0xa5, 0x70, 0xca, 0x52, 0x18, 0x64, 0x77, 0x07, 0x6c,
-	0xcf, 0x99, 0x66, 0x7b, 0x02, 0xf4, 0x19, 0x31, 0xe3, 0xfe, 0x1f, 0x55, 0xf9, 0x08, 0xac, 0xeb,
-	0xc7, 0x86, 0x2e, 0x56, 0x66, 0x1a, 0x0a, 0x8f, 0x40, 0x0d, 0xc5, 0x74, 0x94, 0x70, 0x2d, 0xce,
-	0xed, 0xde, 0x28, 0x9d, 0xd3, 0xdd, 0x97, 0x33, 0x92, 0xa3, 0x09, 0x15, 0xde, 0x2c, 0xaa, 0xa0,
-	0x69, 0xb9, 0x0d, 0x00, 0xa7, 0xbb, 0x9c, 0xfb, 0x0b, 0xd5, 0x16, 0x5f, 0xa6, 0x21, 0xe2, 0xf8,
-	0x29, 0xca, 0x50, 0xcc, 0x04, 0x93, 0xe9, 0x54, 0x5a, 0x8b, 0x98, 0xe4, 0xa1, 0xf0, 0x63, 0x50,
-	0x4b, 0x25, 0x82, 0xa4, 0xbf, 0xd1, 0x79, 0x6b, 0xfe, 0x9a, 0x15, 0x7e, 0x81, 0x86, 0x4a, 0xe8,
-	0x3e, 0x28, 0x8f, 0xfa, 0x9e, 0xa1, 0x71, 0x66, 0x3e, 0x50, 0x73, 0x75, 0xea, 0x2b, 0x9d, 0x35,
-	0x19, 0x5a, 0x9d, 0x5f, 0x56, 0xc1, 0x4a, 0x9f, 0x11, 0xf8, 0x1d, 0xd8, 0x9c, 0xfb, 0x9a, 0xdc,
-	0x9b, 0x2f, 0xaa, 0xf4, 0x50, 0x3a, 0xf7, 0x17, 0x86, 0xe4, 0x6f, 0x29, 0x01, 0x5b, 0xe5, 0x67,
-	0xf2, 0x9d, 0x8a, 0xfc, 0x52, 0x94, 0xf3, 0xde, 0x6d, 0xa2, 0xf2, 0x83, 0x3e, 0x01, 0xab, 0xf2,
-	0xcd, 0xda, 0xae, 0xc8, 0x12, 0x0e, 0xa7, 0x75, 0x8d, 0x23, 0x47, 0xf8, 0x06, 0xbc, 0x51, 0x18,
-	0xfe, 0xeb, 0x12, 0x4c, 0x80, 0xf3, 0xee, 0x82, 0x80, 0x1c, 0xf9, 0x31, 0xa8, 0x9b, 0xd9, 0x71,
-	0x2a, 0x72, 0xb4, 0xcf, 0x71, 0xaf, 0xf7, 0xcd, 0x16, 0x59, 0x68, 0xc5, 0xaa, 0x22, 0x67, 0x03,
-	0x2a, 0x8b, 0xac, 0xea, 0x08, 0x67, 0xed, 0x07, 0xd1, 0x70, 0xbd, 0xcf, 0x5f, 0x5e, 0x34, 0xad,
-	0x57, 0x17, 0x4d, 0xeb, 0xaf, 0x8b, 0xa6, 0xf5, 0xf3, 0x65, 0x73, 0xe9, 0xd5, 0x65, 0x73, 0xe9,
-	0xcf, 0xcb, 0xe6, 0xd2, 0xb7, 0x87, 0x24, 0xe2, 0x27, 0xa3, 0x81, 0x37, 0xa4, 0xb1, 0xaf, 0x31,
-	0x0f, 0x4f, 0x46, 0x03, 0xbf, 0xd8, 0x86, 0x7c, 0x92, 0x62, 0x26, 0xfe, 0x4d, 0xd5, 0xe4, 0x27,
-	0xef, 0xc1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x70, 0x13, 0x4d, 0x50, 0x8f, 0x09, 0x00, 0x00,
+	// 1257 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x93, 0xb4, 0x69, 0xa7, 0xdd, 0xae, 0x6a, 0xb2, 0xd4, 0x35, 0x55, 0x92, 0xb5, 0x16,
+	0xda, 0xad, 0xa8, 0x4d, 0xb3, 0xcb, 0xc7, 0x86, 0x95, 0x60, 0xd3, 0x22, 0x54, 0x89, 0x68, 0x2b,
+	0x57, 0x7c, 0x68, 0x0f, 0x44, 0x13, 0x7b, 0xd6, 0xb5, 0x88, 0x3d, 0xc1, 0x33, 0x8e, 0x9a, 0x13,
+	0x88, 0x03, 0x42, 0x9c, 0x38, 0x72, 0x41, 0xe2, 0xc0, 0x01, 0x71, 0xea, 0x61, 0x0f, 0xec, 0x95,
+	0xd3, 0x0a, 0x09, 0x69, 0xc5, 0x89, 0xd3, 0x82, 0xda, 0x43, 0x25, 0xfe, 0x0a, 0x34, 0xe3, 0x8f,
+	0xc4, 0x71, 0xdc, 0xb4, 0x05, 0x71, 0xa9, 0x3c, 0xef, 0xf3, 0xf7, 0x7e, 0xf3, 0xf2, 0xde, 0x14,
+	0x2c, 0x43, 0x8a, 0x1d, 0xec, 0x22, 0xcd, 0xc2, 0x3d, 0xad, 0xb7, 0xa5, 0xd1, 0x43, 0xb5, 0xeb,
+	0x61, 0x8a, 0xc5, 0xc5, 0x50, 0xa1, 0x5a, 0xb8, 0xa7, 0xf6, 0xb6, 0xe4, 0xb2, 0x81, 0x89, 0x83,
+	0x89, 0xd6, 0x86, 0x04, 0x69, 0xbd, 0xad, 0x36, 0xa2, 0x70, 0x4b, 0x33, 0xb0, 0xed, 0x06, 0xf6,
+	0xb2, 0x34, 0x12, 0x88, 0xb9, 0x05, 0x9a, 0x92, 0x85, 0x2d, 0xcc, 0x3f, 0x35, 0xf6, 0x15, 0x4a,
+	0x57, 0x82, 0x78, 0xad, 0x40, 0x11, 0x1c, 0x22, 0x95, 0x85, 0xb1, 0xd5, 0x41, 0x1a, 0x3f, 0xb5,
+	0xfd, 0x87, 0x1a, 0x74, 0xfb, 0xa1, 0xaa, 0x3c, 0xaa, 0x32, 0x7d, 0x0f, 0x52, 0x1b, 0x47, 0x28,
+	0x96, 0x43, 0x94, 0x0e, 0xb1, 0x18, 0x08, 0x87, 0x58, 0xa1, 0x62, 0x09, 0x3a, 0xb6, 0x8b, 0x35,
+	0xfe, 0x37, 0x10, 0x29, 0x3f, 0xe5, 0xc1, 0x52, 0x93, 0x58, 0xfb, 0x7e, 0xdb, 0xb1, 0xe9, 0x9e,
+	0x87, 0xbb, 0x98, 0xc0, 0x8e, 0xf8, 0x0a, 0x98, 0x75, 0x10, 0x21, 0xd0, 0x42, 0x44, 0x12, 0xaa,
+	0xf9, 0xf5, 0xf9, 0x5a, 0x49, 0x0d, 0x92, 0xaa, 0x51, 0x52, 0xf5, 0x9e, 0xdb, 0xd7, 0x63, 0x2b,
+	0xb1, 0x09, 0xae, 0xda, 0xae, 0x4d, 0x6d, 0xd8, 0x69, 0x99, 0xa8, 0x8b, 0x89, 0x4d, 0xa5, 0x1c,
+	0x77, 0x5c, 0x51, 0xc3, 0xb2, 0x18, 0x67, 0x6a, 0xc8, 0x99, 0xba, 0x8d, 0x6d, 0xb7, 0x31, 0xf7,
+	0xe4, 0x59, 0x65, 0xea, 0xc7, 0xd3, 0xa3, 0x0d, 0x41, 0x5f, 0x0c, 0x9d, 0x77, 0x02, 0x5f, 0xf1,
+	0x36, 0x98, 0xed, 0x72, 0x30, 0xc8, 0x93, 0xf2, 0x55, 0x61, 0x7d, 0xae, 0x21, 0xfd, 0xfe, 0x68,
+	0xb3, 0x14, 0x86, 0xba, 0x67, 0x9a, 0x1e, 0x22, 0x64, 0x9f, 0x7a, 0xb6, 0x6b, 0xe9, 0xb1, 0xa5,
+	0x28, 0x33, 0xd8, 0x14, 0x9a, 0x90, 0x42, 0xa9, 0xc0, 0xbc, 0xf4, 0xf8, 0x2c, 0x96, 0xc0, 0x34,
+	0xb5, 0x69, 0x07, 0x49, 0xd3, 0x5c, 0x11, 0x1c, 0x44, 0x09, 0x14, 0x89, 0xef, 0x38, 0xd0, 0xeb,
+	0x4b, 0x33, 0x5c, 0x1e, 0x1d, 0xc5, 0x1d, 0x70, 0xa5, 0x87, 0xa9, 0xed, 0x5a, 0xad, 0x2e, 0xf2,
+	0x6c, 0x6c, 0x4a, 0xc5, 0xaa, 0xc0, 0xcb, 0x19, 0xe5, 0x61, 0x27, 0x24, 0xbf, 0x51, 0xf8, 0xf6,
+	0xcf, 0x8a, 0xa0, 0x2f, 0x04, 0x5e, 0x7b, 0xdc, 0x49, 0x5c, 0x05, 0x73, 0x9f, 0xfa, 0xd0, 0x64,
+	0x16, 0x86, 0x34, 0x5b, 0x15, 0xd6, 0x67, 0xf5, 0x81, 0xa0, 0xae, 0x7e, 0x71, 0x7a, 0xb4, 0x11,
+	0xc3, 0xff, 0xfa, 0xf4, 0x68, 0x63, 0x35, 0x6a, 0xa0, 0xde, 0x96, 0x96, 0xba, 0x16, 0xe5, 0x2e,
+	0x58, 0x49, 0x09, 0x75, 0x44, 0xba, 0xd8, 0x25, 0x48, 0xac, 0x80, 0xf9, 0x6e, 0x28, 0x6b, 0xd9,
+	0xa6, 0x24, 0x54, 0x85, 0xf5, 0x82, 0x0e, 0x22, 0xd1, 0xae, 0xa9, 0x3c, 0x16, 0x40, 0xa9, 0x49,
+	0xac, 0x77, 0x0e, 0x91, 0xf1, 0x1e, 0xb2, 0xa0, 0xd1, 0xdf, 0xc6, 0x2e, 0x45, 0x2e, 0x15, 0xef,
+	0x83, 0xa2, 0x11, 0x7c, 0x72, 0xaf, 0x8c, 0xcb, 0x6e, 0x54, 0x7e, 0x7d, 0xb4, 0xf9, 0x42, 0xf2,
+	0x07, 0x11, 0x5d, 0x26, 0x77, 0xd6, 0xa3, 0x28, 0xac, 0x6a, 0xe8, 0xd3, 0x03, 0xec, 0xd9, 0xb4,
+	0x2f, 0xe5, 0x38, 0xaf, 0x03, 0x41, 0xbd, 0xc6, 0xaa, 0x1e, 0x9c, 0x59, 0xd9, 0x95, 0x64, 0xd9,
+	0x29, 0x88, 0x4a, 0x19, 0xac, 0x8e, 0x93, 0x47, 0xc5, 0x2b, 0x5f, 0xe6, 0x40, 0xb1, 0x49, 0xac,
+	0x0f, 0x30, 0x45, 0xe2, 0xab, 0x63, 0x88, 0x68, 0x94, 0xfe, 0x7e, 0x56, 0x19, 0x16, 0x07, 0x6d,
+	0x37, 0x44, 0x8f, 0xa8, 0x82, 0xe9, 0x1e, 0xa6, 0xc8, 0x93, 0x72, 0x13, 0xfa, 0x2d, 0x30, 0x13,
+	0x6b, 0x60, 0x06, 0x77, 0xd9, 0xc5, 0xf3, 0x06, 0x5d, 0xac, 0xc9, 0x6a, 0x92, 0x1b, 0x95, 0x81,
+	0xb9, 0xcf, 0x2d, 0xf4, 0xd0, 0xf2, 0xcc, 0x06, 0xbd, 0x0e, 0x16, 0x3a, 0xd8, 0xf8, 0x24, 0x6c,
+	0x37, 0xc2, 0xfb, 0xf4, 0x8a, 0x3e, 0xcf, 0x64, 0x41, 0x33, 0x91, 0xfa, 0x75, 0xc6, 0x5c, 0x90,
+	0x9e, 0xb1, 0x26, 0x26, 0x59, 0x63, 0xf9, 0x94, 0x25, 0x70, 0x35, 0xfc, 0x8c, 0xb9, 0xf9, 0x3e,
+	0x17, 0xcb, 0x3e, 0x44, 0xb6, 0x75, 0x40, 0x91, 0xf9, 0x7f, 0x71, 0x74, 0x17, 0x14, 0x83, 0xca,
+	0x89, 0x94, 0xe7, 0xd3, 0x40, 0x19, 0x25, 0x29, 0x42, 0x34, 0x44, 0x56, 0xe4, 0xf2, 0x6f, 0xd9,
+	0xba, 0x99, 0x64, 0x4b, 0x4e, 0xb3, 0x15, 0x25, 0x57, 0x56, 0xc0, 0xf2, 0x88, 0x28, 0x66, 0xef,
+	0x87, 0x1c, 0x00, 0x4d, 0x62, 0x45, 0x83, 0xe9, 0x92, 0xc4, 0xbd, 0x06, 0xe6, 0xc2, 0xb1, 0x88,
+	0x27, 0x93, 0x37, 0x30, 0x15, 0xef, 0x82, 0x19, 0xe8, 0x60, 0xdf, 0xa5, 0x52, 0xfe, 0x02, 0xd3,
+	0x34, 0xf4, 0x11, 0xdf, 0x02, 0x8b, 0x1e, 0x7a, 0xe8, 0xbb, 0x66, 0x0b, 0x06, 0x09, 0xa4, 0xc2,
+	0x84, 0xd4, 0x57, 0x02, 0xfb, 0x50, 0x58, 0x5f, 0xe7, 0x3f, 0xd5, 0x18, 0x0e, 0xa3, 0xf1, 0x5a,
+	0x92, 0xc6, 0x90, 0x17, 0xa5, 0x04, 0xc4, 0xc1, 0x29, 0x26, 0xef, 0xb1, 0xc0, 0x5b, 0xef, 0xfd,
+	0xae, 0x09, 0x29, 0xda, 0x83, 0x1e, 0x74, 0x08, 0xa3, 0x62, 0x30, 0x1c, 0x84, 0x49, 0x54, 0xc4,
+	0xa6, 0xe2, 0x1d, 0x30, 0xd3, 0xe5, 0x11, 0x38, 0x7f, 0xf3, 0xb5, 0xe7, 0x47, 0x5b, 0x29, 0x88,
+	0x9f, 0xe0, 0x21, 0x70, 0xa8, 0xdf, 0x4a, 0x4f, 0x9c, 0x6a, 0x54, 0xc6, 0x61, 0xb4, 0xab, 0x47,
+	0x70, 0x86, 0x3d, 0x31, 0x2c, 0x8a, 0xcb, 0xfa, 0x59, 0x00, 0x95, 0x26, 0xb1, 0x82, 0x11, 0x8c,
+	0xb6, 0xb1, 0x4b, 0xa8, 0x4d, 0x7d, 0xd6, 0xb4, 0xf7, 0x1c, 0xe4, 0x9a, 0x0e, 0x9b, 0x81, 0x97,
+	0x2d, 0x53, 0x01, 0x0b, 0xc6, 0x50, 0xc0, 0x70, 0x7c, 0x26, 0x64, 0xf5, 0x3b, 0xe9, 0x7a, 0x5e,
+	0x4a, 0x5e, 0x4b, 0x16, 0x34, 0xe5, 0x26, 0x58, 0x9b, 0x80, 0x3c, 0xae, 0xf2, 0xb7, 0x1c, 0x1f,
+	0xba, 0xfb, 0xc6, 0x01, 0x32, 0xfd, 0x0e, 0xd2, 0x91, 0xe1, 0x7b, 0x0c, 0x6d, 0xfc, 0x4a, 0xb8,
+	0x6c, 0x89, 0xc3, 0xaf, 0x8b, 0xdc, 0xb9, 0x5e, 0x17, 0xc3, 0x93, 0x20, 0x9f, 0xb5, 0xd8, 0x0b,
+	0x19, 0x8b, 0x7d, 0x3a, 0xb9, 0xd8, 0xdf, 0x04, 0xb3, 0xb6, 0x4b, 0x91, 0xd7, 0x83, 0x1d, 0xbe,
+	0xf3, 0xcf, 0xb1, 0xd3, 0x63, 0x87, 0x7a, 0x3d, 0xcd, 0xfc, 0xda, 0xc8, 0xca, 0xce, 0xa2, 0x4b,
+	0x79, 0x00, 0x6e, 0x9c, 0xa5, 0x8f, 0x17, 0x79, 0x0d, 0x5c, 0xf3, 0x22, 0x65, 0x2b, 0xbd, 0xd2,
+	0x9f, 0xf3, 0x46, 0x3d, 0x77, 0x4d, 0xe5, 0x17, 0x01, 0xc8, 0x4d, 0x62, 0x6d, 0x43, 0xd7, 0x40,
+	0x9d, 0xff, 0xee, 0xa6, 0x32, 0xa1, 0xe4, 0x32, 0xa1, 0xd4, 0xdf, 0x48, 0x53, 0xf4, 0x62, 0x92,
+	0xa2, 0x0c, 0x94, 0xca, 0x0d, 0xa0, 0x64, 0x6b, 0x23, 0x7a, 0x6a, 0xdf, 0x15, 0x41, 0xbe, 0x49,
+	0x2c, 0xf1, 0x63, 0xb0, 0x38, 0xf2, 0x6a, 0xbd, 0x3e, 0x3a, 0x11, 0x52, 0x8f, 0x25, 0xf9, 0xe6,
+	0x44, 0x93, 0xf8, 0x1a, 0x2c, 0xb0, 0x94, 0x7e, 0x2a, 0xdd, 0x18, 0xe3, 0x9f, 0xb2, 0x92, 0x5f,
+	0x3e, 0x8f, 0x55, 0x9c, 0xe8, 0x6d, 0x50, 0xe0, 0xef, 0x96, 0xe5, 0x31, 0x5e, 0x4c, 0x21, 0x57,
+	0x32, 0x14, 0x71, 0x84, 0x8f, 0xc0, 0x42, 0x62, 0xbb, 0x67, 0x39, 0x44, 0x06, 0xf2, 0xda, 0x04,
+	0x83, 0x38, 0xf2, 0x2e, 0x28, 0x46, 0x9b, 0x4f, 0x1e, 0xe3, 0x13, 0xea, 0x64, 0x25, 0x5b, 0x37,
+	0x0c, 0x32, 0xb1, 0x07, 0xc6, 0x81, 0x1c, 0x36, 0x90, 0xd7, 0x26, 0x18, 0xc4, 0x91, 0xbf, 0x12,
+	0xc0, 0xea, 0x99, 0xb3, 0x58, 0x1b, 0x13, 0xe9, 0x2c, 0x07, 0xf9, 0xf5, 0x0b, 0x3a, 0xc4, 0x50,
+	0x3e, 0x03, 0x2b, 0xd9, 0xf3, 0x72, 0x5c, 0x5b, 0x64, 0x5a, 0xcb, 0xb7, 0x2f, 0x62, 0x1d, 0x03,
+	0xe8, 0x83, 0xe5, 0xac, 0x21, 0xb0, 0x31, 0x26, 0x60, 0x86, 0xad, 0x5c, 0x3b, 0xbf, 0x6d, 0x94,
+	0x5a, 0x9e, 0xfe, 0x9c, 0x2d, 0xdd, 0xc6, 0xbb, 0x4f, 0x8e, 0xcb, 0xc2, 0xd3, 0xe3, 0xb2, 0xf0,
+	0xd7, 0x71, 0x59, 0xf8, 0xe6, 0xa4, 0x3c, 0xf5, 0xf4, 0xa4, 0x3c, 0xf5, 0xc7, 0x49, 0x79, 0xea,
+	0xc1, 0xa6, 0x65, 0xd3, 0x03, 0xbf, 0xad, 0x1a, 0xd8, 0xd1, 0xc2, 0xf0, 0x9b, 0x07, 0x7e, 0x5b,
+	0x4b, 0xae, 0x62, 0xda, 0xef, 0x22, 0xc2, 0xfe, 0xb9, 0x9e, 0xe1, 0xe3, 0xf8, 0xd6, 0x3f, 0x03,
+	0x00, 0x58, 0xe8, 0xf1, 0x13, 0x9e, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -794,6 +1207,16 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ProposeConstitutionAmendment defines a governance operation for amending
+	// the AtomOne constitution. The authority is defined in the keeper.
+	ProposeConstitutionAmendment(ctx context.Context, in *MsgProposeConstitutionAmendment, opts ...grpc.CallOption) (*MsgProposeConstitutionAmendmentResponse, error)
+	// ScheduleRecurringProposal defines a governance operation for scheduling
+	// a proposal submitted again for a vote at a regular interval. The
+	// authority is defined in the keeper.
+	ScheduleRecurringProposal(ctx context.Context, in *MsgScheduleRecurringProposal, opts ...grpc.CallOption) (*MsgScheduleRecurringProposalResponse, error)
+	// CancelRecurringProposal defines a governance operation for cancelling a
+	// recurring proposal. The authority is defined in the keeper.
+	CancelRecurringProposal(ctx context.Context, in *MsgCancelRecurringProposal, opts ...grpc.CallOption) (*MsgCancelRecurringProposalResponse, error)
 }
 
 type msgClient struct {
@@ -858,6 +1281,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ProposeConstitutionAmendment(ctx context.Context, in *MsgProposeConstitutionAmendment, opts ...grpc.CallOption) (*MsgProposeConstitutionAmendmentResponse, error) {
+	out := new(MsgProposeConstitutionAmendmentResponse)
+	err := c.cc.Invoke(ctx, "/atomone.gov.v1.Msg/ProposeConstitutionAmendment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleRecurringProposal(ctx context.Context, in *MsgScheduleRecurringProposal, opts ...grpc.CallOption) (*MsgScheduleRecurringProposalResponse, error) {
+	out := new(MsgScheduleRecurringProposalResponse)
+	err := c.cc.Invoke(ctx, "/atomone.gov.v1.Msg/ScheduleRecurringProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRecurringProposal(ctx context.Context, in *MsgCancelRecurringProposal, opts ...grpc.CallOption) (*MsgCancelRecurringProposalResponse, error) {
+	out := new(MsgCancelRecurringProposalResponse)
+	err := c.cc.Invoke(ctx, "/atomone.gov.v1.Msg/CancelRecurringProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -876,6 +1326,16 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ProposeConstitutionAmendment defines a governance operation for amending
+	// the AtomOne constitution. The authority is defined in the keeper.
+	ProposeConstitutionAmendment(context.Context, *MsgProposeConstitutionAmendment) (*MsgProposeConstitutionAmendmentResponse, error)
+	// ScheduleRecurringProposal defines a governance operation for scheduling
+	// a proposal submitted again for a vote at a regular interval. The
+	// authority is defined in the keeper.
+	ScheduleRecurringProposal(context.Context, *MsgScheduleRecurringProposal) (*MsgScheduleRecurringProposalResponse, error)
+	// CancelRecurringProposal defines a governance operation for cancelling a
+	// recurring proposal. The authority is defined in the keeper.
+	CancelRecurringProposal(context.Context, *MsgCancelRecurringProposal) (*MsgCancelRecurringProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -900,6 +1360,15 @@ func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*M
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ProposeConstitutionAmendment(ctx context.Context, req *MsgProposeConstitutionAmendment) (*MsgProposeConstitutionAmendmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeConstitutionAmendment not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleRecurringProposal(ctx context.Context, req *MsgScheduleRecurringProposal) (*MsgScheduleRecurringProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRecurringProposal not implemented")
+}
+func (*UnimplementedMsgServer) CancelRecurringProposal(ctx context.Context, req *MsgCancelRecurringProposal) (*MsgCancelRecurringProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1013,25 +1482,79 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "atomone.gov.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitProposal",
-			Handler:    _Msg_SubmitProposal_Handler,
-		},
-		{
-			MethodName: "ExecLegacyContent",
-			Handler:    _Msg_ExecLegacyContent_Handler,
-		},
-		{
-			MethodName: "Vote",
-			Handler:    _Msg_Vote_Handler,
-		},
-		{
-			MethodName: "VoteWeighted",
-			Handler:    _Msg_VoteWeighted_Handler,
+func _Msg_ProposeConstitutionAmendment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeConstitutionAmendment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeConstitutionAmendment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.gov.v1.Msg/ProposeConstitutionAmendment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeConstitutionAmendment(ctx, req.(*MsgProposeConstitutionAmendment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleRecurringProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleRecurringProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleRecurringProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.gov.v1.Msg/ScheduleRecurringProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleRecurringProposal(ctx, req.(*MsgScheduleRecurringProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRecurringProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecurringProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecurringProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/atomone.gov.v1.Msg/CancelRecurringProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecurringProposal(ctx, req.(*MsgCancelRecurringProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "atomone.gov.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitProposal",
+			Handler:    _Msg_SubmitProposal_Handler,
+		},
+		{
+			MethodName: "ExecLegacyContent",
+			Handler:    _Msg_ExecLegacyContent_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Msg_Vote_Handler,
+		},
+		{
+			MethodName: "VoteWeighted",
+			Handler:    _Msg_VoteWeighted_Handler,
 		},
 		{
 			MethodName: "Deposit",
@@ -1041,6 +1564,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ProposeConstitutionAmendment",
+			Handler:    _Msg_ProposeConstitutionAmendment_Handler,
+		},
+		{
+			MethodName: "ScheduleRecurringProposal",
+			Handler:    _Msg_ScheduleRecurringProposal_Handler,
+		},
+		{
+			MethodName: "CancelRecurringProposal",
+			Handler:    _Msg_CancelRecurringProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "atomone/gov/v1/tx.proto",
@@ -1066,6 +1601,26 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Quadratic {
+		i--
+		if m.Quadratic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.VotingPeriod != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1238,6 +1793,11 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LockPeriods != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockPeriods))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1308,6 +1868,11 @@ func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LockPeriods != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockPeriods))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1387,6 +1952,13 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1502,221 +2074,1096 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgSubmitProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.InitialDeposit) > 0 {
-		for _, e := range m.InitialDeposit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Summary)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgProposeConstitutionAmendment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
-	}
-	return n
+func (m *MsgProposeConstitutionAmendment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecLegacyContent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgProposeConstitutionAmendment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Content != nil {
-		l = m.Content.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Constitution) > 0 {
+		i -= len(m.Constitution)
+		copy(dAtA[i:], m.Constitution)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Constitution)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecLegacyContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposeConstitutionAmendmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Option != 0 {
-		n += 1 + sovTx(uint64(m.Option))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgProposeConstitutionAmendmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgProposeConstitutionAmendmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgVoteWeighted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgScheduleRecurringProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgVoteWeightedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgScheduleRecurringProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgScheduleRecurringProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+	if m.Interval != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleRecurringProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleRecurringProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleRecurringProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecurringProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecurringProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecurringProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecurringProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecurringProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecurringProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecurringProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecurringProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecurringProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecurringProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Quadratic {
+		n += 2
+	}
 	return n
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
+func (m *MsgSubmitProposalResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgExecLegacyContent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *MsgExecLegacyContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTx(uint64(m.Option))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockPeriods != 0 {
+		n += 1 + sovTx(uint64(m.LockPeriods))
+	}
+	return n
+}
+
+func (m *MsgVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockPeriods != 0 {
+		n += 1 + sovTx(uint64(m.LockPeriods))
+	}
+	return n
+}
+
+func (m *MsgVoteWeightedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeConstitutionAmendment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Constitution)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeConstitutionAmendmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleRecurringProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Interval != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleRecurringProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecurringProposalId != 0 {
+		n += 1 + sovTx(uint64(m.RecurringProposalId))
+	}
+	return n
+}
+
+func (m *MsgCancelRecurringProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecurringProposalId != 0 {
+		n += 1 + sovTx(uint64(m.RecurringProposalId))
+	}
+	return n
+}
+
+func (m *MsgCancelRecurringProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quadratic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quadratic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecLegacyContent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecLegacyContent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecLegacyContent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgExecLegacyContentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecLegacyContentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecLegacyContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1739,17 +3186,36 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1759,31 +3225,199 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockPeriods", wireType)
+			}
+			m.LockPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockPeriods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1793,29 +3427,14 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
-			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1843,13 +3462,13 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1859,27 +3478,29 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1907,13 +3528,13 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockPeriods", wireType)
 			}
-			var stringLen uint64
+			m.LockPeriods = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1923,24 +3544,11 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LockPeriods |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1962,7 +3570,7 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1985,31 +3593,12 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2031,7 +3620,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecLegacyContent) Unmarshal(dAtA []byte) error {
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2054,15 +3643,66 @@ func (m *MsgExecLegacyContent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecLegacyContent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecLegacyContent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2089,16 +3729,14 @@ func (m *MsgExecLegacyContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Content == nil {
-				m.Content = &types.Any{}
-			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2126,7 +3764,7 @@ func (m *MsgExecLegacyContent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2149,7 +3787,7 @@ func (m *MsgExecLegacyContent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecLegacyContentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2172,10 +3810,10 @@ func (m *MsgExecLegacyContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecLegacyContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecLegacyContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2199,7 +3837,7 @@ func (m *MsgExecLegacyContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,34 +3860,15 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2277,32 +3896,13 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2312,23 +3912,24 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2351,7 +3952,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2374,10 +3975,10 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2401,7 +4002,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeConstitutionAmendment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2424,34 +4025,15 @@ func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeConstitutionAmendment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeConstitutionAmendment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2479,45 +4061,11 @@ func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Options = append(m.Options, &WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Constitution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2545,7 +4093,7 @@ func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2568,7 +4116,7 @@ func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeConstitutionAmendmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2591,10 +4139,10 @@ func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteWeightedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeConstitutionAmendmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteWeightedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeConstitutionAmendmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2618,7 +4166,7 @@ func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleRecurringProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2641,17 +4189,17 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleRecurringProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleRecurringProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2661,14 +4209,61 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2696,11 +4291,75 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2727,8 +4386,10 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Interval == nil {
+				m.Interval = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Interval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2753,7 +4414,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleRecurringProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2776,12 +4437,31 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleRecurringProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleRecurringProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringProposalId", wireType)
+			}
+			m.RecurringProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurringProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2803,7 +4483,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRecurringProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2826,10 +4506,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRecurringProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRecurringProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2865,10 +4545,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringProposalId", wireType)
 			}
-			var msglen int
+			m.RecurringProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2878,25 +4558,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RecurringProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2918,7 +4584,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRecurringProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2941,10 +4607,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRecurringProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRecurringProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
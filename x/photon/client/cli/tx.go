@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// NewTxCmd returns the transaction commands for this module
+func NewTxCmd() *cobra.Command {
+	photonTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Photon transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	photonTxCmd.AddCommand(
+		NewCmdMintPhoton(),
+	)
+
+	return photonTxCmd
+}
+
+// NewCmdMintPhoton implements the mint photon command.
+func NewCmdMintPhoton() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Mint PHOTON by burning ATONE",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn the given amount of ATONE and mint PHOTON to the sender at the
+current conversion rate.
+
+Example:
+$ %s tx photon mint 1000000uatone --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintPhoton(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,22 @@
+package photon
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/keeper"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// InitGenesis - store genesis parameters
+func InitGenesis(ctx sdk.Context, k *keeper.Keeper, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Sprintf("%s module params has not been set", types.ModuleName))
+	}
+}
+
+// ExportGenesis - output genesis parameters
+func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
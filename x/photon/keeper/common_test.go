@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/atomone/x/photon/keeper"
+	photontestutil "github.com/atomone-hub/atomone/x/photon/testutil"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+var govAcct = authtypes.NewModuleAddress("gov")
+
+type mocks struct {
+	acctKeeper    *photontestutil.MockAccountKeeper
+	bankKeeper    *photontestutil.MockBankKeeper
+	stakingKeeper *photontestutil.MockStakingKeeper
+}
+
+// setupPhotonKeeper creates a photonKeeper as well as all its dependencies.
+func setupPhotonKeeper(t *testing.T) (*keeper.Keeper, mocks, sdk.Context) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	// gomock initializations
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		acctKeeper:    photontestutil.NewMockAccountKeeper(ctrl),
+		bankKeeper:    photontestutil.NewMockBankKeeper(ctrl),
+		stakingKeeper: photontestutil.NewMockStakingKeeper(ctrl),
+	}
+	m.acctKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName)).AnyTimes()
+	m.stakingKeeper.EXPECT().BondDenom(ctx).Return("uatone").AnyTimes()
+
+	k := keeper.NewKeeper(encCfg.Codec, key, govAcct.String(), m.acctKeeper, m.bankKeeper, m.stakingKeeper)
+	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+		t.Fatal(err)
+	}
+
+	return k, m, ctx
+}
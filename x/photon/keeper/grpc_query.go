@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the photon parameters
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// ConversionRate queries the current ATONE to PHOTON conversion rate
+func (k Keeper) ConversionRate(c context.Context, req *types.QueryConversionRateRequest) (*types.QueryConversionRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	atoneSupply := k.bankKeeper.GetSupply(ctx, k.stakingKeeper.BondDenom(ctx))
+	photonSupply := k.bankKeeper.GetSupply(ctx, types.Denom)
+	mintable := math.NewInt(types.MaxSupply).Sub(photonSupply.Amount)
+	if mintable.IsNegative() {
+		mintable = math.ZeroInt()
+	}
+
+	return &types.QueryConversionRateResponse{
+		ConversionRate: k.PhotonConversionRate(ctx).String(),
+		MintablePhoton: sdk.NewCoin(types.Denom, mintable),
+		AtoneSupply:    atoneSupply,
+		PhotonSupply:   photonSupply,
+	}, nil
+}
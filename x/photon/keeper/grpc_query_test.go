@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestGRPCQueryParams(t *testing.T) {
+	k, _, ctx := setupPhotonKeeper(t)
+
+	_, err := k.Params(ctx, nil)
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request")
+
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), res.Params)
+}
+
+func TestGRPCQueryConversionRate(t *testing.T) {
+	tests := []struct {
+		name         string
+		atoneSupply  int64
+		photonSupply int64
+		expectedRes  *types.QueryConversionRateResponse
+	}{
+		{
+			name:         "no photon minted",
+			atoneSupply:  100_000_000_000_000,
+			photonSupply: 0,
+			expectedRes: &types.QueryConversionRateResponse{
+				ConversionRate: "10.000000000000000000",
+				MintablePhoton: sdk.NewInt64Coin(types.Denom, types.MaxSupply),
+				AtoneSupply:    sdk.NewInt64Coin("uatone", 100_000_000_000_000),
+				PhotonSupply:   sdk.NewInt64Coin(types.Denom, 0),
+			},
+		},
+		{
+			name:         "half photon minted",
+			atoneSupply:  50_000_000_000_000,
+			photonSupply: types.MaxSupply / 2,
+			expectedRes: &types.QueryConversionRateResponse{
+				ConversionRate: "10.000000000000000000",
+				MintablePhoton: sdk.NewInt64Coin(types.Denom, types.MaxSupply/2),
+				AtoneSupply:    sdk.NewInt64Coin("uatone", 50_000_000_000_000),
+				PhotonSupply:   sdk.NewInt64Coin(types.Denom, types.MaxSupply/2),
+			},
+		},
+		{
+			name:         "photon max supply reached",
+			atoneSupply:  1,
+			photonSupply: types.MaxSupply,
+			expectedRes: &types.QueryConversionRateResponse{
+				ConversionRate: "0.000000000000000000",
+				MintablePhoton: sdk.NewInt64Coin(types.Denom, 0),
+				AtoneSupply:    sdk.NewInt64Coin("uatone", 1),
+				PhotonSupply:   sdk.NewInt64Coin(types.Denom, types.MaxSupply),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := setupPhotonKeeper(t)
+			m.bankKeeper.EXPECT().GetSupply(ctx, "uatone").Return(sdk.NewInt64Coin("uatone", tt.atoneSupply)).AnyTimes()
+			m.bankKeeper.EXPECT().GetSupply(ctx, types.Denom).Return(sdk.NewInt64Coin(types.Denom, tt.photonSupply)).AnyTimes()
+
+			res, err := k.ConversionRate(ctx, &types.QueryConversionRateRequest{})
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRes.String(), res.String())
+		})
+	}
+}
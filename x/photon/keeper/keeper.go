@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// Keeper defines the photon module Keeper
+type Keeper struct {
+	authKeeper    types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey storetypes.StoreKey
+
+	// The codec for binary encoding/decoding.
+	cdc codec.BinaryCodec
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper returns a photon keeper. It handles the minting of PHOTON by
+// burning ATONE.
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, authority string,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, stakingKeeper types.StakingKeeper,
+) *Keeper {
+	// ensure photon module account is set
+	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	return &Keeper{
+		storeKey:      key,
+		authKeeper:    authKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
+		cdc:           cdc,
+		authority:     authority,
+	}
+}
+
+// GetAuthority returns the x/photon module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams gets the photon module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the photon module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// PhotonConversionRate returns the conversion rate of ATONE to PHOTON: the
+// remaining mintable PHOTON supply divided by the ATONE supply.
+func (k Keeper) PhotonConversionRate(ctx sdk.Context) math.LegacyDec {
+	atoneSupply := k.bankKeeper.GetSupply(ctx, k.stakingKeeper.BondDenom(ctx)).Amount
+	if atoneSupply.IsZero() {
+		return math.LegacyZeroDec()
+	}
+
+	photonSupply := k.bankKeeper.GetSupply(ctx, types.Denom).Amount
+	mintable := math.NewInt(types.MaxSupply).Sub(photonSupply)
+	if !mintable.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyNewDecFromInt(mintable).QuoInt(atoneSupply)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the photon MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// MintPhoton implements the MsgServer.MintPhoton method. It burns the ATONE
+// amount and mints PHOTON to the sender at the current conversion rate.
+func (k msgServer) MintPhoton(goCtx context.Context, msg *types.MsgMintPhoton) (*types.MsgMintPhotonResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.GetParams(ctx).MintDisabled {
+		return nil, types.ErrMintDisabled
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, errors.Wrapf(types.ErrBurnInvalidDenom, "expected %s, got %s", bondDenom, msg.Amount.Denom)
+	}
+
+	// the conversion rate is computed before the burn, and the minted amount
+	// is truncated, so the PHOTON supply can't exceed its maximum.
+	conversionRate := k.PhotonConversionRate(ctx)
+	minted := sdk.NewCoin(types.Denom, conversionRate.MulInt(msg.Amount.Amount).TruncateInt())
+	if minted.IsZero() {
+		return nil, types.ErrNoMintablePhotons
+	}
+
+	toAddress, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	burned := sdk.NewCoins(msg.Amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, toAddress, types.ModuleName, burned); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(minted)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddress, sdk.NewCoins(minted)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintPhoton,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ToAddress),
+			sdk.NewAttribute(types.AttributeKeyBurned, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyMinted, minted.String()),
+			sdk.NewAttribute(types.AttributeKeyConversionRate, conversionRate.String()),
+		),
+	)
+
+	return &types.MsgMintPhotonResponse{
+		Minted:         minted,
+		ConversionRate: conversionRate.String(),
+	}, nil
+}
+
+// UpdateParams implements the MsgServer.UpdateParams method.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
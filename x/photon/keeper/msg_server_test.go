@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/keeper"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestMsgServerMintPhoton(t *testing.T) {
+	toAddress := sdk.AccAddress("toAddress___________")
+	tests := []struct {
+		name           string
+		params         types.Params
+		amount         sdk.Coin
+		atoneSupply    int64
+		photonSupply   int64
+		setup          func(mocks)
+		expectedErr    string
+		expectedMinted sdk.Coin
+		expectedRate   string
+	}{
+		{
+			name:         "fail: mint disabled",
+			params:       types.NewParams(true, nil),
+			amount:       sdk.NewInt64Coin("uatone", 1),
+			atoneSupply:  1,
+			photonSupply: 0,
+			expectedErr:  "photon mint disabled",
+		},
+		{
+			name:         "fail: invalid burned denom",
+			params:       types.DefaultParams(),
+			amount:       sdk.NewInt64Coin("xxx", 1),
+			atoneSupply:  1,
+			photonSupply: 0,
+			expectedErr:  "expected uatone, got xxx: invalid burned amount denom",
+		},
+		{
+			name:         "fail: photon max supply reached",
+			params:       types.DefaultParams(),
+			amount:       sdk.NewInt64Coin("uatone", 1),
+			atoneSupply:  1,
+			photonSupply: types.MaxSupply,
+			expectedErr:  "no mintable photon after rounding",
+		},
+		{
+			name:         "fail: minted amount rounded to zero",
+			params:       types.DefaultParams(),
+			amount:       sdk.NewInt64Coin("uatone", 1),
+			atoneSupply:  types.MaxSupply * 2,
+			photonSupply: 0,
+			expectedErr:  "no mintable photon after rounding",
+		},
+		{
+			name:         "ok",
+			params:       types.DefaultParams(),
+			amount:       sdk.NewInt64Coin("uatone", 1_000),
+			atoneSupply:  100_000_000_000_000,
+			photonSupply: 0,
+			setup: func(m mocks) {
+				burned := sdk.NewCoins(sdk.NewInt64Coin("uatone", 1_000))
+				minted := sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 10_000))
+				m.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), toAddress, types.ModuleName, burned)
+				m.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, burned)
+				m.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, minted)
+				m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, toAddress, minted)
+			},
+			expectedMinted: sdk.NewInt64Coin(types.Denom, 10_000),
+			expectedRate:   "10.000000000000000000",
+		},
+		{
+			name:         "ok: minted amount is truncated",
+			params:       types.DefaultParams(),
+			amount:       sdk.NewInt64Coin("uatone", 2),
+			atoneSupply:  3,
+			photonSupply: types.MaxSupply - 2,
+			setup: func(m mocks) {
+				burned := sdk.NewCoins(sdk.NewInt64Coin("uatone", 2))
+				minted := sdk.NewCoins(sdk.NewInt64Coin(types.Denom, 1))
+				m.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), toAddress, types.ModuleName, burned)
+				m.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, burned)
+				m.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, minted)
+				m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, toAddress, minted)
+			},
+			expectedMinted: sdk.NewInt64Coin(types.Denom, 1),
+			expectedRate:   "0.666666666666666666",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := setupPhotonKeeper(t)
+			require.NoError(t, k.SetParams(ctx, tt.params))
+			m.bankKeeper.EXPECT().GetSupply(ctx, "uatone").Return(sdk.NewInt64Coin("uatone", tt.atoneSupply)).AnyTimes()
+			m.bankKeeper.EXPECT().GetSupply(ctx, types.Denom).Return(sdk.NewInt64Coin(types.Denom, tt.photonSupply)).AnyTimes()
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+			msgServer := keeper.NewMsgServerImpl(k)
+
+			res, err := msgServer.MintPhoton(ctx, types.NewMsgMintPhoton(toAddress, tt.amount))
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedMinted, res.Minted)
+			require.Equal(t, tt.expectedRate, res.ConversionRate)
+		})
+	}
+}
+
+func TestMsgServerUpdateParams(t *testing.T) {
+	k, _, ctx := setupPhotonKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: sdk.AccAddress("foo").String(),
+		Params:    types.NewParams(true, nil),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	require.False(t, k.GetParams(ctx).MintDisabled)
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: govAcct.String(),
+		Params:    types.NewParams(true, nil),
+	})
+	require.NoError(t, err)
+	require.True(t, k.GetParams(ctx).MintDisabled)
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// NewParamsValidator returns the validator of the photon MsgUpdateParams
+// carried by governance proposals, to be registered in the gov params
+// registry. It rejects the tx_fee_exceptions entries that are not the type URL
+// of a message known by the router, so a misspelled exception can't enter the
+// voting period.
+func NewParamsValidator(router *baseapp.MsgServiceRouter) func(sdk.Context, sdk.Msg) error {
+	return func(_ sdk.Context, msg sdk.Msg) error {
+		updateMsg, ok := msg.(*types.MsgUpdateParams)
+		if !ok {
+			return fmt.Errorf("unexpected message %T", msg)
+		}
+
+		for _, exception := range updateMsg.Params.TxFeeExceptions {
+			if exception == types.TxFeeExceptionWildcard {
+				continue
+			}
+			if router.HandlerByTypeURL(exception) == nil {
+				return fmt.Errorf("unknown tx fee exception %s", exception)
+			}
+		}
+
+		return nil
+	}
+}
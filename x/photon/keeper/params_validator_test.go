@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/atomone-hub/atomone/x/photon/keeper"
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestParamsValidator(t *testing.T) {
+	k, _, ctx := setupPhotonKeeper(t)
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(registry)
+	types.RegisterMsgServer(router, keeper.NewMsgServerImpl(k))
+	validator := keeper.NewParamsValidator(router)
+
+	tests := []struct {
+		name        string
+		exceptions  []string
+		expectedErr string
+	}{
+		{
+			name:       "ok: registered message",
+			exceptions: []string{"/atomone.photon.v1.MsgMintPhoton"},
+		},
+		{
+			name:       "ok: wildcard",
+			exceptions: []string{types.TxFeeExceptionWildcard},
+		},
+		{
+			name:        "fail: unknown message",
+			exceptions:  []string{"/atomone.photon.v1.MsgMintPhoton", "/atomone.photon.v1.MsgUnknown"},
+			expectedErr: "unknown tx fee exception /atomone.photon.v1.MsgUnknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator(ctx, &types.MsgUpdateParams{
+				Authority: govAcct.String(),
+				Params:    types.NewParams(false, tt.exceptions),
+			})
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// Simulation parameter constants
+const MintDisabled = "mint_disabled"
+
+// GenMintDisabled returns a randomized MintDisabled param.
+func GenMintDisabled(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// RandomizedGenState generates a random GenesisState for photon.
+func RandomizedGenState(simState *module.SimulationState) {
+	var mintDisabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintDisabled, &mintDisabled, simState.Rand,
+		func(r *rand.Rand) { mintDisabled = GenMintDisabled(r) },
+	)
+
+	// The simulated accounts hold no PHOTON, and the operations of the other
+	// modules pay their fees in the bond denom, so all the messages are
+	// exempted from the PHOTON fee rule.
+	photonGenesis := types.NewGenesisState(types.NewParams(mintDisabled, []string{types.TxFeeExceptionWildcard}))
+
+	bz, err := json.MarshalIndent(&photonGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated photon parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(photonGenesis)
+}
@@ -0,0 +1,22 @@
+// This file only used to generate mocks
+
+package testutil
+
+import (
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+// AccountKeeper extends photon's actual expected AccountKeeper.
+type AccountKeeper interface {
+	types.AccountKeeper
+}
+
+// BankKeeper extends photon's actual expected BankKeeper.
+type BankKeeper interface {
+	types.BankKeeper
+}
+
+// StakingKeeper extends photon's actual expected StakingKeeper.
+type StakingKeeper interface {
+	types.StakingKeeper
+}
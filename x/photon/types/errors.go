@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/photon module sentinel errors
+var (
+	ErrMintDisabled      = sdkerrors.Register(ModuleName, 10, "photon mint disabled")                               //nolint:staticcheck
+	ErrBurnInvalidDenom  = sdkerrors.Register(ModuleName, 20, "invalid burned amount denom")                        //nolint:staticcheck
+	ErrNoMintablePhotons = sdkerrors.Register(ModuleName, 30, "no mintable photon after rounding")                  //nolint:staticcheck
+	ErrInvalidGenesis    = sdkerrors.Register(ModuleName, 40, "invalid genesis state")                              //nolint:staticcheck
+	ErrInvalidSigner     = sdkerrors.Register(ModuleName, 50, "expected authority account as only signer")          //nolint:staticcheck
+	ErrInvalidFeeToken   = sdkerrors.Register(ModuleName, 60, "invalid fee token")                                  //nolint:staticcheck
+	ErrTooManyFeeCoins   = sdkerrors.Register(ModuleName, 70, "too many fee coins, only accepts fees in one denom") //nolint:staticcheck
+)
@@ -0,0 +1,10 @@
+package types
+
+// Photon module event types
+const (
+	EventTypeMintPhoton = "mint_photon"
+
+	AttributeKeyBurned         = "burned"
+	AttributeKeyMinted         = "minted"
+	AttributeKeyConversionRate = "conversion_rate"
+)
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AccountKeeper defines the expected account keeper (noalias)
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) types.ModuleAccountI
+}
+
+// BankKeeper defines the expected interface needed to burn ATONE and mint
+// PHOTON.
+type BankKeeper interface {
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the expected staking keeper, used to retrieve the
+// ATONE denom (noalias)
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+}
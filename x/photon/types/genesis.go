@@ -0,0 +1,18 @@
+package types
+
+// NewGenesisState creates a new genesis state for the photon module
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState defines the default photon genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// ValidateGenesis checks if the photon genesis state is valid
+func ValidateGenesis(data *GenesisState) error {
+	return data.Params.ValidateBasic()
+}
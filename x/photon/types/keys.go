@@ -0,0 +1,27 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "photon"
+
+	// StoreKey is the store key string for photon
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for photon
+	RouterKey = ModuleName
+
+	// Denom is the denom of the PHOTON token
+	Denom = "uphoton"
+
+	// MaxSupply is the maximum supply of PHOTON, 1 billion PHOTON
+	MaxSupply = 1_000_000_000_000_000 // uphoton
+)
+
+// Keys for photon store
+// Items are stored with the following key: values
+//
+// - 0x00: Params
+var (
+	// ParamsKey is the key to query all photon params
+	ParamsKey = []byte{0x00}
+)
@@ -0,0 +1,75 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _, _ sdk.Msg = &MsgMintPhoton{}, &MsgUpdateParams{}
+
+// NewMsgMintPhoton creates a new MsgMintPhoton instance
+//
+//nolint:interfacer
+func NewMsgMintPhoton(toAddress sdk.AccAddress, amount sdk.Coin) *MsgMintPhoton {
+	return &MsgMintPhoton{
+		ToAddress: toAddress.String(),
+		Amount:    amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgMintPhoton) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgMintPhoton) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgMintPhoton) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgMintPhoton) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgMintPhoton.
+func (msg MsgMintPhoton) GetSigners() []sdk.AccAddress {
+	toAddress, _ := sdk.AccAddressFromBech32(msg.ToAddress)
+	return []sdk.AccAddress{toAddress}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.ValidateBasic()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestMsgMintPhotonValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	tests := []struct {
+		name        string
+		msg         *types.MsgMintPhoton
+		expectedErr string
+	}{
+		{
+			name: "ok",
+			msg:  types.NewMsgMintPhoton(addr, sdk.NewInt64Coin("uatone", 1)),
+		},
+		{
+			name:        "fail: invalid address",
+			msg:         &types.MsgMintPhoton{ToAddress: "xxx", Amount: sdk.NewInt64Coin("uatone", 1)},
+			expectedErr: "invalid to address: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:        "fail: zero amount",
+			msg:         types.NewMsgMintPhoton(addr, sdk.NewInt64Coin("uatone", 0)),
+			expectedErr: "0uatone: invalid coins",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
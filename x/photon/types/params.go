@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxFeeExceptionWildcard is the tx_fee_exceptions entry exempting all the
+// messages from the PHOTON fee rule.
+const TxFeeExceptionWildcard = "*"
+
+// NewParams creates a new Params instance
+func NewParams(mintDisabled bool, txFeeExceptions []string) Params {
+	return Params{
+		MintDisabled:    mintDisabled,
+		TxFeeExceptions: txFeeExceptions,
+	}
+}
+
+// DefaultParams returns the default photon parameters
+func DefaultParams() Params {
+	return NewParams(false, []string{
+		// MsgMintPhoton can pay its fees in ATONE, otherwise no PHOTON could
+		// ever be minted.
+		sdk.MsgTypeURL(&MsgMintPhoton{}),
+	})
+}
+
+// ValidateBasic performs basic validation on photon parameters.
+func (p Params) ValidateBasic() error {
+	seen := make(map[string]bool, len(p.TxFeeExceptions))
+	for _, exception := range p.TxFeeExceptions {
+		if exception != TxFeeExceptionWildcard && !strings.HasPrefix(exception, "/") {
+			return fmt.Errorf("invalid tx fee exception %q: must be a message type URL or %q", exception, TxFeeExceptionWildcard)
+		}
+		if seen[exception] {
+			return fmt.Errorf("duplicate tx fee exception %q", exception)
+		}
+		seen[exception] = true
+	}
+
+	return nil
+}
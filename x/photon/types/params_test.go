@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/atomone-hub/atomone/x/photon/types"
+)
+
+func TestParamsValidateBasic(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      types.Params
+		expectedErr string
+	}{
+		{
+			name:   "ok: default params",
+			params: types.DefaultParams(),
+		},
+		{
+			name:   "ok: no exceptions",
+			params: types.NewParams(false, nil),
+		},
+		{
+			name:   "ok: wildcard",
+			params: types.NewParams(false, []string{types.TxFeeExceptionWildcard}),
+		},
+		{
+			name:        "fail: not a type URL",
+			params:      types.NewParams(false, []string{"atomone.photon.v1.MsgMintPhoton"}),
+			expectedErr: `invalid tx fee exception "atomone.photon.v1.MsgMintPhoton": must be a message type URL or "*"`,
+		},
+		{
+			name:        "fail: empty exception",
+			params:      types.NewParams(false, []string{""}),
+			expectedErr: `invalid tx fee exception "": must be a message type URL or "*"`,
+		},
+		{
+			name:        "fail: duplicate exception",
+			params:      types.NewParams(false, []string{"/atomone.photon.v1.MsgMintPhoton", "/atomone.photon.v1.MsgMintPhoton"}),
+			expectedErr: `duplicate tx fee exception "/atomone.photon.v1.MsgMintPhoton"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.ValidateBasic()
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package treasury
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/keeper"
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+// BeginBlocker collects the treasury share of the tokens minted in the block.
+func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	collected, err := k.CollectMintShare(ctx)
+	if err != nil {
+		// the fee collector holds at least the tokens minted in the block,
+		// failing to collect the share is not worth halting the chain.
+		ctx.Logger().Error("failed to collect treasury mint share", "error", err)
+		return
+	}
+
+	if !collected.IsZero() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCollectMintShare,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, collected.String()),
+			),
+		)
+	}
+}
+
+// EndBlocker releases the amounts vested by the streams.
+func EndBlocker(ctx sdk.Context, k *keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.ReleaseStreams(ctx)
+}
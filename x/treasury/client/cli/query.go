@@ -0,0 +1,294 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+// FlagStatus is the status flag of the budgets query.
+const FlagStatus = "status"
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	// Group treasury queries under a subcommand
+	treasuryQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the treasury module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	treasuryQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryTreasury(),
+		GetCmdQueryBudget(),
+		GetCmdQueryBudgets(),
+		GetCmdQueryStream(),
+		GetCmdQueryStreams(),
+	)
+
+	return treasuryQueryCmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the parameters of the treasury module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the parameters of the treasury module.
+
+Example:
+$ %s query treasury params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTreasury implements the query treasury command.
+func GetCmdQueryTreasury() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury",
+		Short: "Query the treasury balance and the funds allocated to the active budgets",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the treasury balance, the funds allocated to the active budgets and the
+funds available for new budgets.
+
+Example:
+$ %s query treasury treasury
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Treasury(cmd.Context(), &types.QueryTreasuryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBudget implements the query budget command.
+func GetCmdQueryBudget() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "budget [budget-id]",
+		Short: "Query a budget and its remaining allocation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a budget and the sum of its unreleased milestones.
+
+Example:
+$ %s query treasury budget 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			budgetID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("budget-id %s not a valid uint, please input a valid budget-id", args[0])
+			}
+
+			res, err := queryClient.Budget(cmd.Context(), &types.QueryBudgetRequest{BudgetId: budgetID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBudgets implements the query budgets command.
+func GetCmdQueryBudgets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "budgets",
+		Short: "Query the budgets, optionally filtered by status",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the budgets, optionally filtered by status (active, completed or
+canceled).
+
+Example:
+$ %s query treasury budgets --status active
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			statusStr, _ := cmd.Flags().GetString(FlagStatus)
+			var budgetStatus types.BudgetStatus
+			if statusStr != "" {
+				budgetStatus, err = types.BudgetStatusFromString(statusStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Budgets(cmd.Context(), &types.QueryBudgetsRequest{
+				Status:     budgetStatus,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "(optional) filter budgets by budget status, status: active/completed/canceled")
+	flags.AddPaginationFlagsToCmd(cmd, "budgets")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStream implements the query stream command.
+func GetCmdQueryStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream [stream-id]",
+		Short: "Query an active stream and its remaining amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query an active stream and the amount it has left to disburse.
+
+Example:
+$ %s query treasury stream 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream-id %s not a valid uint, please input a valid stream-id", args[0])
+			}
+
+			res, err := queryClient.Stream(cmd.Context(), &types.QueryStreamRequest{StreamId: streamID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStreams implements the query streams command.
+func GetCmdQueryStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "streams",
+		Short: "Query the active streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the active streams, disbursing community pool grants over time.
+
+Example:
+$ %s query treasury streams
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Streams(cmd.Context(), &types.QueryStreamsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "streams")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+// NewTxCmd returns the transaction commands for this module
+func NewTxCmd() *cobra.Command {
+	treasuryTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Treasury transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	treasuryTxCmd.AddCommand(
+		NewCmdFundTreasury(),
+	)
+
+	return treasuryTxCmd
+}
+
+// NewCmdFundTreasury implements the fund treasury command.
+func NewCmdFundTreasury() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Send coins to the treasury",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send the given amount of coins to the treasury. The budgets allocating the
+treasury funds are created through governance proposals.
+
+Example:
+$ %s tx treasury fund 1000000uatone --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundTreasury(clientCtx.GetFromAddress(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,54 @@
+package treasury
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/keeper"
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+// InitGenesis - store genesis budgets and streams
+func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, k *keeper.Keeper, data *types.GenesisState) {
+	// ensure the module account is set, the budgets are funded by its balance
+	moduleAcc := ak.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(fmt.Sprintf("%s module params has not been set", types.ModuleName))
+	}
+
+	k.SetBudgetID(ctx, data.StartingBudgetId)
+	for _, budget := range data.Budgets {
+		k.SetBudget(ctx, budget)
+	}
+
+	k.SetStreamID(ctx, data.StartingStreamId)
+	for _, stream := range data.Streams {
+		k.SetStream(ctx, stream)
+	}
+
+	// check that the treasury balance covers the active budgets and streams
+	balance := k.GetTreasuryBalance(ctx)
+	if allocated := k.GetAllocatedFunds(ctx); !balance.IsAllGTE(allocated) {
+		panic(fmt.Sprintf("treasury balance %s doesn't cover the allocated funds %s", balance, allocated))
+	}
+}
+
+// ExportGenesis - output genesis budgets and streams
+func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
+	startingBudgetID, err := k.GetBudgetID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	startingStreamID, err := k.GetStreamID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), startingBudgetID, k.GetBudgets(ctx), startingStreamID, k.GetStreams(ctx))
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+// GetBudgetID gets the id of the next budget.
+func (k Keeper) GetBudgetID(ctx sdk.Context) (budgetID uint64, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BudgetIDKey)
+	if bz == nil {
+		return 0, errors.New("initial budget ID hasn't been set")
+	}
+
+	budgetID = types.GetBudgetIDFromBytes(bz)
+	return budgetID, nil
+}
+
+// SetBudgetID sets the id of the next budget.
+func (k Keeper) SetBudgetID(ctx sdk.Context, budgetID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BudgetIDKey, types.GetBudgetIDBytes(budgetID))
+}
+
+// GetBudget gets a budget from the store.
+func (k Keeper) GetBudget(ctx sdk.Context, budgetID uint64) (types.Budget, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BudgetKey(budgetID))
+	if bz == nil {
+		return types.Budget{}, false
+	}
+
+	var budget types.Budget
+	k.cdc.MustUnmarshal(bz, &budget)
+	return budget, true
+}
+
+// SetBudget sets a budget to the store.
+func (k Keeper) SetBudget(ctx sdk.Context, budget types.Budget) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BudgetKey(budget.Id), k.cdc.MustMarshal(&budget))
+}
+
+// IterateBudgets iterates over all the budgets and performs a callback
+// function.
+func (k Keeper) IterateBudgets(ctx sdk.Context, cb func(budget types.Budget) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BudgetKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var budget types.Budget
+		k.cdc.MustUnmarshal(iterator.Value(), &budget)
+		if cb(budget) {
+			break
+		}
+	}
+}
+
+// GetBudgets returns all the budgets from the store.
+func (k Keeper) GetBudgets(ctx sdk.Context) (budgets []types.Budget) {
+	k.IterateBudgets(ctx, func(budget types.Budget) bool {
+		budgets = append(budgets, budget)
+		return false
+	})
+	return budgets
+}
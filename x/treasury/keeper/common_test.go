@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/keeper"
+	treasurytestutil "github.com/atomone-hub/atomone/x/treasury/testutil"
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+var (
+	govAcct      = authtypes.NewModuleAddress("gov")
+	treasuryAcct = authtypes.NewModuleAddress(types.ModuleName)
+)
+
+type mocks struct {
+	acctKeeper  *treasurytestutil.MockAccountKeeper
+	bankKeeper  *treasurytestutil.MockBankKeeper
+	distrKeeper *treasurytestutil.MockDistributionKeeper
+	mintKeeper  *treasurytestutil.MockMintKeeper
+}
+
+// setupTreasuryKeeper creates a treasuryKeeper as well as all its
+// dependencies.
+func setupTreasuryKeeper(t *testing.T) (*keeper.Keeper, mocks, sdk.Context) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	// gomock initializations
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		acctKeeper:  treasurytestutil.NewMockAccountKeeper(ctrl),
+		bankKeeper:  treasurytestutil.NewMockBankKeeper(ctrl),
+		distrKeeper: treasurytestutil.NewMockDistributionKeeper(ctrl),
+		mintKeeper:  treasurytestutil.NewMockMintKeeper(ctrl),
+	}
+	m.acctKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(treasuryAcct).AnyTimes()
+
+	k := keeper.NewKeeper(encCfg.Codec, key, govAcct.String(), m.acctKeeper, m.bankKeeper, m.distrKeeper, m.mintKeeper)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+	k.SetBudgetID(ctx, types.DefaultStartingBudgetID)
+	k.SetStreamID(ctx, types.DefaultStartingStreamID)
+
+	return k, m, ctx
+}
+
+// setTreasuryBalance sets the balance returned by the bank keeper mock for the
+// treasury module account.
+func setTreasuryBalance(m mocks, balance sdk.Coins) {
+	m.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), treasuryAcct).Return(balance).AnyTimes()
+}
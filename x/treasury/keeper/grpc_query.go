@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params queries the treasury parameters
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Treasury queries the treasury balance and allocated funds
+func (k Keeper) Treasury(c context.Context, req *types.QueryTreasuryRequest) (*types.QueryTreasuryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	balance := k.GetTreasuryBalance(ctx)
+	allocated := k.GetAllocatedFunds(ctx)
+	available, _ := balance.SafeSub(allocated...)
+
+	return &types.QueryTreasuryResponse{
+		Balance:   balance,
+		Allocated: allocated,
+		Available: available,
+	}, nil
+}
+
+// Budget queries a budget by id
+func (k Keeper) Budget(c context.Context, req *types.QueryBudgetRequest) (*types.QueryBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.BudgetId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "budget id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	budget, ok := k.GetBudget(ctx, req.BudgetId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "budget %d doesn't exist", req.BudgetId)
+	}
+
+	return &types.QueryBudgetResponse{Budget: budget, Remaining: budget.Remaining()}, nil
+}
+
+// Budgets queries all the budgets, filtered by status if specified
+func (k Keeper) Budgets(c context.Context, req *types.QueryBudgetsRequest) (*types.QueryBudgetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BudgetKeyPrefix)
+
+	var budgets []types.Budget
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var budget types.Budget
+		if err := k.cdc.Unmarshal(value, &budget); err != nil {
+			return false, err
+		}
+
+		if req.Status != types.StatusNil && budget.Status != req.Status {
+			return false, nil
+		}
+
+		if accumulate {
+			budgets = append(budgets, budget)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBudgetsResponse{Budgets: budgets, Pagination: pageRes}, nil
+}
+
+// Stream queries an active stream by id
+func (k Keeper) Stream(c context.Context, req *types.QueryStreamRequest) (*types.QueryStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.StreamId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "stream id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stream, ok := k.GetStream(ctx, req.StreamId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "stream %d doesn't exist", req.StreamId)
+	}
+
+	return &types.QueryStreamResponse{Stream: stream, Remaining: stream.Remaining()}, nil
+}
+
+// Streams queries all the active streams
+func (k Keeper) Streams(c context.Context, req *types.QueryStreamsRequest) (*types.QueryStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StreamKeyPrefix)
+
+	var streams []types.Stream
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var stream types.Stream
+		if err := k.cdc.Unmarshal(value, &stream); err != nil {
+			return err
+		}
+
+		streams = append(streams, stream)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+func TestQueryTreasury(t *testing.T) {
+	milestones := newMilestones()
+	k, m, ctx := setupTreasuryKeeper(t)
+	setTreasuryBalance(m, sdk.NewCoins(sdk.NewInt64Coin("uatone", 1000)))
+	k.SetBudget(ctx, types.NewBudget(1, "title", recipient, milestones, ctx.BlockTime()))
+	canceled := types.NewBudget(2, "title", recipient, milestones, ctx.BlockTime())
+	canceled.Status = types.StatusCanceled
+	k.SetBudget(ctx, canceled)
+
+	res, err := k.Treasury(ctx, &types.QueryTreasuryRequest{})
+
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 1000)), res.Balance)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 300)), res.Allocated)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 700)), res.Available)
+}
+
+func TestQueryBudget(t *testing.T) {
+	milestones := newMilestones()
+	k, _, ctx := setupTreasuryKeeper(t)
+	budget := types.NewBudget(1, "title", recipient, milestones, ctx.BlockTime())
+	budget.Milestones[0].Released = true
+	k.SetBudget(ctx, budget)
+
+	_, err := k.Budget(ctx, &types.QueryBudgetRequest{BudgetId: 0})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = budget id can not be 0")
+	_, err = k.Budget(ctx, &types.QueryBudgetRequest{BudgetId: 2})
+	require.EqualError(t, err, "rpc error: code = NotFound desc = budget 2 doesn't exist")
+
+	res, err := k.Budget(ctx, &types.QueryBudgetRequest{BudgetId: 1})
+	require.NoError(t, err)
+	require.Equal(t, budget, res.Budget)
+	require.Equal(t, milestones[1].Amount, res.Remaining)
+}
+
+func TestQueryBudgets(t *testing.T) {
+	milestones := newMilestones()
+	k, _, ctx := setupTreasuryKeeper(t)
+	var active []types.Budget
+	for id := uint64(1); id <= 4; id++ {
+		budget := types.NewBudget(id, "title", recipient, milestones, ctx.BlockTime())
+		if id%2 == 0 {
+			budget.Status = types.StatusCanceled
+		} else {
+			active = append(active, budget)
+		}
+		k.SetBudget(ctx, budget)
+	}
+
+	res, err := k.Budgets(ctx, &types.QueryBudgetsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Budgets, 4)
+
+	res, err = k.Budgets(ctx, &types.QueryBudgetsRequest{Status: types.StatusActive})
+	require.NoError(t, err)
+	require.Equal(t, active, res.Budgets)
+
+	res, err = k.Budgets(ctx, &types.QueryBudgetsRequest{
+		Status:     types.StatusActive,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, active[:1], res.Budgets)
+	require.NotNil(t, res.Pagination.NextKey)
+}
+
+func TestQueryStreams(t *testing.T) {
+	k, _, ctx := setupTreasuryKeeper(t)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatone", 1000))
+	var streams []types.Stream
+	for id := uint64(1); id <= 3; id++ {
+		stream := types.NewStream(id, "title", recipient, amount, ctx.BlockTime(), ctx.BlockTime().Add(time.Hour), 0)
+		k.SetStream(ctx, stream)
+		streams = append(streams, stream)
+	}
+	streams[0].Released = sdk.NewCoins(sdk.NewInt64Coin("uatone", 400))
+	k.SetStream(ctx, streams[0])
+
+	_, err := k.Stream(ctx, &types.QueryStreamRequest{StreamId: 0})
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = stream id can not be 0")
+	_, err = k.Stream(ctx, &types.QueryStreamRequest{StreamId: 4})
+	require.EqualError(t, err, "rpc error: code = NotFound desc = stream 4 doesn't exist")
+
+	res, err := k.Stream(ctx, &types.QueryStreamRequest{StreamId: 1})
+	require.NoError(t, err)
+	require.Equal(t, streams[0], res.Stream)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 600)), res.Remaining)
+
+	streamsRes, err := k.Streams(ctx, &types.QueryStreamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, streams, streamsRes.Streams)
+
+	streamsRes, err = k.Streams(ctx, &types.QueryStreamsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, streams[:2], streamsRes.Streams)
+	require.NotNil(t, streamsRes.Pagination.NextKey)
+}
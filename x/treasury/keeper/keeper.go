@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+// Keeper defines the treasury module Keeper
+type Keeper struct {
+	authKeeper  types.AccountKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
+	mintKeeper  types.MintKeeper
+
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey storetypes.StoreKey
+
+	// The codec for binary encoding/decoding.
+	cdc codec.BinaryCodec
+
+	// the address capable of executing the budget messages. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper returns a treasury keeper. It handles the budgets allocating the
+// funds held by the treasury module account, and the streams disbursing the
+// funds granted from the community pool.
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, authority string,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper, mintKeeper types.MintKeeper,
+) *Keeper {
+	// ensure treasury module account is set
+	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address: %s", authority))
+	}
+
+	return &Keeper{
+		storeKey:    key,
+		authKeeper:  authKeeper,
+		bankKeeper:  bankKeeper,
+		distrKeeper: distrKeeper,
+		mintKeeper:  mintKeeper,
+		cdc:         cdc,
+		authority:   authority,
+	}
+}
+
+// GetAuthority returns the x/treasury module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams gets the treasury module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the treasury module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetTreasuryBalance returns the balance of the treasury module account.
+func (k Keeper) GetTreasuryBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.authKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// GetAllocatedFunds returns the sum of the unreleased milestones of the
+// active budgets and of the amounts left to disburse by the streams.
+func (k Keeper) GetAllocatedFunds(ctx sdk.Context) sdk.Coins {
+	allocated := sdk.NewCoins()
+	k.IterateBudgets(ctx, func(budget types.Budget) bool {
+		allocated = allocated.Add(budget.Remaining()...)
+		return false
+	})
+	k.IterateStreams(ctx, func(stream types.Stream) bool {
+		allocated = allocated.Add(stream.Remaining()...)
+		return false
+	})
+	return allocated
+}
+
+// GetAvailableFunds returns the part of the treasury balance not allocated to
+// the active budgets and streams.
+func (k Keeper) GetAvailableFunds(ctx sdk.Context) sdk.Coins {
+	available, _ := k.GetTreasuryBalance(ctx).SafeSub(k.GetAllocatedFunds(ctx)...)
+	return available
+}
+
+// CollectMintShare moves the mint_share of the tokens minted by x/mint in the
+// current block from the fee collector to the treasury. It must be called
+// after the x/mint BeginBlocker and before the x/distribution one, and returns
+// the amount collected.
+func (k Keeper) CollectMintShare(ctx sdk.Context) (sdk.Coins, error) {
+	mintShare := k.GetParams(ctx).MintShare
+	if mintShare.IsNil() || mintShare.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	minted := k.mintKeeper.GetMinter(ctx).BlockProvision(k.mintKeeper.GetParams(ctx))
+	share := sdk.NewCoins(sdk.NewCoin(minted.Denom, math.LegacyNewDecFromInt(minted.Amount).Mul(mintShare).TruncateInt()))
+	if share.IsZero() {
+		return share, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, share); err != nil {
+		return nil, err
+	}
+	return share, nil
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+func TestCollectMintShare(t *testing.T) {
+	// the annual provisions are chosen so that the block provision is 1000uatone
+	mintParams := minttypes.DefaultParams()
+	mintParams.MintDenom = "uatone"
+	minter := minttypes.NewMinter(math.LegacyZeroDec(), math.LegacyNewDec(1000*int64(mintParams.BlocksPerYear)))
+	tests := []struct {
+		name              string
+		mintShare         math.LegacyDec
+		expectedCollected sdk.Coins
+	}{
+		{
+			name:              "disabled",
+			mintShare:         math.LegacyZeroDec(),
+			expectedCollected: sdk.NewCoins(),
+		},
+		{
+			name:              "share of the block provision",
+			mintShare:         math.LegacyNewDecWithPrec(25, 2),
+			expectedCollected: sdk.NewCoins(sdk.NewInt64Coin("uatone", 250)),
+		},
+		{
+			name:              "share truncated to zero",
+			mintShare:         math.LegacyNewDecWithPrec(1, 4),
+			expectedCollected: sdk.NewCoins(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := setupTreasuryKeeper(t)
+			require.NoError(t, k.SetParams(ctx, types.NewParams(tt.mintShare)))
+			if tt.mintShare.IsPositive() {
+				m.mintKeeper.EXPECT().GetMinter(ctx).Return(minter)
+				m.mintKeeper.EXPECT().GetParams(ctx).Return(mintParams)
+			}
+			if !tt.expectedCollected.IsZero() {
+				m.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, tt.expectedCollected)
+			}
+
+			collected, err := k.CollectMintShare(ctx)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCollected, collected)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the treasury MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// FundTreasury implements the MsgServer.FundTreasury method.
+func (k msgServer) FundTreasury(goCtx context.Context, msg *types.MsgFundTreasury) (*types.MsgFundTreasuryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundTreasury,
+			sdk.NewAttribute(types.AttributeKeyDepositor, msg.Depositor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgFundTreasuryResponse{}, nil
+}
+
+// CreateBudget implements the MsgServer.CreateBudget method. The budget total
+// must be covered by the available treasury funds.
+func (k msgServer) CreateBudget(goCtx context.Context, msg *types.MsgCreateBudget) (*types.MsgCreateBudgetResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	budgetID, err := k.GetBudgetID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	budget := types.NewBudget(budgetID, msg.Title, recipient, msg.Milestones, ctx.BlockTime())
+
+	total := budget.Total()
+	if available := k.GetAvailableFunds(ctx); !available.IsAllGTE(total) {
+		return nil, errors.Wrapf(types.ErrInsufficientFunds, "budget total %s, available %s", total, available)
+	}
+
+	k.SetBudget(ctx, budget)
+	k.SetBudgetID(ctx, budgetID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateBudget,
+			sdk.NewAttribute(types.AttributeKeyBudgetID, strconv.FormatUint(budgetID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, total.String()),
+		),
+	)
+
+	return &types.MsgCreateBudgetResponse{BudgetId: budgetID}, nil
+}
+
+// ReleaseMilestone implements the MsgServer.ReleaseMilestone method. The
+// milestone amount is sent to the budget recipient, and the budget is
+// completed once all its milestones are released.
+func (k msgServer) ReleaseMilestone(goCtx context.Context, msg *types.MsgReleaseMilestone) (*types.MsgReleaseMilestoneResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	budget, err := k.getActiveBudget(ctx, msg.BudgetId)
+	if err != nil {
+		return nil, err
+	}
+	if int(msg.Milestone) >= len(budget.Milestones) {
+		return nil, errors.Wrapf(types.ErrInvalidMilestone, "budget %d has no milestone %d", msg.BudgetId, msg.Milestone)
+	}
+	milestone := &budget.Milestones[msg.Milestone]
+	if milestone.Released {
+		return nil, errors.Wrapf(types.ErrInvalidMilestone, "milestone %d of budget %d already released", msg.Milestone, msg.BudgetId)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(budget.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, milestone.Amount); err != nil {
+		return nil, err
+	}
+
+	milestone.Released = true
+	if budget.Remaining().IsZero() {
+		budget.Status = types.StatusCompleted
+	}
+	k.SetBudget(ctx, budget)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseMilestone,
+			sdk.NewAttribute(types.AttributeKeyBudgetID, strconv.FormatUint(msg.BudgetId, 10)),
+			sdk.NewAttribute(types.AttributeKeyMilestone, strconv.FormatUint(uint64(msg.Milestone), 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, budget.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, milestone.Amount.String()),
+		),
+	)
+
+	return &types.MsgReleaseMilestoneResponse{}, nil
+}
+
+// CancelBudget implements the MsgServer.CancelBudget method. The unreleased
+// milestones of the budget return to the available treasury funds.
+func (k msgServer) CancelBudget(goCtx context.Context, msg *types.MsgCancelBudget) (*types.MsgCancelBudgetResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	budget, err := k.getActiveBudget(ctx, msg.BudgetId)
+	if err != nil {
+		return nil, err
+	}
+
+	budget.Status = types.StatusCanceled
+	k.SetBudget(ctx, budget)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelBudget,
+			sdk.NewAttribute(types.AttributeKeyBudgetID, strconv.FormatUint(msg.BudgetId, 10)),
+		),
+	)
+
+	return &types.MsgCancelBudgetResponse{}, nil
+}
+
+// CreateStream implements the MsgServer.CreateStream method. The stream
+// amount is moved from the community pool to the treasury module account,
+// which disburses it to the recipient over time.
+func (k msgServer) CreateStream(goCtx context.Context, msg *types.MsgCreateStream) (*types.MsgCreateStreamResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Recipient)
+	}
+
+	streamID, err := k.GetStreamID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream := types.NewStream(streamID, msg.Title, recipient, msg.Amount, msg.StartTime, msg.EndTime, msg.Tranches)
+
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, msg.Amount, k.authKeeper.GetModuleAddress(types.ModuleName)); err != nil {
+		return nil, err
+	}
+
+	k.SetStream(ctx, stream)
+	k.SetStreamID(ctx, streamID+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgCreateStreamResponse{StreamId: streamID}, nil
+}
+
+// UpdateParams implements the MsgServer.UpdateParams method.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// getActiveBudget returns the budget if it exists and is active.
+func (k Keeper) getActiveBudget(ctx sdk.Context, budgetID uint64) (types.Budget, error) {
+	budget, ok := k.GetBudget(ctx, budgetID)
+	if !ok {
+		return types.Budget{}, errors.Wrapf(types.ErrUnknownBudget, "%d", budgetID)
+	}
+	if budget.Status != types.StatusActive {
+		return types.Budget{}, errors.Wrapf(types.ErrInactiveBudget, "budget %d is %s", budgetID, budget.Status)
+	}
+	return budget, nil
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/keeper"
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+var recipient = sdk.AccAddress("recipient___________")
+
+// newMilestones returns the milestones of the test budgets, a budget of
+// 300uatone.
+func newMilestones() []types.Milestone {
+	return []types.Milestone{
+		types.NewMilestone("design", sdk.NewCoins(sdk.NewInt64Coin("uatone", 100))),
+		types.NewMilestone("implementation", sdk.NewCoins(sdk.NewInt64Coin("uatone", 200))),
+	}
+}
+
+func TestMsgServerFundTreasury(t *testing.T) {
+	k, m, ctx := setupTreasuryKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	depositor := sdk.AccAddress("depositor___________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatone", 1000))
+	m.bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount)
+
+	_, err := msgServer.FundTreasury(ctx, types.NewMsgFundTreasury(depositor, amount))
+
+	require.NoError(t, err)
+}
+
+func TestMsgServerCreateBudget(t *testing.T) {
+	milestones := newMilestones()
+	tests := []struct {
+		name        string
+		authority   sdk.AccAddress
+		balance     sdk.Coins
+		allocated   []types.Milestone
+		expectedErr string
+	}{
+		{
+			name:        "fail: invalid authority",
+			authority:   sdk.AccAddress("foo"),
+			expectedErr: "invalid authority; expected " + govAcct.String() + ", got " + sdk.AccAddress("foo").String() + ": expected authority account as only signer",
+		},
+		{
+			name:        "fail: insufficient balance",
+			authority:   govAcct,
+			balance:     sdk.NewCoins(sdk.NewInt64Coin("uatone", 299)),
+			expectedErr: "budget total 300uatone, available 299uatone: insufficient available treasury funds",
+		},
+		{
+			name:      "fail: balance allocated to another budget",
+			authority: govAcct,
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("uatone", 500)),
+			allocated: []types.Milestone{
+				types.NewMilestone("other", sdk.NewCoins(sdk.NewInt64Coin("uatone", 201))),
+			},
+			expectedErr: "budget total 300uatone, available 299uatone: insufficient available treasury funds",
+		},
+		{
+			name:      "ok",
+			authority: govAcct,
+			balance:   sdk.NewCoins(sdk.NewInt64Coin("uatone", 500)),
+			allocated: []types.Milestone{
+				types.NewMilestone("other", sdk.NewCoins(sdk.NewInt64Coin("uatone", 200))),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := setupTreasuryKeeper(t)
+			msgServer := keeper.NewMsgServerImpl(k)
+			setTreasuryBalance(m, tt.balance)
+			budgetID := types.DefaultStartingBudgetID
+			if tt.allocated != nil {
+				k.SetBudget(ctx, types.NewBudget(budgetID, "other", recipient, tt.allocated, ctx.BlockTime()))
+				budgetID++
+				k.SetBudgetID(ctx, budgetID)
+			}
+
+			res, err := msgServer.CreateBudget(ctx, types.NewMsgCreateBudget(tt.authority, "title", recipient, milestones))
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, budgetID, res.BudgetId)
+			budget, found := k.GetBudget(ctx, budgetID)
+			require.True(t, found)
+			require.Equal(t, types.NewBudget(budgetID, "title", recipient, milestones, ctx.BlockTime()), budget)
+			nextID, err := k.GetBudgetID(ctx)
+			require.NoError(t, err)
+			require.Equal(t, budgetID+1, nextID)
+		})
+	}
+}
+
+func TestMsgServerReleaseMilestone(t *testing.T) {
+	milestones := newMilestones()
+	k, m, ctx := setupTreasuryKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	k.SetBudget(ctx, types.NewBudget(1, "title", recipient, milestones, ctx.BlockTime()))
+
+	_, err := msgServer.ReleaseMilestone(ctx, types.NewMsgReleaseMilestone(sdk.AccAddress("foo"), 1, 0))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = msgServer.ReleaseMilestone(ctx, types.NewMsgReleaseMilestone(govAcct, 2, 0))
+	require.EqualError(t, err, "2: unknown budget")
+	_, err = msgServer.ReleaseMilestone(ctx, types.NewMsgReleaseMilestone(govAcct, 1, 2))
+	require.EqualError(t, err, "budget 1 has no milestone 2: invalid milestone")
+
+	m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, milestones[1].Amount)
+	_, err = msgServer.ReleaseMilestone(ctx, types.NewMsgReleaseMilestone(govAcct, 1, 1))
+	require.NoError(t, err)
+	budget, _ := k.GetBudget(ctx, 1)
+	require.Equal(t, types.StatusActive, budget.Status)
+	require.True(t, budget.Milestones[1].Released)
+	require.Equal(t, milestones[0].Amount, budget.Remaining())
+
+	_, err = msgServer.ReleaseMilestone(ctx, types.NewMsgReleaseMilestone(govAcct, 1, 1))
+	require.EqualError(t, err, "milestone 1 of budget 1 already released: invalid milestone")
+
+	// releasing the last milestone completes the budget
+	m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, milestones[0].Amount)
+	_, err = msgServer.ReleaseMilestone(ctx, types.NewMsgReleaseMilestone(govAcct, 1, 0))
+	require.NoError(t, err)
+	budget, _ = k.GetBudget(ctx, 1)
+	require.Equal(t, types.StatusCompleted, budget.Status)
+
+	_, err = msgServer.ReleaseMilestone(ctx, types.NewMsgReleaseMilestone(govAcct, 1, 0))
+	require.EqualError(t, err, "budget 1 is BUDGET_STATUS_COMPLETED: inactive budget")
+}
+
+func TestMsgServerCancelBudget(t *testing.T) {
+	milestones := newMilestones()
+	k, m, ctx := setupTreasuryKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	setTreasuryBalance(m, sdk.NewCoins(sdk.NewInt64Coin("uatone", 300)))
+	k.SetBudget(ctx, types.NewBudget(1, "title", recipient, milestones, ctx.BlockTime()))
+	require.True(t, k.GetAvailableFunds(ctx).IsZero())
+
+	_, err := msgServer.CancelBudget(ctx, types.NewMsgCancelBudget(sdk.AccAddress("foo"), 1))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = msgServer.CancelBudget(ctx, types.NewMsgCancelBudget(govAcct, 1))
+	require.NoError(t, err)
+	budget, _ := k.GetBudget(ctx, 1)
+	require.Equal(t, types.StatusCanceled, budget.Status)
+	// the unreleased milestones are available again
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 300)), k.GetAvailableFunds(ctx))
+
+	_, err = msgServer.CancelBudget(ctx, types.NewMsgCancelBudget(govAcct, 1))
+	require.ErrorIs(t, err, types.ErrInactiveBudget)
+}
+
+func TestMsgServerUpdateParams(t *testing.T) {
+	tests := []struct {
+		name        string
+		msg         *types.MsgUpdateParams
+		expectedErr string
+	}{
+		{
+			name:        "fail: invalid authority",
+			msg:         &types.MsgUpdateParams{Authority: recipient.String(), Params: types.DefaultParams()},
+			expectedErr: "invalid authority; expected " + govAcct.String() + ", got " + recipient.String() + ": expected authority account as only signer",
+		},
+		{
+			name: "ok",
+			msg:  &types.MsgUpdateParams{Authority: govAcct.String(), Params: types.NewParams(math.LegacyNewDecWithPrec(1, 1))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, _, ctx := setupTreasuryKeeper(t)
+			msgServer := keeper.NewMsgServerImpl(k)
+
+			_, err := msgServer.UpdateParams(ctx, tt.msg)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.msg.Params, k.GetParams(ctx))
+		})
+	}
+}
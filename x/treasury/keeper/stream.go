@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+// GetStreamID gets the id of the next stream.
+func (k Keeper) GetStreamID(ctx sdk.Context) (streamID uint64, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StreamIDKey)
+	if bz == nil {
+		return 0, errors.New("initial stream ID hasn't been set")
+	}
+
+	streamID = types.GetStreamIDFromBytes(bz)
+	return streamID, nil
+}
+
+// SetStreamID sets the id of the next stream.
+func (k Keeper) SetStreamID(ctx sdk.Context, streamID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.StreamIDKey, types.GetStreamIDBytes(streamID))
+}
+
+// GetStream gets a stream from the store.
+func (k Keeper) GetStream(ctx sdk.Context, streamID uint64) (types.Stream, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StreamKey(streamID))
+	if bz == nil {
+		return types.Stream{}, false
+	}
+
+	var stream types.Stream
+	k.cdc.MustUnmarshal(bz, &stream)
+	return stream, true
+}
+
+// SetStream sets a stream to the store.
+func (k Keeper) SetStream(ctx sdk.Context, stream types.Stream) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.StreamKey(stream.Id), k.cdc.MustMarshal(&stream))
+}
+
+// DeleteStream deletes a stream from the store.
+func (k Keeper) DeleteStream(ctx sdk.Context, streamID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.StreamKey(streamID))
+}
+
+// IterateStreams iterates over all the streams and performs a callback
+// function.
+func (k Keeper) IterateStreams(ctx sdk.Context, cb func(stream types.Stream) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.StreamKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.Stream
+		k.cdc.MustUnmarshal(iterator.Value(), &stream)
+		if cb(stream) {
+			break
+		}
+	}
+}
+
+// GetStreams returns all the streams from the store.
+func (k Keeper) GetStreams(ctx sdk.Context) (streams []types.Stream) {
+	k.IterateStreams(ctx, func(stream types.Stream) bool {
+		streams = append(streams, stream)
+		return false
+	})
+	return streams
+}
+
+// ReleaseStreams sends to the recipients the amounts vested by the streams
+// since their last release. The streams whose whole amount is disbursed are
+// removed from the store. A failed release is logged and retried at the next
+// call.
+func (k Keeper) ReleaseStreams(ctx sdk.Context) {
+	// the streams are collected first, as the releases write to the store
+	for _, stream := range k.GetStreams(ctx) {
+		releasable := stream.Releasable(ctx.BlockTime())
+		if releasable.IsZero() {
+			continue
+		}
+
+		if err := k.releaseStream(ctx, stream, releasable); err != nil {
+			k.Logger(ctx).Error("failed to release stream", "stream", stream.Id, "err", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleaseStream,
+				sdk.NewAttribute(types.AttributeKeyStreamID, strconv.FormatUint(stream.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, releasable.String()),
+			),
+		)
+	}
+}
+
+// releaseStream sends an amount of the stream to its recipient and records
+// the release.
+func (k Keeper) releaseStream(ctx sdk.Context, stream types.Stream, amount sdk.Coins) error {
+	recipient, err := sdk.AccAddressFromBech32(stream.Recipient)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+
+	stream.Released = stream.Released.Add(amount...)
+	if stream.Remaining().IsZero() {
+		k.DeleteStream(ctx, stream.Id)
+	} else {
+		k.SetStream(ctx, stream)
+	}
+	return nil
+}
+
+// CommunityPoolSpend returns the amount a message moves from the community
+// pool: the amount of a MsgCreateStream, moved on the stream creation.
+func (k Keeper) CommunityPoolSpend(msg sdk.Msg) sdk.Coins {
+	if msg, ok := msg.(*types.MsgCreateStream); ok {
+		return msg.Amount
+	}
+	return nil
+}
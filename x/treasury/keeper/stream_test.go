@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/keeper"
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+func TestMsgServerCreateStream(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatone", 1000))
+	tests := []struct {
+		name        string
+		authority   sdk.AccAddress
+		setup       func(mocks)
+		expectedErr string
+	}{
+		{
+			name:        "fail: invalid authority",
+			authority:   sdk.AccAddress("foo"),
+			setup:       func(mocks) {},
+			expectedErr: "invalid authority; expected " + govAcct.String() + ", got " + sdk.AccAddress("foo").String() + ": expected authority account as only signer",
+		},
+		{
+			name:      "fail: blocked recipient",
+			authority: govAcct,
+			setup: func(m mocks) {
+				m.bankKeeper.EXPECT().BlockedAddr(recipient).Return(true)
+			},
+			expectedErr: recipient.String() + " is not allowed to receive funds: unauthorized",
+		},
+		{
+			name:      "fail: insufficient community pool",
+			authority: govAcct,
+			setup: func(m mocks) {
+				m.bankKeeper.EXPECT().BlockedAddr(recipient).Return(false)
+				m.distrKeeper.EXPECT().DistributeFromFeePool(gomock.Any(), amount, treasuryAcct).Return(errors.New("insufficient community pool"))
+			},
+			expectedErr: "insufficient community pool",
+		},
+		{
+			name:      "ok",
+			authority: govAcct,
+			setup: func(m mocks) {
+				m.bankKeeper.EXPECT().BlockedAddr(recipient).Return(false)
+				m.distrKeeper.EXPECT().DistributeFromFeePool(gomock.Any(), amount, treasuryAcct)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, m, ctx := setupTreasuryKeeper(t)
+			msgServer := keeper.NewMsgServerImpl(k)
+			tt.setup(m)
+			startTime := ctx.BlockTime()
+			endTime := startTime.Add(time.Hour)
+
+			res, err := msgServer.CreateStream(ctx, types.NewMsgCreateStream(tt.authority, "title", recipient, amount, startTime, endTime, 4))
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				_, found := k.GetStream(ctx, types.DefaultStartingStreamID)
+				require.False(t, found)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, types.DefaultStartingStreamID, res.StreamId)
+			stream, found := k.GetStream(ctx, res.StreamId)
+			require.True(t, found)
+			require.Equal(t, types.NewStream(res.StreamId, "title", recipient, amount, startTime, endTime, 4), stream)
+			nextID, err := k.GetStreamID(ctx)
+			require.NoError(t, err)
+			require.Equal(t, res.StreamId+1, nextID)
+			require.Equal(t, amount, k.GetAllocatedFunds(ctx))
+		})
+	}
+}
+
+func TestReleaseStreams(t *testing.T) {
+	k, m, ctx := setupTreasuryKeeper(t)
+	startTime := ctx.BlockTime()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatone", 1000))
+	linear := types.NewStream(1, "linear", recipient, amount, startTime, startTime.Add(time.Hour), 0)
+	tranches := types.NewStream(2, "tranches", recipient, amount, startTime, startTime.Add(time.Hour), 4)
+	k.SetStream(ctx, linear)
+	k.SetStream(ctx, tranches)
+
+	// nothing is vested at the start time
+	k.ReleaseStreams(ctx)
+
+	// a sixth of the linear stream is vested, and no tranche yet
+	ctx = ctx.WithBlockTime(startTime.Add(10 * time.Minute))
+	m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin("uatone", 166)))
+	k.ReleaseStreams(ctx)
+
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin("uatone", 334)))
+	m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin("uatone", 500)))
+	k.ReleaseStreams(ctx)
+
+	stream, found := k.GetStream(ctx, linear.Id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 500)), stream.Released)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 1000)), k.GetAllocatedFunds(ctx))
+
+	// a failed release is retried at the next call
+	ctx = ctx.WithBlockTime(startTime.Add(45 * time.Minute))
+	m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin("uatone", 250))).Return(errors.New("failure"))
+	m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin("uatone", 250)))
+	k.ReleaseStreams(ctx)
+	stream, found = k.GetStream(ctx, linear.Id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 500)), stream.Released)
+
+	// the completed streams are removed
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin("uatone", 500)))
+	m.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin("uatone", 250)))
+	k.ReleaseStreams(ctx)
+	require.Empty(t, k.GetStreams(ctx))
+	require.Empty(t, k.GetAllocatedFunds(ctx))
+}
+
+func TestCommunityPoolSpend(t *testing.T) {
+	k, _, _ := setupTreasuryKeeper(t)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatone", 1000))
+
+	msg := &types.MsgCreateStream{Authority: govAcct.String(), Recipient: recipient.String(), Amount: amount}
+	require.Equal(t, amount, k.CommunityPoolSpend(msg))
+	require.Empty(t, k.CommunityPoolSpend(&types.MsgUpdateParams{Authority: govAcct.String()}))
+}
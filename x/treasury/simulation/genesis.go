@@ -0,0 +1,45 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+// Simulation parameter constants
+const MintShare = "mint_share"
+
+// GenMintShare returns a randomized MintShare param.
+func GenMintShare(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(51)), 2)
+}
+
+// RandomizedGenState generates a GenesisState for treasury. The treasury
+// module account is not funded at genesis, so there are no budgets.
+func RandomizedGenState(simState *module.SimulationState) {
+	var mintShare math.LegacyDec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MintShare, &mintShare, simState.Rand,
+		func(r *rand.Rand) { mintShare = GenMintShare(r) },
+	)
+
+	treasuryGenesis := types.NewGenesisState(
+		types.NewParams(mintShare),
+		types.DefaultStartingBudgetID, nil, types.DefaultStartingStreamID, nil,
+	)
+
+	bz, err := json.MarshalIndent(&treasuryGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected treasury genesis state:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(treasuryGenesis)
+}
@@ -0,0 +1,27 @@
+// This file only used to generate mocks
+
+package testutil
+
+import (
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+// AccountKeeper extends treasury's actual expected AccountKeeper.
+type AccountKeeper interface {
+	types.AccountKeeper
+}
+
+// BankKeeper extends treasury's actual expected BankKeeper.
+type BankKeeper interface {
+	types.BankKeeper
+}
+
+// DistributionKeeper extends treasury's actual expected DistributionKeeper.
+type DistributionKeeper interface {
+	types.DistributionKeeper
+}
+
+// MintKeeper extends treasury's actual expected MintKeeper.
+type MintKeeper interface {
+	types.MintKeeper
+}
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	StatusNil       = BudgetStatus_BUDGET_STATUS_UNSPECIFIED
+	StatusActive    = BudgetStatus_BUDGET_STATUS_ACTIVE
+	StatusCompleted = BudgetStatus_BUDGET_STATUS_COMPLETED
+	StatusCanceled  = BudgetStatus_BUDGET_STATUS_CANCELED
+)
+
+// MaxTitleLength is the maximum length of a budget or stream title.
+const MaxTitleLength = 256
+
+// NewBudget creates a new active budget.
+//
+//nolint:interfacer
+func NewBudget(id uint64, title string, recipient sdk.AccAddress, milestones []Milestone, createTime time.Time) Budget {
+	return Budget{
+		Id:         id,
+		Title:      title,
+		Recipient:  recipient.String(),
+		Milestones: milestones,
+		Status:     StatusActive,
+		CreateTime: createTime,
+	}
+}
+
+// NewMilestone creates a new unreleased milestone.
+func NewMilestone(description string, amount sdk.Coins) Milestone {
+	return Milestone{
+		Description: description,
+		Amount:      amount,
+	}
+}
+
+// Total returns the sum of the milestone amounts of the budget.
+func (b Budget) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, m := range b.Milestones {
+		total = total.Add(m.Amount...)
+	}
+	return total
+}
+
+// Remaining returns the sum of the unreleased milestone amounts of the
+// budget, zero if the budget is not active.
+func (b Budget) Remaining() sdk.Coins {
+	remaining := sdk.NewCoins()
+	if b.Status != StatusActive {
+		return remaining
+	}
+	for _, m := range b.Milestones {
+		if !m.Released {
+			remaining = remaining.Add(m.Amount...)
+		}
+	}
+	return remaining
+}
+
+// ValidateBasic performs basic validation of the budget.
+func (b Budget) ValidateBasic() error {
+	if err := validateTitle("budget", b.Title); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(b.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if err := ValidateMilestones(b.Milestones); err != nil {
+		return err
+	}
+	if !ValidBudgetStatus(b.Status) {
+		return fmt.Errorf("invalid budget status: %s", b.Status)
+	}
+	return nil
+}
+
+// ValidateMilestones checks that there is at least one milestone, and that
+// each milestone has a description and a positive amount.
+func ValidateMilestones(milestones []Milestone) error {
+	if len(milestones) == 0 {
+		return ErrInvalidMilestone.Wrap("no milestones")
+	}
+	for i, m := range milestones {
+		if strings.TrimSpace(m.Description) == "" {
+			return ErrInvalidMilestone.Wrapf("milestone %d: empty description", i)
+		}
+		if !m.Amount.IsValid() || m.Amount.IsZero() {
+			return ErrInvalidMilestone.Wrapf("milestone %d: invalid amount %s", i, m.Amount)
+		}
+	}
+	return nil
+}
+
+// ValidBudgetStatus returns true if the budget status is valid and false
+// otherwise.
+func ValidBudgetStatus(status BudgetStatus) bool {
+	return status == StatusActive || status == StatusCompleted || status == StatusCanceled
+}
+
+// BudgetStatusFromString turns a string into a BudgetStatus. The short form
+// of the status is accepted, e.g. "active" for "BUDGET_STATUS_ACTIVE".
+func BudgetStatusFromString(str string) (BudgetStatus, error) {
+	if num, ok := BudgetStatus_value[str]; ok {
+		return BudgetStatus(num), nil
+	}
+	if num, ok := BudgetStatus_value["BUDGET_STATUS_"+strings.ToUpper(str)]; ok {
+		return BudgetStatus(num), nil
+	}
+	return StatusNil, fmt.Errorf("'%s' is not a valid budget status", str)
+}
+
+func validateTitle(kind, title string) error {
+	if strings.TrimSpace(title) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s title cannot be empty", kind)
+	}
+	if len(title) > MaxTitleLength {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s title is longer than max length of %d", kind, MaxTitleLength)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+
+	govcodec "github.com/atomone-hub/atomone/x/gov/codec"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/treasury module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding
+	// as Amino is still used for that purpose.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+// RegisterLegacyAminoCodec registers all the necessary types and interfaces
+// for the treasury module.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgFundTreasury{}, "atomone/treasury/v1/MsgFundTreasury")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateBudget{}, "atomone/treasury/v1/MsgCreateBudget")
+	legacy.RegisterAminoMsg(cdc, &MsgReleaseMilestone{}, "atomone/treasury/v1/MsgReleaseMilestone")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelBudget{}, "atomone/treasury/v1/MsgCancelBudget")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateStream{}, "atomone/treasury/v1/MsgCreateStream")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "atomone/x/treasury/v1/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "atomone/x/treasury/Params", nil)
+}
+
+// RegisterInterfaces registers the interfaces types with the Interface Registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFundTreasury{},
+		&MsgCreateBudget{},
+		&MsgReleaseMilestone{},
+		&MsgCancelBudget{},
+		&MsgCreateStream{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz and gov
+	// Amino codec so that this can later be used to properly serialize MsgGrant,
+	// MsgExec and MsgSubmitProposal instances
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+	RegisterLegacyAminoCodec(govcodec.Amino)
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/treasury module sentinel errors
+var (
+	ErrInvalidSigner     = sdkerrors.Register(ModuleName, 10, "expected authority account as only signer") //nolint:staticcheck
+	ErrUnknownBudget     = sdkerrors.Register(ModuleName, 20, "unknown budget")                            //nolint:staticcheck
+	ErrInactiveBudget    = sdkerrors.Register(ModuleName, 30, "inactive budget")                           //nolint:staticcheck
+	ErrInvalidMilestone  = sdkerrors.Register(ModuleName, 40, "invalid milestone")                         //nolint:staticcheck
+	ErrInsufficientFunds = sdkerrors.Register(ModuleName, 50, "insufficient available treasury funds")     //nolint:staticcheck
+	ErrInvalidGenesis    = sdkerrors.Register(ModuleName, 60, "invalid genesis state")                     //nolint:staticcheck
+	ErrUnknownStream     = sdkerrors.Register(ModuleName, 70, "unknown stream")                            //nolint:staticcheck
+	ErrInvalidStream     = sdkerrors.Register(ModuleName, 80, "invalid stream")                            //nolint:staticcheck
+)
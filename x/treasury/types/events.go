@@ -0,0 +1,18 @@
+package types
+
+// Treasury module event types
+const (
+	EventTypeFundTreasury     = "fund_treasury"
+	EventTypeCreateBudget     = "create_budget"
+	EventTypeReleaseMilestone = "release_milestone"
+	EventTypeCancelBudget     = "cancel_budget"
+	EventTypeCreateStream     = "create_stream"
+	EventTypeReleaseStream    = "release_stream"
+	EventTypeCollectMintShare = "collect_mint_share"
+
+	AttributeKeyDepositor = "depositor"
+	AttributeKeyBudgetID  = "budget_id"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyMilestone = "milestone"
+	AttributeKeyStreamID  = "stream_id"
+)
@@ -0,0 +1,68 @@
+package types
+
+const (
+	// DefaultStartingBudgetID is the id of the first budget
+	DefaultStartingBudgetID uint64 = 1
+	// DefaultStartingStreamID is the id of the first stream
+	DefaultStartingStreamID uint64 = 1
+)
+
+// NewGenesisState creates a new genesis state for the treasury module
+func NewGenesisState(params Params, startingBudgetID uint64, budgets []Budget, startingStreamID uint64, streams []Stream) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		StartingBudgetId: startingBudgetID,
+		Budgets:          budgets,
+		StartingStreamId: startingStreamID,
+		Streams:          streams,
+	}
+}
+
+// DefaultGenesisState defines the default treasury genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultStartingBudgetID, nil, DefaultStartingStreamID, nil)
+}
+
+// ValidateGenesis checks if the treasury genesis state is valid
+func ValidateGenesis(data *GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return ErrInvalidGenesis.Wrap(err.Error())
+	}
+
+	if data.StartingBudgetId == 0 {
+		return ErrInvalidGenesis.Wrap("starting budget id must be positive")
+	}
+
+	ids := make(map[uint64]bool, len(data.Budgets))
+	for _, b := range data.Budgets {
+		if ids[b.Id] {
+			return ErrInvalidGenesis.Wrapf("duplicate budget id: %d", b.Id)
+		}
+		ids[b.Id] = true
+		if b.Id >= data.StartingBudgetId {
+			return ErrInvalidGenesis.Wrapf("budget id %d is not lower than the starting budget id %d", b.Id, data.StartingBudgetId)
+		}
+		if err := b.ValidateBasic(); err != nil {
+			return ErrInvalidGenesis.Wrapf("budget %d: %s", b.Id, err)
+		}
+	}
+
+	if data.StartingStreamId == 0 {
+		return ErrInvalidGenesis.Wrap("starting stream id must be positive")
+	}
+
+	ids = make(map[uint64]bool, len(data.Streams))
+	for _, s := range data.Streams {
+		if ids[s.Id] {
+			return ErrInvalidGenesis.Wrapf("duplicate stream id: %d", s.Id)
+		}
+		ids[s.Id] = true
+		if s.Id >= data.StartingStreamId {
+			return ErrInvalidGenesis.Wrapf("stream id %d is not lower than the starting stream id %d", s.Id, data.StartingStreamId)
+		}
+		if err := s.ValidateBasic(); err != nil {
+			return ErrInvalidGenesis.Wrapf("stream %d: %s", s.Id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	milestones := []types.Milestone{types.NewMilestone("m1", sdk.NewCoins(sdk.NewInt64Coin("uatone", 1)))}
+	budget := func(id uint64) types.Budget {
+		return types.NewBudget(id, "title", addr, milestones, time.Time{})
+	}
+	stream := func(id uint64) types.Stream {
+		return types.NewStream(id, "title", addr, milestones[0].Amount, time.Time{}, time.Time{}.Add(time.Hour), 0)
+	}
+	tests := []struct {
+		name        string
+		genesis     *types.GenesisState
+		expectedErr string
+	}{
+		{
+			name:    "ok: default",
+			genesis: types.DefaultGenesisState(),
+		},
+		{
+			name:        "fail: invalid params",
+			genesis:     types.NewGenesisState(types.NewParams(math.LegacyNewDec(2)), types.DefaultStartingBudgetID, nil, types.DefaultStartingStreamID, nil),
+			expectedErr: "mint share must be in [0, 1]: 2.000000000000000000: invalid genesis state",
+		},
+		{
+			name:    "ok: budgets",
+			genesis: types.NewGenesisState(types.DefaultParams(), 3, []types.Budget{budget(1), budget(2)}, types.DefaultStartingStreamID, nil),
+		},
+		{
+			name:        "fail: zero starting budget id",
+			genesis:     types.NewGenesisState(types.DefaultParams(), 0, nil, types.DefaultStartingStreamID, nil),
+			expectedErr: "starting budget id must be positive: invalid genesis state",
+		},
+		{
+			name:        "fail: duplicate budget id",
+			genesis:     types.NewGenesisState(types.DefaultParams(), 3, []types.Budget{budget(1), budget(1)}, types.DefaultStartingStreamID, nil),
+			expectedErr: "duplicate budget id: 1: invalid genesis state",
+		},
+		{
+			name:        "fail: budget id not lower than starting budget id",
+			genesis:     types.NewGenesisState(types.DefaultParams(), 2, []types.Budget{budget(2)}, types.DefaultStartingStreamID, nil),
+			expectedErr: "budget id 2 is not lower than the starting budget id 2: invalid genesis state",
+		},
+		{
+			name: "fail: invalid budget status",
+			genesis: types.NewGenesisState(types.DefaultParams(), 2, []types.Budget{
+				{Id: 1, Title: "title", Recipient: addr.String(), Milestones: milestones},
+			}, types.DefaultStartingStreamID, nil),
+			expectedErr: "budget 1: invalid budget status: BUDGET_STATUS_UNSPECIFIED: invalid genesis state",
+		},
+		{
+			name:    "ok: streams",
+			genesis: types.NewGenesisState(types.DefaultParams(), types.DefaultStartingBudgetID, nil, 2, []types.Stream{stream(1)}),
+		},
+		{
+			name:        "fail: zero starting stream id",
+			genesis:     types.NewGenesisState(types.DefaultParams(), types.DefaultStartingBudgetID, nil, 0, nil),
+			expectedErr: "starting stream id must be positive: invalid genesis state",
+		},
+		{
+			name:        "fail: stream id not lower than starting stream id",
+			genesis:     types.NewGenesisState(types.DefaultParams(), types.DefaultStartingBudgetID, nil, 2, []types.Stream{stream(2)}),
+			expectedErr: "stream id 2 is not lower than the starting stream id 2: invalid genesis state",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateGenesis(tt.genesis)
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
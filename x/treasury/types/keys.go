@@ -0,0 +1,70 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "treasury"
+
+	// StoreKey is the store key string for treasury
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for treasury
+	RouterKey = ModuleName
+)
+
+// Keys for treasury store
+// Items are stored with the following key: values
+//
+// - 0x00<budgetID_Bytes>: Budget
+//
+// - 0x01: nextBudgetID
+//
+// - 0x02<streamID_Bytes>: Stream
+//
+// - 0x03: nextStreamID
+//
+// - 0x04: Params
+var (
+	BudgetKeyPrefix = []byte{0x00}
+	BudgetIDKey     = []byte{0x01}
+	StreamKeyPrefix = []byte{0x02}
+	StreamIDKey     = []byte{0x03}
+	ParamsKey       = []byte{0x04}
+)
+
+// GetBudgetIDBytes returns the byte representation of the budgetID
+func GetBudgetIDBytes(budgetID uint64) (budgetIDBz []byte) {
+	budgetIDBz = make([]byte, 8)
+	binary.BigEndian.PutUint64(budgetIDBz, budgetID)
+	return
+}
+
+// GetBudgetIDFromBytes returns budgetID in uint64 format from a byte array
+func GetBudgetIDFromBytes(bz []byte) (budgetID uint64) {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// BudgetKey gets a specific budget from the store
+func BudgetKey(budgetID uint64) []byte {
+	return append(BudgetKeyPrefix, GetBudgetIDBytes(budgetID)...)
+}
+
+// GetStreamIDBytes returns the byte representation of the streamID
+func GetStreamIDBytes(streamID uint64) (streamIDBz []byte) {
+	streamIDBz = make([]byte, 8)
+	binary.BigEndian.PutUint64(streamIDBz, streamID)
+	return
+}
+
+// GetStreamIDFromBytes returns streamID in uint64 format from a byte array
+func GetStreamIDFromBytes(bz []byte) (streamID uint64) {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// StreamKey gets a specific stream from the store
+func StreamKey(streamID uint64) []byte {
+	return append(StreamKeyPrefix, GetStreamIDBytes(streamID)...)
+}
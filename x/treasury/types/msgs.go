@@ -0,0 +1,258 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_, _, _, _, _, _ sdk.Msg = &MsgFundTreasury{}, &MsgCreateBudget{}, &MsgReleaseMilestone{}, &MsgCancelBudget{}, &MsgCreateStream{}, &MsgUpdateParams{}
+)
+
+// NewMsgFundTreasury creates a new MsgFundTreasury instance
+//
+//nolint:interfacer
+func NewMsgFundTreasury(depositor sdk.AccAddress, amount sdk.Coins) *MsgFundTreasury {
+	return &MsgFundTreasury{
+		Depositor: depositor.String(),
+		Amount:    amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgFundTreasury) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgFundTreasury) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgFundTreasury) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid depositor address: %s", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgFundTreasury) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgFundTreasury.
+func (msg MsgFundTreasury) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// NewMsgCreateBudget creates a new MsgCreateBudget instance
+//
+//nolint:interfacer
+func NewMsgCreateBudget(authority sdk.AccAddress, title string, recipient sdk.AccAddress, milestones []Milestone) *MsgCreateBudget {
+	return &MsgCreateBudget{
+		Authority:  authority.String(),
+		Title:      title,
+		Recipient:  recipient.String(),
+		Milestones: milestones,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateBudget) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateBudget) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if err := validateTitle("budget", msg.Title); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if err := ValidateMilestones(msg.Milestones); err != nil {
+		return err
+	}
+	for i, m := range msg.Milestones {
+		if m.Released {
+			return ErrInvalidMilestone.Wrapf("milestone %d: already released", i)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateBudget) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCreateBudget.
+func (msg MsgCreateBudget) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgReleaseMilestone creates a new MsgReleaseMilestone instance
+//
+//nolint:interfacer
+func NewMsgReleaseMilestone(authority sdk.AccAddress, budgetID uint64, milestone uint32) *MsgReleaseMilestone {
+	return &MsgReleaseMilestone{
+		Authority: authority.String(),
+		BudgetId:  budgetID,
+		Milestone: milestone,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgReleaseMilestone) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgReleaseMilestone) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgReleaseMilestone) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgReleaseMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgReleaseMilestone.
+func (msg MsgReleaseMilestone) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgCancelBudget creates a new MsgCancelBudget instance
+//
+//nolint:interfacer
+func NewMsgCancelBudget(authority sdk.AccAddress, budgetID uint64) *MsgCancelBudget {
+	return &MsgCancelBudget{
+		Authority: authority.String(),
+		BudgetId:  budgetID,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelBudget) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelBudget) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelBudget) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelBudget) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCancelBudget.
+func (msg MsgCancelBudget) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgCreateStream creates a new MsgCreateStream instance
+//
+//nolint:interfacer
+func NewMsgCreateStream(
+	authority sdk.AccAddress, title string, recipient sdk.AccAddress, amount sdk.Coins,
+	startTime, endTime time.Time, tranches uint32,
+) *MsgCreateStream {
+	return &MsgCreateStream{
+		Authority: authority.String(),
+		Title:     title,
+		Recipient: recipient.String(),
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Tranches:  tranches,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateStream) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateStream) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if err := validateTitle("stream", msg.Title); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return ValidateStreamSchedule(msg.Amount, msg.StartTime, msg.EndTime)
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateStream) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCreateStream.
+func (msg MsgCreateStream) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.ValidateBasic()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -0,0 +1,227 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/atomone/x/treasury/types"
+)
+
+func TestMsgFundTreasuryValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	tests := []struct {
+		name        string
+		msg         *types.MsgFundTreasury
+		expectedErr string
+	}{
+		{
+			name: "ok",
+			msg:  types.NewMsgFundTreasury(addr, sdk.NewCoins(sdk.NewInt64Coin("uatone", 1))),
+		},
+		{
+			name:        "fail: invalid address",
+			msg:         &types.MsgFundTreasury{Depositor: "xxx", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatone", 1))},
+			expectedErr: "invalid depositor address: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:        "fail: empty amount",
+			msg:         types.NewMsgFundTreasury(addr, sdk.NewCoins()),
+			expectedErr: ": invalid coins",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCreateBudgetValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatone", 1))
+	tests := []struct {
+		name        string
+		msg         *types.MsgCreateBudget
+		expectedErr string
+	}{
+		{
+			name: "ok",
+			msg:  types.NewMsgCreateBudget(addr, "title", addr, []types.Milestone{types.NewMilestone("m1", amount)}),
+		},
+		{
+			name:        "fail: empty title",
+			msg:         types.NewMsgCreateBudget(addr, " ", addr, []types.Milestone{types.NewMilestone("m1", amount)}),
+			expectedErr: "budget title cannot be empty: invalid request",
+		},
+		{
+			name:        "fail: invalid recipient",
+			msg:         &types.MsgCreateBudget{Authority: addr.String(), Title: "title", Recipient: "xxx"},
+			expectedErr: "invalid recipient address: decoding bech32 failed: invalid bech32 string length 3: invalid address",
+		},
+		{
+			name:        "fail: no milestones",
+			msg:         types.NewMsgCreateBudget(addr, "title", addr, nil),
+			expectedErr: "no milestones: invalid milestone",
+		},
+		{
+			name:        "fail: empty milestone description",
+			msg:         types.NewMsgCreateBudget(addr, "title", addr, []types.Milestone{types.NewMilestone("", amount)}),
+			expectedErr: "milestone 0: empty description: invalid milestone",
+		},
+		{
+			name:        "fail: zero milestone amount",
+			msg:         types.NewMsgCreateBudget(addr, "title", addr, []types.Milestone{types.NewMilestone("m1", nil)}),
+			expectedErr: "milestone 0: invalid amount : invalid milestone",
+		},
+		{
+			name: "fail: released milestone",
+			msg: types.NewMsgCreateBudget(addr, "title", addr, []types.Milestone{
+				{Description: "m1", Amount: amount, Released: true},
+			}),
+			expectedErr: "milestone 0: already released: invalid milestone",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCreateStreamValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatone", 1000))
+	startTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+	tests := []struct {
+		name        string
+		msg         *types.MsgCreateStream
+		expectedErr string
+	}{
+		{
+			name: "ok",
+			msg:  types.NewMsgCreateStream(addr, "title", addr, amount, startTime, endTime, 0),
+		},
+		{
+			name:        "fail: empty title",
+			msg:         types.NewMsgCreateStream(addr, "", addr, amount, startTime, endTime, 0),
+			expectedErr: "stream title cannot be empty: invalid request",
+		},
+		{
+			name:        "fail: zero amount",
+			msg:         types.NewMsgCreateStream(addr, "title", addr, nil, startTime, endTime, 0),
+			expectedErr: "invalid amount : invalid stream",
+		},
+		{
+			name:        "fail: end time not after start time",
+			msg:         types.NewMsgCreateStream(addr, "title", addr, amount, endTime, endTime, 2),
+			expectedErr: "end time 2026-01-01 01:00:00 +0000 UTC must be after start time 2026-01-01 01:00:00 +0000 UTC: invalid stream",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	tests := []struct {
+		name        string
+		msg         *types.MsgUpdateParams
+		expectedErr string
+	}{
+		{
+			name: "ok: default params",
+			msg:  &types.MsgUpdateParams{Authority: addr.String(), Params: types.DefaultParams()},
+		},
+		{
+			name: "ok: full mint share",
+			msg:  &types.MsgUpdateParams{Authority: addr.String(), Params: types.NewParams(math.LegacyOneDec())},
+		},
+		{
+			name:        "fail: invalid authority",
+			msg:         &types.MsgUpdateParams{Authority: "x", Params: types.DefaultParams()},
+			expectedErr: "invalid authority address: decoding bech32 failed: invalid bech32 string length 1: invalid address",
+		},
+		{
+			name:        "fail: nil mint share",
+			msg:         &types.MsgUpdateParams{Authority: addr.String()},
+			expectedErr: "mint share must be in [0, 1]: <nil>",
+		},
+		{
+			name:        "fail: negative mint share",
+			msg:         &types.MsgUpdateParams{Authority: addr.String(), Params: types.NewParams(math.LegacyNewDecWithPrec(-1, 1))},
+			expectedErr: "mint share must be in [0, 1]: -0.100000000000000000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestStreamVested(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uatone", 1000), sdk.NewInt64Coin("uphoton", 10))
+	startTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	linear := types.NewStream(1, "title", addr, amount, startTime, startTime.Add(time.Hour), 0)
+	tranches := types.NewStream(1, "title", addr, amount, startTime, startTime.Add(time.Hour), 3)
+
+	require.Empty(t, linear.Vested(startTime.Add(-time.Minute)))
+	require.Empty(t, linear.Vested(startTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 250), sdk.NewInt64Coin("uphoton", 2)), linear.Vested(startTime.Add(15*time.Minute)))
+	require.Equal(t, amount, linear.Vested(startTime.Add(time.Hour)))
+
+	require.Empty(t, tranches.Vested(startTime.Add(19*time.Minute)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 333), sdk.NewInt64Coin("uphoton", 3)), tranches.Vested(startTime.Add(20*time.Minute)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 666), sdk.NewInt64Coin("uphoton", 6)), tranches.Vested(startTime.Add(59*time.Minute)))
+	require.Equal(t, amount, tranches.Vested(startTime.Add(2*time.Hour)))
+
+	tranches.Released = sdk.NewCoins(sdk.NewInt64Coin("uatone", 333), sdk.NewInt64Coin("uphoton", 3))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 333), sdk.NewInt64Coin("uphoton", 3)), tranches.Releasable(startTime.Add(40*time.Minute)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatone", 667), sdk.NewInt64Coin("uphoton", 7)), tranches.Remaining())
+}
+
+func TestBudgetStatusFromString(t *testing.T) {
+	status, err := types.BudgetStatusFromString("active")
+	require.NoError(t, err)
+	require.Equal(t, types.StatusActive, status)
+	status, err = types.BudgetStatusFromString("BUDGET_STATUS_CANCELED")
+	require.NoError(t, err)
+	require.Equal(t, types.StatusCanceled, status)
+	_, err = types.BudgetStatusFromString("foo")
+	require.EqualError(t, err, "'foo' is not a valid budget status")
+}
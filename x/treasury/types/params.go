@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultMintShare is the default share of the minted tokens routed to the
+// treasury, none.
+var DefaultMintShare = math.LegacyZeroDec()
+
+// NewParams creates a new Params instance
+func NewParams(mintShare math.LegacyDec) Params {
+	return Params{
+		MintShare: mintShare,
+	}
+}
+
+// DefaultParams returns the default treasury parameters
+func DefaultParams() Params {
+	return NewParams(DefaultMintShare)
+}
+
+// ValidateBasic performs basic validation on treasury parameters.
+func (p Params) ValidateBasic() error {
+	if p.MintShare.IsNil() || p.MintShare.IsNegative() || p.MintShare.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("mint share must be in [0, 1]: %s", p.MintShare)
+	}
+
+	return nil
+}
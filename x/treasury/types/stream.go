@@ -0,0 +1,96 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewStream creates a new stream with nothing released yet.
+//
+//nolint:interfacer
+func NewStream(
+	id uint64, title string, recipient sdk.AccAddress, amount sdk.Coins,
+	startTime, endTime time.Time, tranches uint32,
+) Stream {
+	return Stream{
+		Id:        id,
+		Title:     title,
+		Recipient: recipient.String(),
+		Amount:    amount,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Tranches:  tranches,
+	}
+}
+
+// Remaining returns the amount of the stream left to disburse.
+func (s Stream) Remaining() sdk.Coins {
+	remaining, _ := s.Amount.SafeSub(s.Released...)
+	return remaining
+}
+
+// Vested returns the amount of the stream disbursable at the given time. A
+// linear stream vests proportionally to the time elapsed since its start,
+// while a stream in tranches vests a tranche at the end of each of the equal
+// intervals between its start and end times.
+func (s Stream) Vested(blockTime time.Time) sdk.Coins {
+	if !blockTime.After(s.StartTime) {
+		return sdk.NewCoins()
+	}
+	if !blockTime.Before(s.EndTime) {
+		return s.Amount
+	}
+
+	elapsed := math.NewInt(int64(blockTime.Sub(s.StartTime)))
+	duration := math.NewInt(int64(s.EndTime.Sub(s.StartTime)))
+	if s.Tranches > 0 {
+		tranches := math.NewInt(int64(s.Tranches))
+		elapsed = elapsed.Mul(tranches).Quo(duration)
+		duration = tranches
+	}
+
+	vested := make([]sdk.Coin, 0, len(s.Amount))
+	for _, c := range s.Amount {
+		vested = append(vested, sdk.NewCoin(c.Denom, c.Amount.Mul(elapsed).Quo(duration)))
+	}
+	return sdk.NewCoins(vested...)
+}
+
+// Releasable returns the vested amount of the stream not released yet.
+func (s Stream) Releasable(blockTime time.Time) sdk.Coins {
+	releasable, _ := s.Vested(blockTime).SafeSub(s.Released...)
+	return releasable
+}
+
+// ValidateBasic performs basic validation of the stream.
+func (s Stream) ValidateBasic() error {
+	if err := validateTitle("stream", s.Title); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if err := ValidateStreamSchedule(s.Amount, s.StartTime, s.EndTime); err != nil {
+		return err
+	}
+	if !s.Released.IsValid() || !s.Amount.IsAllGTE(s.Released) {
+		return ErrInvalidStream.Wrapf("invalid released amount %s", s.Released)
+	}
+	return nil
+}
+
+// ValidateStreamSchedule checks that the stream amount is positive, and that
+// its end time is after its start time.
+func ValidateStreamSchedule(amount sdk.Coins, startTime, endTime time.Time) error {
+	if !amount.IsValid() || amount.IsZero() {
+		return ErrInvalidStream.Wrapf("invalid amount %s", amount)
+	}
+	if !endTime.After(startTime) {
+		return ErrInvalidStream.Wrapf("end time %s must be after start time %s", endTime, startTime)
+	}
+	return nil
+}
@@ -0,0 +1,1619 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: atomone/treasury/v1/treasury.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BudgetStatus enumerates the valid statuses of a budget.
+type BudgetStatus int32
+
+const (
+	// BUDGET_STATUS_UNSPECIFIED defines the default budget status.
+	BudgetStatus_BUDGET_STATUS_UNSPECIFIED BudgetStatus = 0
+	// BUDGET_STATUS_ACTIVE defines a budget with milestones left to release.
+	BudgetStatus_BUDGET_STATUS_ACTIVE BudgetStatus = 1
+	// BUDGET_STATUS_COMPLETED defines a budget whose milestones have all been
+	// released.
+	BudgetStatus_BUDGET_STATUS_COMPLETED BudgetStatus = 2
+	// BUDGET_STATUS_CANCELED defines a budget canceled before the release of
+	// all its milestones.
+	BudgetStatus_BUDGET_STATUS_CANCELED BudgetStatus = 3
+)
+
+var BudgetStatus_name = map[int32]string{
+	0: "BUDGET_STATUS_UNSPECIFIED",
+	1: "BUDGET_STATUS_ACTIVE",
+	2: "BUDGET_STATUS_COMPLETED",
+	3: "BUDGET_STATUS_CANCELED",
+}
+
+var BudgetStatus_value = map[string]int32{
+	"BUDGET_STATUS_UNSPECIFIED": 0,
+	"BUDGET_STATUS_ACTIVE":      1,
+	"BUDGET_STATUS_COMPLETED":   2,
+	"BUDGET_STATUS_CANCELED":    3,
+}
+
+func (x BudgetStatus) String() string {
+	return proto.EnumName(BudgetStatus_name, int32(x))
+}
+
+func (BudgetStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d01c5ef1baf025f6, []int{0}
+}
+
+// Params defines the parameters for the x/treasury module.
+type Params struct {
+	// mint_share is the share of the tokens minted at each block by x/mint
+	// routed to the treasury instead of the fee collector.
+	MintShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=mint_share,json=mintShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"mint_share"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01c5ef1baf025f6, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// Milestone defines a part of a budget, disbursed to the budget recipient
+// once approved through governance.
+type Milestone struct {
+	// description is the deliverable of the milestone.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// amount is the amount disbursed when the milestone is released.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// released is true once the milestone amount has been disbursed.
+	Released bool `protobuf:"varint,3,opt,name=released,proto3" json:"released,omitempty"`
+}
+
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01c5ef1baf025f6, []int{1}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Milestone) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Milestone) GetReleased() bool {
+	if m != nil {
+		return m.Released
+	}
+	return false
+}
+
+// Budget defines an allocation of the treasury funds to a recipient,
+// disbursed milestone by milestone.
+type Budget struct {
+	// id is the unique id of the budget.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the title of the budget.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// recipient is the account receiving the milestone disbursements.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// milestones are the milestones of the budget.
+	Milestones []Milestone `protobuf:"bytes,4,rep,name=milestones,proto3" json:"milestones"`
+	// status is the status of the budget.
+	Status BudgetStatus `protobuf:"varint,5,opt,name=status,proto3,enum=atomone.treasury.v1.BudgetStatus" json:"status,omitempty"`
+	// create_time is the time of the budget creation.
+	CreateTime time.Time `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time"`
+}
+
+func (m *Budget) Reset()         { *m = Budget{} }
+func (m *Budget) String() string { return proto.CompactTextString(m) }
+func (*Budget) ProtoMessage()    {}
+func (*Budget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01c5ef1baf025f6, []int{2}
+}
+func (m *Budget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Budget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Budget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Budget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Budget.Merge(m, src)
+}
+func (m *Budget) XXX_Size() int {
+	return m.Size()
+}
+func (m *Budget) XXX_DiscardUnknown() {
+	xxx_messageInfo_Budget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Budget proto.InternalMessageInfo
+
+func (m *Budget) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Budget) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Budget) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Budget) GetMilestones() []Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *Budget) GetStatus() BudgetStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BudgetStatus_BUDGET_STATUS_UNSPECIFIED
+}
+
+func (m *Budget) GetCreateTime() time.Time {
+	if m != nil {
+		return m.CreateTime
+	}
+	return time.Time{}
+}
+
+// Stream defines a grant of community pool funds to a recipient, disbursed
+// over time instead of a lump sum. The granted amount is held by the treasury
+// module account until its release.
+type Stream struct {
+	// id is the unique id of the stream.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the title of the stream.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// recipient is the account receiving the stream disbursements.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the total amount granted by the stream.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// released is the amount already disbursed to the recipient.
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+	// start_time is the time the disbursements start.
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time the whole amount is disbursed.
+	EndTime time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// tranches is the number of equal tranches the amount is disbursed in, at
+	// regular intervals between the start and end times. Zero disburses the
+	// amount linearly, at every block.
+	Tranches uint32 `protobuf:"varint,8,opt,name=tranches,proto3" json:"tranches,omitempty"`
+}
+
+func (m *Stream) Reset()         { *m = Stream{} }
+func (m *Stream) String() string { return proto.CompactTextString(m) }
+func (*Stream) ProtoMessage()    {}
+func (*Stream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d01c5ef1baf025f6, []int{3}
+}
+func (m *Stream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stream.Merge(m, src)
+}
+func (m *Stream) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stream) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stream proto.InternalMessageInfo
+
+func (m *Stream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Stream) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Stream) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Stream) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Stream) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
+func (m *Stream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetTranches() uint32 {
+	if m != nil {
+		return m.Tranches
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("atomone.treasury.v1.BudgetStatus", BudgetStatus_name, BudgetStatus_value)
+	proto.RegisterType((*Params)(nil), "atomone.treasury.v1.Params")
+	proto.RegisterType((*Milestone)(nil), "atomone.treasury.v1.Milestone")
+	proto.RegisterType((*Budget)(nil), "atomone.treasury.v1.Budget")
+	proto.RegisterType((*Stream)(nil), "atomone.treasury.v1.Stream")
+}
+
+func init() {
+	proto.RegisterFile("atomone/treasury/v1/treasury.proto", fileDescriptor_d01c5ef1baf025f6)
+}
+
+var fileDescriptor_d01c5ef1baf025f6 = []byte{
+	// 719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x4f, 0x1a, 0x4f,
+	0x14, 0x66, 0x41, 0x11, 0x86, 0xdf, 0xcf, 0xd0, 0x29, 0x69, 0x17, 0x4c, 0x17, 0xca, 0x89, 0x98,
+	0xb0, 0x1b, 0x6c, 0x6a, 0xd2, 0x5e, 0x1a, 0x16, 0xb6, 0x0d, 0x89, 0x5a, 0xb3, 0x40, 0x0f, 0xbd,
+	0x90, 0x61, 0x77, 0xba, 0x4c, 0x64, 0x77, 0xc8, 0xce, 0x60, 0xea, 0xa9, 0x3d, 0xf7, 0xe4, 0x9f,
+	0xd1, 0xf4, 0x64, 0xa2, 0x7f, 0x84, 0x47, 0xe3, 0xa9, 0xe9, 0x41, 0x8d, 0x1e, 0xfc, 0x37, 0x9a,
+	0xdd, 0x19, 0x11, 0x1b, 0x2f, 0x1e, 0xbc, 0xc0, 0xbc, 0xf9, 0xde, 0xfb, 0xde, 0x7b, 0xdf, 0x7c,
+	0x59, 0x50, 0x45, 0x9c, 0xfa, 0x34, 0xc0, 0x06, 0x0f, 0x31, 0x62, 0xd3, 0x70, 0xcf, 0xd8, 0x6d,
+	0xcc, 0xce, 0xfa, 0x24, 0xa4, 0x9c, 0xc2, 0xa7, 0x32, 0x47, 0x9f, 0xdd, 0xef, 0x36, 0x4a, 0x05,
+	0x8f, 0x7a, 0x34, 0xc6, 0x8d, 0xe8, 0x24, 0x52, 0x4b, 0x65, 0x8f, 0x52, 0x6f, 0x8c, 0x8d, 0x38,
+	0x1a, 0x4e, 0xbf, 0x18, 0x9c, 0xf8, 0x98, 0x71, 0xe4, 0x4f, 0x64, 0xc2, 0x13, 0xe4, 0x93, 0x80,
+	0x1a, 0xf1, 0xaf, 0xbc, 0x2a, 0x3a, 0x94, 0xf9, 0x94, 0x0d, 0x04, 0x99, 0x08, 0x24, 0xa4, 0x89,
+	0xc8, 0x18, 0x22, 0x86, 0x8d, 0xdd, 0xc6, 0x10, 0x73, 0xd4, 0x30, 0x1c, 0x4a, 0x02, 0x81, 0x57,
+	0xbf, 0x81, 0xf4, 0x36, 0x0a, 0x91, 0xcf, 0x60, 0x1f, 0x00, 0x9f, 0x04, 0x7c, 0xc0, 0x46, 0x28,
+	0xc4, 0xaa, 0x52, 0x51, 0x6a, 0x59, 0x73, 0xfd, 0xf8, 0xac, 0x9c, 0xf8, 0x73, 0x56, 0x5e, 0x11,
+	0x2c, 0xcc, 0xdd, 0xd1, 0x09, 0x35, 0x7c, 0xc4, 0x47, 0xfa, 0x06, 0xf6, 0x90, 0xb3, 0xd7, 0xc6,
+	0xce, 0xe9, 0x51, 0x1d, 0xc8, 0x96, 0x6d, 0xec, 0xfc, 0xbc, 0x3e, 0x58, 0x55, 0xec, 0x6c, 0xc4,
+	0xd4, 0x8d, 0x88, 0xde, 0x6a, 0x3f, 0xae, 0x0f, 0x56, 0x8b, 0x37, 0x1a, 0x7d, 0xbd, 0x55, 0x49,
+	0xb4, 0xad, 0x1e, 0x2a, 0x20, 0xbb, 0x49, 0xc6, 0x98, 0x71, 0x1a, 0x60, 0x58, 0x01, 0x39, 0x17,
+	0x33, 0x27, 0x24, 0x13, 0x4e, 0x68, 0x20, 0xa6, 0xb0, 0xe7, 0xaf, 0xe0, 0x08, 0xa4, 0x91, 0x4f,
+	0xa7, 0x01, 0x57, 0x93, 0x95, 0x54, 0x2d, 0xb7, 0x56, 0xd4, 0x65, 0xf3, 0x68, 0x43, 0x5d, 0x6e,
+	0xa8, 0xb7, 0x28, 0x09, 0xcc, 0xd7, 0xd1, 0xf4, 0xbf, 0xce, 0xcb, 0x35, 0x8f, 0xf0, 0xd1, 0x74,
+	0xa8, 0x3b, 0xd4, 0x97, 0xe2, 0xc8, 0xbf, 0x3a, 0x73, 0x77, 0x0c, 0xbe, 0x37, 0xc1, 0x2c, 0x2e,
+	0x60, 0x62, 0x78, 0xc9, 0x0f, 0x4b, 0x20, 0x13, 0xe2, 0x31, 0x46, 0x0c, 0xbb, 0x6a, 0xaa, 0xa2,
+	0xd4, 0x32, 0xf6, 0x2c, 0xae, 0x1e, 0x26, 0x41, 0xda, 0x9c, 0xba, 0x1e, 0xe6, 0x70, 0x19, 0x24,
+	0x89, 0x1b, 0x4f, 0xba, 0x60, 0x27, 0x89, 0x0b, 0x0b, 0x60, 0x91, 0x13, 0x3e, 0xc6, 0x6a, 0x32,
+	0x1e, 0x5e, 0x04, 0x70, 0x1d, 0x64, 0x43, 0xec, 0x90, 0x09, 0xc1, 0x01, 0x8f, 0xd9, 0xb2, 0xa6,
+	0x7a, 0x7a, 0x54, 0x2f, 0xc8, 0xe1, 0x9b, 0xae, 0x1b, 0x62, 0xc6, 0xba, 0x3c, 0x24, 0x81, 0x67,
+	0xdf, 0xa6, 0xc2, 0x4e, 0xf4, 0x2a, 0x52, 0x1d, 0xa6, 0x2e, 0xc4, 0x2b, 0x6b, 0xfa, 0x3d, 0x76,
+	0xd2, 0x67, 0x22, 0x9a, 0xd9, 0x68, 0x6f, 0xb1, 0xcb, 0x5c, 0x31, 0x7c, 0x03, 0xd2, 0x8c, 0x23,
+	0x3e, 0x65, 0xea, 0x62, 0x45, 0xa9, 0x2d, 0xaf, 0xbd, 0xbc, 0x97, 0x46, 0x6c, 0xd5, 0x8d, 0x13,
+	0x6d, 0x59, 0x00, 0x2d, 0x90, 0x73, 0x42, 0x8c, 0x38, 0x1e, 0x44, 0x6e, 0x54, 0xd3, 0x15, 0xa5,
+	0x96, 0x5b, 0x2b, 0xe9, 0xc2, 0xaa, 0xfa, 0x8d, 0x55, 0xf5, 0xde, 0x8d, 0x55, 0xcd, 0x4c, 0x34,
+	0xc2, 0xfe, 0x79, 0x59, 0xb1, 0x81, 0x28, 0x8c, 0xa0, 0xea, 0x45, 0x0a, 0xa4, 0xbb, 0x51, 0x33,
+	0xff, 0x91, 0x55, 0xbb, 0x35, 0xc9, 0xc2, 0x23, 0x9b, 0x64, 0x3c, 0x67, 0x92, 0xc5, 0x47, 0xea,
+	0x35, 0xeb, 0x00, 0x5b, 0x00, 0x30, 0x8e, 0x42, 0xfe, 0xf0, 0x67, 0xc8, 0xc6, 0x75, 0x11, 0x02,
+	0xdf, 0x81, 0x0c, 0x0e, 0x5c, 0x41, 0xb1, 0xf4, 0x00, 0x8a, 0x25, 0x1c, 0xb8, 0x31, 0x41, 0x09,
+	0x64, 0x78, 0x88, 0x02, 0x67, 0x84, 0x99, 0x9a, 0xa9, 0x28, 0xb5, 0xff, 0xed, 0x59, 0xbc, 0xfa,
+	0x5d, 0x01, 0xff, 0xcd, 0x5b, 0x08, 0xbe, 0x00, 0x45, 0xb3, 0xdf, 0xfe, 0x60, 0xf5, 0x06, 0xdd,
+	0x5e, 0xb3, 0xd7, 0xef, 0x0e, 0xfa, 0x5b, 0xdd, 0x6d, 0xab, 0xd5, 0x79, 0xdf, 0xb1, 0xda, 0xf9,
+	0x04, 0x54, 0x41, 0xe1, 0x2e, 0xdc, 0x6c, 0xf5, 0x3a, 0x9f, 0xac, 0xbc, 0x02, 0x57, 0xc0, 0xf3,
+	0xbb, 0x48, 0xeb, 0xe3, 0xe6, 0xf6, 0x86, 0xd5, 0xb3, 0xda, 0xf9, 0x24, 0x2c, 0x81, 0x67, 0xff,
+	0x80, 0xcd, 0xad, 0x96, 0xb5, 0x61, 0xb5, 0xf3, 0x29, 0xb3, 0x73, 0x7c, 0xa9, 0x29, 0x27, 0x97,
+	0x9a, 0x72, 0x71, 0xa9, 0x29, 0xfb, 0x57, 0x5a, 0xe2, 0xe4, 0x4a, 0x4b, 0xfc, 0xbe, 0xd2, 0x12,
+	0x9f, 0x8d, 0x39, 0xdd, 0xa5, 0xf7, 0xeb, 0xa3, 0xe9, 0xd0, 0xb8, 0xe7, 0xeb, 0x14, 0x3f, 0xc2,
+	0x30, 0x1d, 0x0b, 0xf2, 0xea, 0xef, 0x00, 0x32, 0x0c, 0x3c, 0x79, 0xe4, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MintShare.Size()
+		i -= size
+		if _, err := m.MintShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTreasury(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Milestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Budget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Budget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTreasury(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.Status != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tranches != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.Tranches))
+		i--
+		dAtA[i] = 0x40
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTreasury(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTreasury(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTreasury(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTreasury(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTreasury(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTreasury(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTreasury(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintShare.Size()
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	if m.Released {
+		n += 2
+	}
+	return n
+}
+
+func (m *Budget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTreasury(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovTreasury(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovTreasury(uint64(l))
+	return n
+}
+
+func (m *Stream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTreasury(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTreasury(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovTreasury(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTreasury(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTreasury(uint64(l))
+	if m.Tranches != 0 {
+		n += 1 + sovTreasury(uint64(m.Tranches))
+	}
+	return n
+}
+
+func sovTreasury(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTreasury(x uint64) (n int) {
+	return sovTreasury(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Budget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Budget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Budget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BudgetStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
+			}
+			m.Tranches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tranches |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTreasury(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTreasury
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTreasury(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTreasury
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTreasury
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTreasury
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTreasury
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTreasury
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTreasury        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTreasury          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTreasury = fmt.Errorf("proto: unexpected end of group")
+)